@@ -0,0 +1,225 @@
+package go4ta
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// 按名称调用 TA-Lib 函数（对应 TA-Lib 的抽象接口 ta_abstract.h）。
+// 以 TA-Lib 构建时可调用 TA-Lib 中的全部函数；纯 Go 构建时只能调用已有原生实现的函数。
+
+// FuncInfo 描述一个可按名称调用的函数，对应 TA-Lib 的 TA_FuncInfo 及各参数的描述。
+type FuncInfo struct {
+	Name           string       // TA-Lib 函数名，如 "BBANDS"
+	Group          string       // 分组，如 "Overlap Studies"
+	Hint           string       // 简短说明，如 "Bollinger Bands"
+	Overlap        bool         // 结果与价格同一量纲，可叠加在价格图上
+	Volume         bool         // 需要成交量
+	UnstablePeriod bool         // 受 SetUnstablePeriod 影响
+	Candlestick    bool         // K线形态识别函数
+	Inputs         []FuncInput  // 输入序列
+	Params         []FuncParam  // 可选参数
+	Outputs        []FuncOutput // 输出序列
+}
+
+// FuncInput 是函数的一个输入参数。
+type FuncInput struct {
+	Name  string   // TA-Lib 中的参数名，如 "inPriceHLC"、"inReal"、"inReal0"
+	Price []string // 价格输入需要的列，取值为 open、high、low、close、volume、openinterest；其他输入为空
+}
+
+// FuncParam 是函数的一个可选参数。
+type FuncParam struct {
+	Name    string  // TA-Lib 中的参数名，如 "optInTimePeriod"
+	Integer bool    // 整型参数（包括均线类型）
+	Default float64 // 默认值
+	Min     float64 // 允许的最小值
+	Max     float64 // 允许的最大值
+}
+
+// FuncOutput 是函数的一个输出序列。
+type FuncOutput struct {
+	Name    string // TA-Lib 中的参数名，如 "outReal"、"outMACDSignal"
+	Integer bool   // 整型输出（如K线形态），在 Call 的结果中转换为 float64
+}
+
+// priceColumns 是价格输入的各列，顺序与 TA_SetInputParamPricePtr 的参数一致。
+var priceColumns = []string{"open", "high", "low", "close", "volume", "openinterest"}
+
+var funcInfoCache sync.Map // 大写函数名 -> *FuncInfo
+
+// GetFuncInfo 返回按名称调用时函数的输入、参数与输出。
+//
+// @param name      - TA-Lib 函数名，不区分大小写，如 "CCI"
+// @return *FuncInfo - 函数描述，由所有调用方共享，不应修改
+// @return error     - 函数不存在时返回 RetFuncNotFound
+func GetFuncInfo(name string) (*FuncInfo, error) {
+	key := strings.ToUpper(name)
+	if info, ok := funcInfoCache.Load(key); ok {
+		return info.(*FuncInfo), nil
+	}
+	info, err := taFuncInfo(key)
+	if err != nil {
+		return nil, err
+	}
+	funcInfoCache.Store(key, info)
+	return info, nil
+}
+
+// FuncNames 返回所有可按名称调用的函数名，按字母顺序排列。
+func FuncNames() []string {
+	names := taFuncNames()
+	sort.Strings(names)
+	return names
+}
+
+// Call 按名称调用函数，输入、参数与输出均以名称给出。
+//
+// inputs 的键不区分大小写：价格输入按列名取值（open、high、low、close、volume、openinterest），
+// 其他输入按去掉 "in" 前缀的参数名取值（如 "real"、"real0"、"periods"），唯一的 inReal 缺省时取 close。
+// params 的键为去掉 "optIn" 前缀的参数名，不区分大小写并忽略下划线（如 "timePeriod"、"fastKPeriod"），未给出的参数取默认值。
+// 结果以去掉 "out" 前缀的输出名为键（如 "Real"、"MACDSignal"），各序列与输入等长，
+// 未计算部分按 SetFillPolicy 的设置填充，默认为0。
+//
+// @param name    - TA-Lib 函数名，不区分大小写
+// @param inputs  - 输入序列，长度须相同
+// @param params  - 可选参数
+// @return map[string][]float64 - 各输出序列
+// @return error  - 函数不存在、缺少输入或参数无效时返回错误
+func Call(name string, inputs map[string][]float64, params map[string]float64) (map[string][]float64, error) {
+	info, err := GetFuncInfo(name)
+	if err != nil {
+		return nil, err
+	}
+	fn := "TA_" + info.Name
+
+	columns, names, err := info.resolveInputs(fn, inputs)
+	if err != nil {
+		return nil, err
+	}
+	values, err := info.resolveParams(fn, params)
+	if err != nil {
+		return nil, err
+	}
+	if check, ok := paramRelations[info.Name]; ok {
+		if err := check(values); err != nil {
+			return nil, err
+		}
+	}
+	n, err := checkInputs(fn, strings.Join(names, ", "), columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[string][]float64, len(info.Outputs))
+	if n == 0 {
+		for _, out := range info.Outputs {
+			results[strings.TrimPrefix(out.Name, "out")] = []float64{}
+		}
+		return results, nil
+	}
+
+	outBegIdx, outputs, err := taCall(info, columns, values)
+	if err != nil {
+		return nil, err
+	}
+	w := newWarmup(0)
+	for i, out := range info.Outputs {
+		results[strings.TrimPrefix(out.Name, "out")] = w.spread(n, outBegIdx, outputs[i])
+	}
+	return results, nil
+}
+
+// resolveInputs 按 info.Inputs 的顺序取出各输入序列，价格输入展开为所需的各列。
+func (info *FuncInfo) resolveInputs(fn string, inputs map[string][]float64) (columns [][]float64, names []string, err error) {
+	byName := make(map[string][]float64, len(inputs))
+	for k, v := range inputs {
+		byName[strings.ToLower(k)] = v
+	}
+	lookup := func(param, key string) error {
+		v, ok := byName[key]
+		if !ok {
+			return &FuncError{Func: fn, Code: RetInputNotAllInitialize, Param: param}
+		}
+		columns = append(columns, v)
+		names = append(names, key)
+		return nil
+	}
+
+	for _, in := range info.Inputs {
+		if in.Price != nil {
+			for _, col := range in.Price {
+				if err := lookup(in.Name, col); err != nil {
+					return nil, nil, err
+				}
+			}
+			continue
+		}
+		key := strings.ToLower(strings.TrimPrefix(in.Name, "in"))
+		if _, ok := byName[key]; !ok && in.Name == "inReal" {
+			key = "close"
+		}
+		if err := lookup(in.Name, key); err != nil {
+			return nil, nil, err
+		}
+	}
+	return columns, names, nil
+}
+
+// resolveParams 按 info.Params 的顺序给出各参数的值，并检查取值范围。
+func (info *FuncInfo) resolveParams(fn string, params map[string]float64) ([]float64, error) {
+	values := make([]float64, len(info.Params))
+	index := make(map[string]int, len(info.Params))
+	for i, p := range info.Params {
+		values[i] = p.Default
+		index[paramKey(p.Name)] = i
+	}
+	for k, v := range params {
+		i, ok := index[paramKey(k)]
+		if !ok {
+			return nil, badParam(fn, k, v)
+		}
+		p := info.Params[i]
+		// NaN 与任何值比较都不成立，需单独拒绝
+		if math.IsNaN(v) || (p.Integer && v != math.Trunc(v)) || v < p.Min || v > p.Max {
+			return nil, badParam(fn, p.Name, v)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// paramRelations 检查参数之间的约束。FuncParam 只描述各参数自身的取值范围，
+// 这类约束 TA-Lib 的抽象接口不检查，需要在调用前拒绝；Indicator.Validate 对同一函数使用相同的检查。
+var paramRelations = map[string]func(values []float64) error{
+	"MAVP": mavpRelation,
+}
+
+// mavpRelation 检查 MAVP 与 CycleMA 的参数 minPeriod、maxPeriod、maType 之间的约束，见 mavpParams。
+func mavpRelation(values []float64) error {
+	_, _, _, err := mavpParams(int(values[0]), int(values[1]), int(values[2]))
+	return err
+}
+
+// paramKey 将参数名规范化为小写、去掉 "optin" 前缀和下划线的形式，用于匹配。
+func paramKey(name string) string {
+	key := strings.TrimPrefix(strings.ToLower(name), "optin")
+	return strings.ReplaceAll(key, "_", "")
+}
+
+// Call 以 b 的各列为输入按名称调用函数，见 Call。实数输入取收盘价。
+func (b *Bars) Call(name string, params map[string]float64) (map[string][]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	inputs := map[string][]float64{"high": b.High, "low": b.Low, "close": b.Close}
+	if b.Open != nil {
+		inputs["open"] = b.Open
+	}
+	if b.Volume != nil {
+		inputs["volume"] = b.Volume
+	}
+	return Call(name, inputs, params)
+}
@@ -0,0 +1,237 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#include <ta-lib/ta_libc.h>
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"runtime"
+	"unsafe"
+)
+
+// taFuncHandle 按名称查找 TA-Lib 函数句柄。
+func taFuncHandle(name string) (*C.TA_FuncHandle, error) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	var handle *C.TA_FuncHandle
+	if retCode := C.TA_GetFuncHandle(cName, &handle); retCode != C.TA_SUCCESS {
+		return nil, &FuncError{Func: name, Code: RetCode(retCode)}
+	}
+	return handle, nil
+}
+
+// taFuncInfo 通过 TA-Lib 的抽象接口读取函数的输入、参数与输出。
+func taFuncInfo(name string) (*FuncInfo, error) {
+	handle, err := taFuncHandle(name)
+	if err != nil {
+		return nil, err
+	}
+	var fi *C.TA_FuncInfo
+	if retCode := C.TA_GetFuncInfo(handle, &fi); retCode != C.TA_SUCCESS {
+		return nil, &FuncError{Func: name, Code: RetCode(retCode)}
+	}
+
+	info := &FuncInfo{
+		Name:           C.GoString(fi.name),
+		Group:          C.GoString(fi.group),
+		Hint:           C.GoString(fi.hint),
+		Overlap:        fi.flags&C.TA_FUNC_FLG_OVERLAP != 0,
+		Volume:         fi.flags&C.TA_FUNC_FLG_VOLUME != 0,
+		UnstablePeriod: fi.flags&C.TA_FUNC_FLG_UNST_PER != 0,
+		Candlestick:    fi.flags&C.TA_FUNC_FLG_CANDLESTICK != 0,
+	}
+	for i := range C.uint(fi.nbInput) {
+		var in *C.TA_InputParameterInfo
+		if retCode := C.TA_GetInputParameterInfo(handle, i, &in); retCode != C.TA_SUCCESS {
+			return nil, &FuncError{Func: name, Code: RetCode(retCode)}
+		}
+		input := FuncInput{Name: C.GoString(in.paramName)}
+		switch in._type {
+		case C.TA_Input_Price:
+			input.Price = []string{}
+			for j, col := range priceColumns {
+				if in.flags&(1<<j) != 0 {
+					input.Price = append(input.Price, col)
+				}
+			}
+		case C.TA_Input_Integer:
+			// 标准函数中没有整型输入，Call 无法提供，按不支持处理
+			return nil, &FuncError{Func: name, Code: RetNotSupported, Param: input.Name}
+		}
+		info.Inputs = append(info.Inputs, input)
+	}
+	for i := range C.uint(fi.nbOptInput) {
+		var opt *C.TA_OptInputParameterInfo
+		if retCode := C.TA_GetOptInputParameterInfo(handle, i, &opt); retCode != C.TA_SUCCESS {
+			return nil, &FuncError{Func: name, Code: RetCode(retCode)}
+		}
+		info.Params = append(info.Params, optInputParam(opt))
+	}
+	for i := range C.uint(fi.nbOutput) {
+		var out *C.TA_OutputParameterInfo
+		if retCode := C.TA_GetOutputParameterInfo(handle, i, &out); retCode != C.TA_SUCCESS {
+			return nil, &FuncError{Func: name, Code: RetCode(retCode)}
+		}
+		info.Outputs = append(info.Outputs, FuncOutput{
+			Name:    C.GoString(out.paramName),
+			Integer: out._type == C.TA_Output_Integer,
+		})
+	}
+	return info, nil
+}
+
+// optInputParam 将可选参数的描述转换为 FuncParam，取值范围取自 dataSet 中的区间或列表。
+func optInputParam(opt *C.TA_OptInputParameterInfo) FuncParam {
+	p := FuncParam{Name: C.GoString(opt.paramName), Default: float64(opt.defaultValue)}
+	switch opt._type {
+	case C.TA_OptInput_RealRange:
+		r := (*C.TA_RealRange)(opt.dataSet)
+		p.Min, p.Max = float64(r.min), float64(r.max)
+	case C.TA_OptInput_IntegerRange:
+		r := (*C.TA_IntegerRange)(opt.dataSet)
+		p.Integer = true
+		p.Min, p.Max = float64(r.min), float64(r.max)
+	case C.TA_OptInput_RealList:
+		l := (*C.TA_RealList)(opt.dataSet)
+		for i, pair := range unsafe.Slice(l.data, l.nbElement) {
+			v := float64(pair.value)
+			if i == 0 || v < p.Min {
+				p.Min = v
+			}
+			if i == 0 || v > p.Max {
+				p.Max = v
+			}
+		}
+	case C.TA_OptInput_IntegerList:
+		l := (*C.TA_IntegerList)(opt.dataSet)
+		p.Integer = true
+		for i, pair := range unsafe.Slice(l.data, l.nbElement) {
+			v := float64(pair.value)
+			if i == 0 || v < p.Min {
+				p.Min = v
+			}
+			if i == 0 || v > p.Max {
+				p.Max = v
+			}
+		}
+	}
+	return p
+}
+
+// taFuncNames 列出 TA-Lib 各分组中的全部函数名。
+func taFuncNames() []string {
+	var groups *C.TA_StringTable
+	if C.TA_GroupTableAlloc(&groups) != C.TA_SUCCESS {
+		return nil
+	}
+	defer C.TA_GroupTableFree(groups)
+
+	var names []string
+	for _, group := range unsafe.Slice(groups.string, groups.size) {
+		var funcs *C.TA_StringTable
+		if C.TA_FuncTableAlloc(group, &funcs) != C.TA_SUCCESS {
+			continue
+		}
+		for _, name := range unsafe.Slice(funcs.string, funcs.size) {
+			names = append(names, C.GoString(name))
+		}
+		C.TA_FuncTableFree(funcs)
+	}
+	return names
+}
+
+// taCall 通过 TA_CallFunc 调用函数。inputs 按 info.Inputs 展开后的顺序给出，params 已填入默认值。
+// 参数容器在多次 cgo 调用之间保存输入输出缓冲区的指针，因此这些缓冲区在调用期间须固定。
+func taCall(info *FuncInfo, inputs [][]float64, params []float64) (int, [][]float64, error) {
+	defer readSettings()()
+	fn := "TA_" + info.Name
+	handle, err := taFuncHandle(info.Name)
+	if err != nil {
+		return 0, nil, err
+	}
+	var holder *C.TA_ParamHolder
+	if retCode := C.TA_ParamHolderAlloc(handle, &holder); retCode != C.TA_SUCCESS {
+		return 0, nil, taErr(fn, RetCode(retCode), nil)
+	}
+	defer C.TA_ParamHolderFree(holder)
+
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	pin := func(v []float64) *C.TA_Real {
+		pinner.Pin(&v[0])
+		return (*C.TA_Real)(unsafe.Pointer(&v[0]))
+	}
+
+	n := len(inputs[0])
+	next := 0
+	for i, in := range info.Inputs {
+		var retCode C.TA_RetCode
+		if in.Price == nil {
+			retCode = C.TA_SetInputParamRealPtr(holder, C.uint(i), pin(inputs[next]))
+			next++
+		} else {
+			var cols [6]*C.TA_Real
+			for _, col := range in.Price {
+				for j, name := range priceColumns {
+					if name == col {
+						cols[j] = pin(inputs[next])
+					}
+				}
+				next++
+			}
+			retCode = C.TA_SetInputParamPricePtr(holder, C.uint(i), cols[0], cols[1], cols[2], cols[3], cols[4], cols[5])
+		}
+		if retCode != C.TA_SUCCESS {
+			return 0, nil, taErr(fn, RetCode(retCode), nil)
+		}
+	}
+	for i, p := range info.Params {
+		var retCode C.TA_RetCode
+		if p.Integer {
+			retCode = C.TA_SetOptInputParamInteger(holder, C.uint(i), C.TA_Integer(params[i]))
+		} else {
+			retCode = C.TA_SetOptInputParamReal(holder, C.uint(i), C.TA_Real(params[i]))
+		}
+		if retCode != C.TA_SUCCESS {
+			return 0, nil, taErr(fn, RetCode(retCode), badParam(fn, p.Name, params[i]))
+		}
+	}
+
+	realOut := make([][]float64, len(info.Outputs))
+	intOut := make([][]C.TA_Integer, len(info.Outputs))
+	for i, out := range info.Outputs {
+		var retCode C.TA_RetCode
+		if out.Integer {
+			intOut[i] = make([]C.TA_Integer, n)
+			pinner.Pin(&intOut[i][0])
+			retCode = C.TA_SetOutputParamIntegerPtr(holder, C.uint(i), &intOut[i][0])
+		} else {
+			realOut[i] = make([]float64, n)
+			retCode = C.TA_SetOutputParamRealPtr(holder, C.uint(i), pin(realOut[i]))
+		}
+		if retCode != C.TA_SUCCESS {
+			return 0, nil, taErr(fn, RetCode(retCode), nil)
+		}
+	}
+
+	var outBegIdx, outNBElement C.TA_Integer
+	if retCode := C.TA_CallFunc(holder, 0, C.TA_Integer(n-1), &outBegIdx, &outNBElement); retCode != C.TA_SUCCESS {
+		return 0, nil, taErr(fn, RetCode(retCode), nil)
+	}
+
+	outputs := make([][]float64, len(info.Outputs))
+	for i := range outputs {
+		if intOut[i] != nil {
+			outputs[i] = make([]float64, outNBElement)
+			for j := range outputs[i] {
+				outputs[i][j] = float64(intOut[i][j])
+			}
+		} else {
+			outputs[i] = realOut[i][:outNBElement]
+		}
+	}
+	return int(outBegIdx), outputs, nil
+}
@@ -0,0 +1,591 @@
+package go4ta
+
+// 原生实现的函数描述，与 TA-Lib 抽象接口给出的 TA_FuncInfo 一致，纯 Go 构建时供 Call 使用。
+
+// nativeFunc 是一个可按名称调用的原生函数：inputs 按 info.Inputs 展开后的顺序给出，params 已填入默认值。
+type nativeFunc struct {
+	info FuncInfo
+	call func(inputs [][]float64, params []float64) (int, [][]float64, error)
+}
+
+const (
+	groupOverlap    = "Overlap Studies"
+	groupMomentum   = "Momentum Indicators"
+	groupVolatility = "Volatility Indicators"
+	groupVolume     = "Volume Indicators"
+	groupStatistic  = "Statistic Functions"
+	groupPattern    = "Pattern Recognition"
+	groupCycle      = "Cycle Indicators"
+	groupMath       = "Math Operators"
+)
+
+var (
+	inReal      = FuncInput{Name: "inReal"}
+	inPriceHL   = FuncInput{Name: "inPriceHL", Price: []string{"high", "low"}}
+	inPriceOHLC = FuncInput{Name: "inPriceOHLC", Price: []string{"open", "high", "low", "close"}}
+	inPriceHLC  = FuncInput{Name: "inPriceHLC", Price: []string{"high", "low", "close"}}
+	inPriceHLCV = FuncInput{Name: "inPriceHLCV", Price: []string{"high", "low", "close", "volume"}}
+	inPriceV    = FuncInput{Name: "inPriceV", Price: []string{"volume"}}
+	inPair      = []FuncInput{{Name: "inReal0"}, {Name: "inReal1"}}
+	outReal     = []FuncOutput{{Name: "outReal"}}
+	outInteger  = []FuncOutput{{Name: "outInteger", Integer: true}}
+)
+
+// optInPeriod 返回取值范围为 min..100000 的周期参数。
+func optInPeriod(name string, def, min float64) FuncParam {
+	return FuncParam{Name: name, Integer: true, Default: def, Min: min, Max: 100000}
+}
+
+// optInMAType 返回均线类型参数，取值为 0..8，默认 SMA。
+func optInMAType(name string) FuncParam {
+	return FuncParam{Name: name, Integer: true, Min: 0, Max: 8}
+}
+
+// optInReal 返回取值范围为 TA-Lib 全部实数的参数。
+func optInReal(name string, def float64) FuncParam {
+	return FuncParam{Name: name, Default: def, Min: taRealMin, Max: taRealMax}
+}
+
+// optInNonNeg 返回不小于0的实数参数，如 SAR 的加速因子。
+func optInNonNeg(name string, def float64) FuncParam {
+	return FuncParam{Name: name, Default: def, Min: 0, Max: taRealMax}
+}
+
+// call1 将只有一个输出的原生函数包装为 nativeFunc.call 的形式。
+func call1(outBegIdx int, output []float64, err error) (int, [][]float64, error) {
+	return outBegIdx, [][]float64{output}, err
+}
+
+func call2(outBegIdx int, a, b []float64, err error) (int, [][]float64, error) {
+	return outBegIdx, [][]float64{a, b}, err
+}
+
+func call3(outBegIdx int, a, b, c []float64, err error) (int, [][]float64, error) {
+	return outBegIdx, [][]float64{a, b, c}, err
+}
+
+// intsToFloats 将整型输出转换为 Call 结果中的 float64。
+func intsToFloats(output []int) []float64 {
+	result := make([]float64, len(output))
+	for i, v := range output {
+		result[i] = float64(v)
+	}
+	return result
+}
+
+// maFunc 返回固定均线类型的均线函数，如 SMA、EMA。
+func maFunc(name, hint string, maType int, unstable bool) nativeFunc {
+	return nativeFunc{
+		FuncInfo{Name: name, Group: groupOverlap, Hint: hint, Overlap: true, UnstablePeriod: unstable,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 30, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeMA(in[0], int(p[0]), maType))
+		},
+	}
+}
+
+// nativeFuncs 以 TA-Lib 函数名为键，列出有原生实现的函数。
+var nativeFuncs = map[string]nativeFunc{
+	"MA": {
+		FuncInfo{Name: "MA", Group: groupOverlap, Hint: "Moving average", Overlap: true,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 30, 1), optInMAType("optInMAType")}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeMA(in[0], int(p[0]), int(p[1])))
+		},
+	},
+	"MAVP": {
+		FuncInfo{Name: "MAVP", Group: groupOverlap, Hint: "Moving average with variable period", Overlap: true,
+			Inputs:  []FuncInput{inReal, {Name: "inPeriods"}},
+			Params:  []FuncParam{optInPeriod("optInMinPeriod", 2, 2), optInPeriod("optInMaxPeriod", 30, 2), optInMAType("optInMAType")},
+			Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeMAVP(in[0], in[1], int(p[0]), int(p[1]), int(p[2])))
+		},
+	},
+	"SMA":   maFunc("SMA", "Simple Moving Average", 0, false),
+	"EMA":   maFunc("EMA", "Exponential Moving Average", 1, true),
+	"WMA":   maFunc("WMA", "Weighted Moving Average", 2, false),
+	"DEMA":  maFunc("DEMA", "Double Exponential Moving Average", 3, false),
+	"TEMA":  maFunc("TEMA", "Triple Exponential Moving Average", 4, false),
+	"TRIMA": maFunc("TRIMA", "Triangular Moving Average", 5, false),
+	"KAMA":  maFunc("KAMA", "Kaufman Adaptive Moving Average", 6, true),
+	"MAMA": {
+		FuncInfo{Name: "MAMA", Group: groupOverlap, Hint: "MESA Adaptive Moving Average", Overlap: true, UnstablePeriod: true,
+			Inputs:  []FuncInput{inReal},
+			Params:  []FuncParam{{Name: "optInFastLimit", Default: 0.5, Min: 0.01, Max: 0.99}, {Name: "optInSlowLimit", Default: 0.05, Min: 0.01, Max: 0.99}},
+			Outputs: []FuncOutput{{Name: "outMAMA"}, {Name: "outFAMA"}}},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call2(nativeMAMA(in[0], p[0], p[1]))
+		},
+	},
+	"T3": {
+		FuncInfo{Name: "T3", Group: groupOverlap, Hint: "Triple Exponential Moving Average (T3)", Overlap: true, UnstablePeriod: true,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 5, 2), {Name: "optInVFactor", Default: 0.7, Min: 0, Max: 1}}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeT3(in[0], int(p[0]), p[1]))
+		},
+	},
+	"BBANDS": {
+		FuncInfo{Name: "BBANDS", Group: groupOverlap, Hint: "Bollinger Bands", Overlap: true,
+			Inputs:  []FuncInput{inReal},
+			Params:  []FuncParam{optInPeriod("optInTimePeriod", 5, 2), optInReal("optInNbDevUp", 2), optInReal("optInNbDevDn", 2), optInMAType("optInMAType")},
+			Outputs: []FuncOutput{{Name: "outRealUpperBand"}, {Name: "outRealMiddleBand"}, {Name: "outRealLowerBand"}}},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call3(nativeBBands(in[0], int(p[0]), p[1], p[2], int(p[3])))
+		},
+	},
+	"SAR": {
+		FuncInfo{Name: "SAR", Group: groupOverlap, Hint: "Parabolic SAR", Overlap: true,
+			Inputs: []FuncInput{inPriceHL}, Params: []FuncParam{optInNonNeg("optInAcceleration", 0.02), optInNonNeg("optInMaximum", 0.2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeSAR(in[0], in[1], p[0], p[1]))
+		},
+	},
+	"SAREXT": {
+		FuncInfo{Name: "SAREXT", Group: groupOverlap, Hint: "Parabolic SAR - Extended", Overlap: true,
+			Inputs: []FuncInput{inPriceHL},
+			Params: []FuncParam{optInReal("optInStartValue", 0), optInNonNeg("optInOffsetOnReverse", 0),
+				optInNonNeg("optInAccelerationInitLong", 0.02), optInNonNeg("optInAccelerationLong", 0.02), optInNonNeg("optInAccelerationMaxLong", 0.2),
+				optInNonNeg("optInAccelerationInitShort", 0.02), optInNonNeg("optInAccelerationShort", 0.02), optInNonNeg("optInAccelerationMaxShort", 0.2)},
+			Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeSAREXT(in[0], in[1], p[0], p[1], p[2], p[3], p[4], p[5], p[6], p[7]))
+		},
+	},
+	"RSI": {
+		FuncInfo{Name: "RSI", Group: groupMomentum, Hint: "Relative Strength Index", UnstablePeriod: true,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeRSI(in[0], int(p[0])))
+		},
+	},
+	"MACD": {
+		FuncInfo{Name: "MACD", Group: groupMomentum, Hint: "Moving Average Convergence/Divergence",
+			Inputs:  []FuncInput{inReal},
+			Params:  []FuncParam{optInPeriod("optInFastPeriod", 12, 2), optInPeriod("optInSlowPeriod", 26, 2), optInPeriod("optInSignalPeriod", 9, 1)},
+			Outputs: []FuncOutput{{Name: "outMACD"}, {Name: "outMACDSignal"}, {Name: "outMACDHist"}}},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call3(nativeMACD(in[0], int(p[0]), int(p[1]), int(p[2])))
+		},
+	},
+	"ADX": {
+		FuncInfo{Name: "ADX", Group: groupMomentum, Hint: "Average Directional Movement Index", UnstablePeriod: true,
+			Inputs: []FuncInput{inPriceHLC}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeADX(in[0], in[1], in[2], int(p[0])))
+		},
+	},
+	"ADXR": {
+		FuncInfo{Name: "ADXR", Group: groupMomentum, Hint: "Average Directional Movement Index Rating", UnstablePeriod: true,
+			Inputs: []FuncInput{inPriceHLC}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeADXR(in[0], in[1], in[2], int(p[0])))
+		},
+	},
+	"DX": {
+		FuncInfo{Name: "DX", Group: groupMomentum, Hint: "Directional Movement Index", UnstablePeriod: true,
+			Inputs: []FuncInput{inPriceHLC}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeDX(in[0], in[1], in[2], int(p[0])))
+		},
+	},
+	"PLUS_DI": {
+		FuncInfo{Name: "PLUS_DI", Group: groupMomentum, Hint: "Plus Directional Indicator", UnstablePeriod: true,
+			Inputs: []FuncInput{inPriceHLC}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativePLUSDI(in[0], in[1], in[2], int(p[0])))
+		},
+	},
+	"MINUS_DI": {
+		FuncInfo{Name: "MINUS_DI", Group: groupMomentum, Hint: "Minus Directional Indicator", UnstablePeriod: true,
+			Inputs: []FuncInput{inPriceHLC}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeMINUSDI(in[0], in[1], in[2], int(p[0])))
+		},
+	},
+	"PLUS_DM": {
+		FuncInfo{Name: "PLUS_DM", Group: groupMomentum, Hint: "Plus Directional Movement", UnstablePeriod: true,
+			Inputs: []FuncInput{inPriceHL}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativePLUSDM(in[0], in[1], int(p[0])))
+		},
+	},
+	"MINUS_DM": {
+		FuncInfo{Name: "MINUS_DM", Group: groupMomentum, Hint: "Minus Directional Movement", UnstablePeriod: true,
+			Inputs: []FuncInput{inPriceHL}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeMINUSDM(in[0], in[1], int(p[0])))
+		},
+	},
+	"AROON": {
+		FuncInfo{Name: "AROON", Group: groupMomentum, Hint: "Aroon",
+			Inputs: []FuncInput{inPriceHL}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)},
+			Outputs: []FuncOutput{{Name: "outAroonDown"}, {Name: "outAroonUp"}}},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call2(nativeAROON(in[0], in[1], int(p[0])))
+		},
+	},
+	"AROONOSC": {
+		FuncInfo{Name: "AROONOSC", Group: groupMomentum, Hint: "Aroon Oscillator",
+			Inputs: []FuncInput{inPriceHL}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeAROONOSC(in[0], in[1], int(p[0])))
+		},
+	},
+	"STOCH": {
+		FuncInfo{Name: "STOCH", Group: groupMomentum, Hint: "Stochastic",
+			Inputs: []FuncInput{inPriceHLC},
+			Params: []FuncParam{optInPeriod("optInFastK_Period", 5, 1), optInPeriod("optInSlowK_Period", 3, 1), optInMAType("optInSlowK_MAType"),
+				optInPeriod("optInSlowD_Period", 3, 1), optInMAType("optInSlowD_MAType")},
+			Outputs: []FuncOutput{{Name: "outSlowK"}, {Name: "outSlowD"}}},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call2(nativeSTOCH(in[0], in[1], in[2], int(p[0]), int(p[1]), int(p[3]), int(p[2]), int(p[4])))
+		},
+	},
+	"STOCHRSI": {
+		FuncInfo{Name: "STOCHRSI", Group: groupMomentum, Hint: "Stochastic Relative Strength Index", UnstablePeriod: true,
+			Inputs:  []FuncInput{inReal},
+			Params:  []FuncParam{optInPeriod("optInTimePeriod", 14, 2), optInPeriod("optInFastK_Period", 5, 1), optInPeriod("optInFastD_Period", 3, 1), optInMAType("optInFastD_MAType")},
+			Outputs: []FuncOutput{{Name: "outFastK"}, {Name: "outFastD"}}},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call2(nativeSTOCHRSI(in[0], int(p[0]), int(p[1]), int(p[2]), int(p[3])))
+		},
+	},
+	"APO": {
+		FuncInfo{Name: "APO", Group: groupMomentum, Hint: "Absolute Price Oscillator",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInFastPeriod", 12, 2), optInPeriod("optInSlowPeriod", 26, 2), optInMAType("optInMAType")}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeAPO(in[0], int(p[0]), int(p[1]), int(p[2])))
+		},
+	},
+	"PPO": {
+		FuncInfo{Name: "PPO", Group: groupMomentum, Hint: "Percentage Price Oscillator",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInFastPeriod", 12, 2), optInPeriod("optInSlowPeriod", 26, 2), optInMAType("optInMAType")}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativePPO(in[0], int(p[0]), int(p[1]), int(p[2])))
+		},
+	},
+	"ATR": {
+		FuncInfo{Name: "ATR", Group: groupVolatility, Hint: "Average True Range", UnstablePeriod: true,
+			Inputs: []FuncInput{inPriceHLC}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeATR(in[0], in[1], in[2], int(p[0])))
+		},
+	},
+	"NATR": {
+		FuncInfo{Name: "NATR", Group: groupVolatility, Hint: "Normalized Average True Range", UnstablePeriod: true,
+			Inputs: []FuncInput{inPriceHLC}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeNATR(in[0], in[1], in[2], int(p[0])))
+		},
+	},
+	"TRANGE": {
+		FuncInfo{Name: "TRANGE", Group: groupVolatility, Hint: "True Range",
+			Inputs: []FuncInput{inPriceHLC}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeTRANGE(in[0], in[1], in[2]))
+		},
+	},
+	"OBV": {
+		FuncInfo{Name: "OBV", Group: groupVolume, Hint: "On Balance Volume", Volume: true,
+			Inputs: []FuncInput{inReal, inPriceV}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeOBV(in[0], in[1]))
+		},
+	},
+	"AD": {
+		FuncInfo{Name: "AD", Group: groupVolume, Hint: "Chaikin A/D Line", Volume: true,
+			Inputs: []FuncInput{inPriceHLCV}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeAD(in[0], in[1], in[2], in[3]))
+		},
+	},
+	"ADOSC": {
+		FuncInfo{Name: "ADOSC", Group: groupVolume, Hint: "Chaikin A/D Oscillator", Volume: true,
+			Inputs: []FuncInput{inPriceHLCV}, Params: []FuncParam{optInPeriod("optInFastPeriod", 3, 2), optInPeriod("optInSlowPeriod", 10, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeADOSC(in[0], in[1], in[2], in[3], int(p[0]), int(p[1])))
+		},
+	},
+	"STDDEV": {
+		FuncInfo{Name: "STDDEV", Group: groupStatistic, Hint: "Standard Deviation",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 5, 2), optInReal("optInNbDev", 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeSTDDEV(in[0], int(p[0]), p[1]))
+		},
+	},
+	"VAR": {
+		FuncInfo{Name: "VAR", Group: groupStatistic, Hint: "Variance",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 5, 1), optInReal("optInNbDev", 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeVAR(in[0], int(p[0]), p[1]))
+		},
+	},
+	"CORREL": {
+		FuncInfo{Name: "CORREL", Group: groupStatistic, Hint: "Pearson's Correlation Coefficient (r)",
+			Inputs: inPair, Params: []FuncParam{optInPeriod("optInTimePeriod", 30, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeCORREL(in[0], in[1], int(p[0])))
+		},
+	},
+	"BETA": {
+		FuncInfo{Name: "BETA", Group: groupStatistic, Hint: "Beta",
+			Inputs: inPair, Params: []FuncParam{optInPeriod("optInTimePeriod", 5, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeBETA(in[0], in[1], int(p[0])))
+		},
+	},
+	"SUM": {
+		FuncInfo{Name: "SUM", Group: groupMath, Hint: "Summation",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 30, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeSUM(in[0], int(p[0])))
+		},
+	},
+	"MAX": {
+		FuncInfo{Name: "MAX", Group: groupMath, Hint: "Highest value over a specified period", Overlap: true,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 30, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeMAX(in[0], int(p[0])))
+		},
+	},
+	"MIN": {
+		FuncInfo{Name: "MIN", Group: groupMath, Hint: "Lowest value over a specified period", Overlap: true,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 30, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeMIN(in[0], int(p[0])))
+		},
+	},
+	"MINMAX": {
+		FuncInfo{Name: "MINMAX", Group: groupMath, Hint: "Lowest and highest values over a specified period", Overlap: true,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 30, 2)},
+			Outputs: []FuncOutput{{Name: "outMin"}, {Name: "outMax"}}},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call2(nativeMINMAX(in[0], int(p[0])))
+		},
+	},
+	"MAXINDEX": {
+		FuncInfo{Name: "MAXINDEX", Group: groupMath, Hint: "Index of highest value over a specified period",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 30, 2)}, Outputs: outInteger},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			outBegIdx, output, err := nativeMAXINDEX(in[0], int(p[0]))
+			return outBegIdx, [][]float64{intsToFloats(output)}, err
+		},
+	},
+	"MININDEX": {
+		FuncInfo{Name: "MININDEX", Group: groupMath, Hint: "Index of lowest value over a specified period",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 30, 2)}, Outputs: outInteger},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			outBegIdx, output, err := nativeMININDEX(in[0], int(p[0]))
+			return outBegIdx, [][]float64{intsToFloats(output)}, err
+		},
+	},
+	"MINMAXINDEX": {
+		FuncInfo{Name: "MINMAXINDEX", Group: groupMath, Hint: "Indexes of lowest and highest values over a specified period",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 30, 2)},
+			Outputs: []FuncOutput{{Name: "outMinIdx", Integer: true}, {Name: "outMaxIdx", Integer: true}}},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			outBegIdx, outMinIdx, outMaxIdx, err := nativeMINMAXINDEX(in[0], int(p[0]))
+			return outBegIdx, [][]float64{intsToFloats(outMinIdx), intsToFloats(outMaxIdx)}, err
+		},
+	},
+	"MIDPOINT": {
+		FuncInfo{Name: "MIDPOINT", Group: groupOverlap, Hint: "MidPoint over period", Overlap: true,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeMIDPOINT(in[0], int(p[0])))
+		},
+	},
+	"MIDPRICE": {
+		FuncInfo{Name: "MIDPRICE", Group: groupOverlap, Hint: "Midpoint Price over period", Overlap: true,
+			Inputs: []FuncInput{inPriceHL}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeMIDPRICE(in[0], in[1], int(p[0])))
+		},
+	},
+	"LINEARREG": {
+		FuncInfo{Name: "LINEARREG", Group: groupStatistic, Hint: "Linear Regression", Overlap: true,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeLINEARREG(in[0], int(p[0])))
+		},
+	},
+	"LINEARREG_SLOPE": {
+		FuncInfo{Name: "LINEARREG_SLOPE", Group: groupStatistic, Hint: "Linear Regression Slope",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeLINEARREGSLOPE(in[0], int(p[0])))
+		},
+	},
+	"LINEARREG_ANGLE": {
+		FuncInfo{Name: "LINEARREG_ANGLE", Group: groupStatistic, Hint: "Linear Regression Angle",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeLINEARREGANGLE(in[0], int(p[0])))
+		},
+	},
+	"LINEARREG_INTERCEPT": {
+		FuncInfo{Name: "LINEARREG_INTERCEPT", Group: groupStatistic, Hint: "Linear Regression Intercept", Overlap: true,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeLINEARREGINTERCEPT(in[0], int(p[0])))
+		},
+	},
+	"TSF": {
+		FuncInfo{Name: "TSF", Group: groupStatistic, Hint: "Time Series Forecast", Overlap: true,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeTSF(in[0], int(p[0])))
+		},
+	},
+	"MOM": {
+		FuncInfo{Name: "MOM", Group: groupMomentum, Hint: "Momentum",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 10, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeMOM(in[0], int(p[0])))
+		},
+	},
+	"ROC": {
+		FuncInfo{Name: "ROC", Group: groupMomentum, Hint: "Rate of change : ((price/prevPrice)-1)*100",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 10, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeROC(in[0], int(p[0])))
+		},
+	},
+	"ROCP": {
+		FuncInfo{Name: "ROCP", Group: groupMomentum, Hint: "Rate of change Percentage: (price-prevPrice)/prevPrice",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 10, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeROCP(in[0], int(p[0])))
+		},
+	},
+	"ROCR": {
+		FuncInfo{Name: "ROCR", Group: groupMomentum, Hint: "Rate of change ratio: (price/prevPrice)",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 10, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeROCR(in[0], int(p[0])))
+		},
+	},
+	"ROCR100": {
+		FuncInfo{Name: "ROCR100", Group: groupMomentum, Hint: "Rate of change ratio 100 scale: (price/prevPrice)*100",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 10, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeROCR100(in[0], int(p[0])))
+		},
+	},
+	"CMO": {
+		FuncInfo{Name: "CMO", Group: groupMomentum, Hint: "Chande Momentum Oscillator", UnstablePeriod: true,
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeCMO(in[0], int(p[0])))
+		},
+	},
+	"TRIX": {
+		FuncInfo{Name: "TRIX", Group: groupMomentum, Hint: "1-day Rate-Of-Change (ROC) of a Triple Smooth EMA",
+			Inputs: []FuncInput{inReal}, Params: []FuncParam{optInPeriod("optInTimePeriod", 30, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeTRIX(in[0], int(p[0])))
+		},
+	},
+	"CCI": {
+		FuncInfo{Name: "CCI", Group: groupMomentum, Hint: "Commodity Channel Index",
+			Inputs: []FuncInput{inPriceHLC}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeCCI(in[0], in[1], in[2], int(p[0])))
+		},
+	},
+	"WILLR": {
+		FuncInfo{Name: "WILLR", Group: groupMomentum, Hint: "Williams' %R",
+			Inputs: []FuncInput{inPriceHLC}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeWILLR(in[0], in[1], in[2], int(p[0])))
+		},
+	},
+	"MFI": {
+		FuncInfo{Name: "MFI", Group: groupMomentum, Hint: "Money Flow Index", Volume: true, UnstablePeriod: true,
+			Inputs: []FuncInput{inPriceHLCV}, Params: []FuncParam{optInPeriod("optInTimePeriod", 14, 2)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeMFI(in[0], in[1], in[2], in[3], int(p[0])))
+		},
+	},
+	"ULTOSC": {
+		FuncInfo{Name: "ULTOSC", Group: groupMomentum, Hint: "Ultimate Oscillator",
+			Inputs: []FuncInput{inPriceHLC}, Params: []FuncParam{optInPeriod("optInTimePeriod1", 7, 1), optInPeriod("optInTimePeriod2", 14, 1), optInPeriod("optInTimePeriod3", 28, 1)}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeULTOSC(in[0], in[1], in[2], int(p[0]), int(p[1]), int(p[2])))
+		},
+	},
+	"BOP": {
+		FuncInfo{Name: "BOP", Group: groupMomentum, Hint: "Balance Of Power",
+			Inputs: []FuncInput{inPriceOHLC}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeBOP(in[0], in[1], in[2], in[3]))
+		},
+	},
+	"HT_DCPERIOD": {
+		FuncInfo{Name: "HT_DCPERIOD", Group: groupCycle, Hint: "Hilbert Transform - Dominant Cycle Period", UnstablePeriod: true,
+			Inputs: []FuncInput{inReal}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeHTDCPERIOD(in[0]))
+		},
+	},
+	"HT_DCPHASE": {
+		FuncInfo{Name: "HT_DCPHASE", Group: groupCycle, Hint: "Hilbert Transform - Dominant Cycle Phase", UnstablePeriod: true,
+			Inputs: []FuncInput{inReal}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeHTDCPHASE(in[0]))
+		},
+	},
+	"HT_PHASOR": {
+		FuncInfo{Name: "HT_PHASOR", Group: groupCycle, Hint: "Hilbert Transform - Phasor Components", UnstablePeriod: true,
+			Inputs: []FuncInput{inReal}, Outputs: []FuncOutput{{Name: "outInPhase"}, {Name: "outQuadrature"}}},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call2(nativeHTPHASOR(in[0]))
+		},
+	},
+	"HT_SINE": {
+		FuncInfo{Name: "HT_SINE", Group: groupCycle, Hint: "Hilbert Transform - SineWave", UnstablePeriod: true,
+			Inputs: []FuncInput{inReal}, Outputs: []FuncOutput{{Name: "outSine"}, {Name: "outLeadSine"}}},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call2(nativeHTSINE(in[0]))
+		},
+	},
+	"HT_TRENDLINE": {
+		FuncInfo{Name: "HT_TRENDLINE", Group: groupOverlap, Hint: "Hilbert Transform - Instantaneous Trendline", Overlap: true, UnstablePeriod: true,
+			Inputs: []FuncInput{inReal}, Outputs: outReal},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			return call1(nativeHTTRENDLINE(in[0]))
+		},
+	},
+	"HT_TRENDMODE": {
+		FuncInfo{Name: "HT_TRENDMODE", Group: groupCycle, Hint: "Hilbert Transform - Trend vs Cycle Mode", UnstablePeriod: true,
+			Inputs: []FuncInput{inReal}, Outputs: outInteger},
+		func(in [][]float64, p []float64) (int, [][]float64, error) {
+			outBegIdx, output, err := nativeHTTRENDMODE(in[0])
+			return outBegIdx, [][]float64{intsToFloats(output)}, err
+		},
+	},
+}
+
+// nativeFuncInfo 返回原生函数的描述。
+func nativeFuncInfo(name string) (*FuncInfo, error) {
+	f, ok := nativeFuncs[name]
+	if !ok {
+		return nil, &FuncError{Func: name, Code: RetFuncNotFound}
+	}
+	return &f.info, nil
+}
+
+// nativeFuncNames 返回所有原生函数的名称。
+func nativeFuncNames() []string {
+	names := make([]string, 0, len(nativeFuncs))
+	for name := range nativeFuncs {
+		names = append(names, name)
+	}
+	return names
+}
+
+// nativeCall 调用原生函数，info 须来自 nativeFuncInfo。
+func nativeCall(info *FuncInfo, inputs [][]float64, params []float64) (int, [][]float64, error) {
+	return nativeFuncs[info.Name].call(inputs, params)
+}
@@ -0,0 +1,105 @@
+package go4ta
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestCall(t *testing.T) {
+	b := testBars()
+	inputs := map[string][]float64{"open": b.Open, "high": b.High, "low": b.Low, "close": b.Close, "volume": b.Volume}
+
+	rsi, err := Call("RSI", inputs, map[string]float64{"timePeriod": 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := RSI(b.Close, 10)
+	if !equalFloats(rsi["Real"], want) {
+		t.Error("Call RSI differs from RSI")
+	}
+
+	// 参数名不区分大小写，可带 optIn 前缀，下划线可省略
+	stoch, err := Call("stoch", inputs, map[string]float64{"optInFastK_Period": 9, "slowkperiod": 3, "SlowD_Period": 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slowK, slowD, _ := STOCH(b.High, b.Low, b.Close, 9, 3, 3, 0, 0)
+	if !equalFloats(stoch["SlowK"], slowK) || !equalFloats(stoch["SlowD"], slowD) {
+		t.Error("Call STOCH differs from STOCH")
+	}
+
+	// 未给出的参数取 TA-Lib 的默认值
+	macd, err := Call("MACD", map[string][]float64{"real": b.Close}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, s, h, _ := MACD(b.Close, 12, 26, 9)
+	if !equalFloats(macd["MACD"], m) || !equalFloats(macd["MACDSignal"], s) || !equalFloats(macd["MACDHist"], h) {
+		t.Error("Call MACD with defaults differs from MACD(12, 26, 9)")
+	}
+
+	obv, err := b.Call("OBV", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantOBV, _ := OBV(b.Close, b.Volume)
+	if !equalFloats(obv["Real"], wantOBV) {
+		t.Error("Bars.Call OBV differs from OBV")
+	}
+
+	empty, err := Call("ATR", map[string][]float64{"high": nil, "low": nil, "close": nil}, nil)
+	if err != nil || empty["Real"] == nil || len(empty["Real"]) != 0 {
+		t.Errorf("Call on empty input = %v, %v", empty, err)
+	}
+}
+
+func TestCallErrors(t *testing.T) {
+	close := []float64{1, 2, 3, 4, 5}
+	closeOnly := map[string][]float64{"close": close}
+
+	if _, err := Call("NO_SUCH_FUNC", closeOnly, nil); !errors.Is(err, RetFuncNotFound) {
+		t.Errorf("unknown function: %v", err)
+	}
+	var fe *FuncError
+	if _, err := Call("ATR", closeOnly, nil); !errors.As(err, &fe) || fe.Code != RetInputNotAllInitialize || fe.Param != "inPriceHLC" {
+		t.Errorf("missing input: %v", err)
+	}
+	for _, params := range []map[string]float64{
+		{"noSuchParam": 1},
+		{"timePeriod": 1},   // RSI 的周期至少为 2
+		{"timePeriod": 2.5}, // 整型参数
+	} {
+		if _, err := Call("RSI", closeOnly, params); !errors.Is(err, ErrBadParam) {
+			t.Errorf("RSI %v: got %v, want ErrBadParam", params, err)
+		}
+	}
+	if _, err := Call("STDDEV", closeOnly, map[string]float64{"nbDev": math.NaN()}); !errors.As(err, &fe) || !errors.Is(err, ErrBadParam) || fe.Param != "optInNbDev" {
+		t.Errorf("STDDEV nbDev NaN: got %v, want ErrBadParam", err)
+	}
+	ragged := map[string][]float64{"high": close, "low": close[1:], "close": close}
+	if _, err := Call("ATR", ragged, nil); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("ragged inputs: %v", err)
+	}
+}
+
+func TestGetFuncInfo(t *testing.T) {
+	info, err := GetFuncInfo("stochrsi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name != "STOCHRSI" || !info.UnstablePeriod || len(info.Inputs) != 1 || len(info.Outputs) != 2 {
+		t.Errorf("STOCHRSI info = %+v", info)
+	}
+	if p := info.Params[0]; p.Name != "optInTimePeriod" || !p.Integer || p.Default != 14 || p.Min != 2 {
+		t.Errorf("STOCHRSI timePeriod = %+v", p)
+	}
+	atr, _ := GetFuncInfo("ATR")
+	if !slices.Equal(atr.Inputs[0].Price, []string{"high", "low", "close"}) {
+		t.Errorf("ATR inputs = %+v", atr.Inputs)
+	}
+	if names := FuncNames(); !slices.Contains(names, "BBANDS") || !slices.IsSorted(names) {
+		t.Errorf("FuncNames = %v", names)
+	}
+}
@@ -0,0 +1,50 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taAD 调用 TA_AD。
+func taAD(high, low, close, volume []float64) (int, []float64, error) {
+	defer readSettings()()
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	cVolume := (*C.double)(unsafe.Pointer(&volume[0]))
+	output := make([]C.double, len(high))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_AD(
+		0,
+		C.int(len(high)-1),
+		cHigh,
+		cLow,
+		cClose,
+		cVolume,
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		return 0, nil, taErr("TA_AD", RetCode(retCode), nil)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taADLookback 调用 TA_AD_Lookback，参数无效时返回 -1。
+func taADLookback() int {
+	defer readSettings()()
+	return int(C.TA_AD_Lookback())
+}
@@ -0,0 +1,23 @@
+package go4ta
+
+// nativeAD 是 TA_AD 的原生实现。
+func nativeAD(high, low, close, volume []float64) (int, []float64, error) {
+	output := make([]float64, len(high))
+	ad := 0.0
+	for i := range high {
+		h := high[i]
+		l := low[i]
+		tmp := h - l
+		c := close[i]
+		if tmp > 0.0 {
+			ad += (((c - l) - (h - c)) / tmp) * volume[i]
+		}
+		output[i] = ad
+	}
+	return 0, output, nil
+}
+
+// nativeADLookback 对应 TA_AD_Lookback，参数无效时返回 -1。
+func nativeADLookback() int {
+	return 0
+}
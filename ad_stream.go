@@ -0,0 +1,66 @@
+package go4ta
+
+// ADStream 是 AD 的流式版本，从第一根价格柱起即有效。
+type ADStream struct {
+	state adState
+	value float64
+	ready bool
+}
+
+// NewADStream 创建累积/派发线流式指标。
+func NewADStream() *ADStream {
+	return &ADStream{}
+}
+
+// Update 输入一根新的价格柱及其成交量，返回最新的 AD。
+func (s *ADStream) Update(high, low, close, volume float64) float64 {
+	s.value, s.ready = s.state.update(high, low, close, volume)
+	return s.value
+}
+
+// Value 返回最近一次 Update 的结果。
+func (s *ADStream) Value() float64 {
+	return s.value
+}
+
+// Ready 表示是否已经输入过价格柱。
+func (s *ADStream) Ready() bool {
+	return s.ready
+}
+
+func (s *ADStream) snapshot() *streamSnapshot {
+	return &streamSnapshot{kind: "AD", values: []*float64{&s.value}, ready: &s.ready, state: &s.state}
+}
+
+// MarshalBinary 将完整的计算状态编码为二进制快照。
+func (s *ADStream) MarshalBinary() ([]byte, error) {
+	return s.snapshot().marshalBinary()
+}
+
+// UnmarshalBinary 从 MarshalBinary 生成的快照恢复状态。
+func (s *ADStream) UnmarshalBinary(data []byte) error {
+	return s.snapshot().unmarshalBinary(data)
+}
+
+// MarshalJSON 将完整的计算状态编码为 JSON 快照。
+func (s *ADStream) MarshalJSON() ([]byte, error) {
+	return s.snapshot().marshalJSON()
+}
+
+// UnmarshalJSON 从 MarshalJSON 生成的快照恢复状态。
+func (s *ADStream) UnmarshalJSON(data []byte) error {
+	return s.snapshot().unmarshalJSON(data)
+}
+
+// adState 对应 nativeAD 中的累加值。
+type adState struct {
+	AD float64
+}
+
+func (s *adState) update(high, low, close, volume float64) (float64, bool) {
+	tmp := high - low
+	if tmp > 0.0 {
+		s.AD += (((close - low) - (high - close)) / tmp) * volume
+	}
+	return s.AD, true
+}
@@ -0,0 +1,49 @@
+package go4ta
+
+// ADOSC 计算佳庆振荡器（Chaikin A/D Oscillator），即 AD 线的快速 EMA 与慢速 EMA 之差。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param volume     - 成交量序列
+// @param fastPeriod - 快速 EMA 周期（如3）
+// @param slowPeriod - 慢速 EMA 周期（如10）
+// @return []float64 - ADOSC 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func ADOSC(high, low, close, volume []float64, fastPeriod, slowPeriod int) ([]float64, error) {
+	n, err := checkInputs("ADOSC", "high, low, close, volume", high, low, close, volume)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < fastPeriod {
+		return nil, tooShort("ADOSC", n, "fastPeriod", fastPeriod)
+	}
+	if n < slowPeriod {
+		return nil, tooShort("ADOSC", n, "slowPeriod", slowPeriod)
+	}
+
+	if err := checkParams("ADOSC", float64(fastPeriod), float64(slowPeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taADOSC(high, low, close, volume, fastPeriod, slowPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// ADOSCLookback 返回 ADOSC 在给定参数下的回看期，即两个周期中较长者的 EMA 回看期。
+//
+// @param fastPeriod - 快速 EMA 周期
+// @param slowPeriod - 慢速 EMA 周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func ADOSCLookback(fastPeriod, slowPeriod int) (int, error) {
+	_, _, err := adoscParams(fastPeriod, slowPeriod)
+	return lookbackResult("TA_ADOSC", taADOSCLookback(fastPeriod, slowPeriod), err)
+}
@@ -0,0 +1,53 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taADOSC 调用 TA_ADOSC。
+func taADOSC(high, low, close, volume []float64, fastPeriod, slowPeriod int) (int, []float64, error) {
+	defer readSettings()()
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	cVolume := (*C.double)(unsafe.Pointer(&volume[0]))
+	output := make([]C.double, len(high))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_ADOSC(
+		0,
+		C.int(len(high)-1),
+		cHigh,
+		cLow,
+		cClose,
+		cVolume,
+		C.int(fastPeriod),
+		C.int(slowPeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, paramErr := adoscParams(fastPeriod, slowPeriod)
+		return 0, nil, taErr("TA_ADOSC", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taADOSCLookback 调用 TA_ADOSC_Lookback，参数无效时返回 -1。
+func taADOSCLookback(fastPeriod, slowPeriod int) int {
+	defer readSettings()()
+	return int(C.TA_ADOSC_Lookback(C.int(fastPeriod), C.int(slowPeriod)))
+}
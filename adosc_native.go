@@ -0,0 +1,70 @@
+package go4ta
+
+// nativeADOSC 是 TA_ADOSC 的原生实现。
+func nativeADOSC(high, low, close, volume []float64, fastPeriod, slowPeriod int) (int, []float64, error) {
+	fastPeriod, slowPeriod, err := adoscParams(fastPeriod, slowPeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	lookbackTotal := adoscLookback(fastPeriod, slowPeriod)
+	startIdx := lookbackTotal
+	if startIdx > len(high)-1 {
+		return 0, nil, nil
+	}
+
+	// 与 TA-Lib 相同，两条 EMA 都以第一根的 AD 值为种子，不受兼容模式影响
+	today := startIdx - lookbackTotal
+	ad := 0.0
+	nextAD := func() float64 {
+		h := high[today]
+		l := low[today]
+		tmp := h - l
+		c := close[today]
+		if tmp > 0.0 {
+			ad += (((c - l) - (h - c)) / tmp) * volume[today]
+		}
+		today++
+		return ad
+	}
+
+	fastK := perToK(fastPeriod)
+	slowK := perToK(slowPeriod)
+	fastEMA := nextAD()
+	slowEMA := fastEMA
+	for today < startIdx {
+		v := nextAD()
+		fastEMA = fastK*v + (1.0-fastK)*fastEMA
+		slowEMA = slowK*v + (1.0-slowK)*slowEMA
+	}
+
+	output := make([]float64, len(high)-startIdx)
+	for outIdx := range output {
+		v := nextAD()
+		fastEMA = fastK*v + (1.0-fastK)*fastEMA
+		slowEMA = slowK*v + (1.0-slowK)*slowEMA
+		output[outIdx] = fastEMA - slowEMA
+	}
+	return startIdx, output, nil
+}
+
+func adoscLookback(fastPeriod, slowPeriod int) int {
+	return emaLookback(max(fastPeriod, slowPeriod))
+}
+
+// nativeADOSCLookback 对应 TA_ADOSC_Lookback，参数无效时返回 -1。
+func nativeADOSCLookback(fastPeriod, slowPeriod int) int {
+	fastPeriod, slowPeriod, err := adoscParams(fastPeriod, slowPeriod)
+	if err != nil {
+		return -1
+	}
+	return adoscLookback(fastPeriod, slowPeriod)
+}
+
+// adoscParams 按 TA_ADOSC 的规则处理参数。
+func adoscParams(fastPeriod, slowPeriod int) (int, int, error) {
+	c := paramCheck{fn: "TA_ADOSC"}
+	fastPeriod = c.integer("fastPeriod", fastPeriod, 3, 2, 100000)
+	slowPeriod = c.integer("slowPeriod", slowPeriod, 10, 2, 100000)
+	return fastPeriod, slowPeriod, c.err
+}
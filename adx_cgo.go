@@ -0,0 +1,55 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taADX 调用 TA_ADX。
+func taADX(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	// --- 准备 C 语言格式的输入数据 ---
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+
+	// --- 准备 C 语言格式的输出缓冲区 ---
+	output := make([]C.double, len(high))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	// --- 准备用于接收 TA-Lib 输出元数据的变量 ---
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	// --- 调用 C 函数 ---
+	retCode := C.TA_ADX(
+		0,                  // startIdx
+		C.int(len(high)-1), // endIdx
+		cHigh,              // inHigh
+		cLow,               // inLow
+		cClose,             // inClose
+		C.int(timePeriod),  // optInTimePeriod
+		&outBegIdx,         // outBegIdx
+		&outNBElement,      // outNBElement
+		cOutput,            // outReal
+	)
+
+	// --- 检查 C 函数调用结果 ---
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := adxParams(timePeriod)
+		return 0, nil, taErr("TA_ADX", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taADXLookback 调用 TA_ADX_Lookback，参数无效时返回 -1。
+func taADXLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_ADX_Lookback(C.int(timePeriod)))
+}
@@ -0,0 +1,161 @@
+package go4ta
+
+import "math"
+
+// nativeADX 是 TA_ADX 的原生实现。
+func nativeADX(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := adxParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intADX(high, low, close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+func adxLookback(timePeriod int) int {
+	return (2 * timePeriod) - 1 + unstablePeriod(FuncUnstADX)
+}
+
+// dmState 保存 Wilder 平滑后的 +DM、-DM 与真实波幅，对应 TA-Lib 中 prevPlusDM/prevMinusDM/prevTR 等变量。
+// 第一根价格柱只记录前值，之后 timePeriod-1 根累加初始值，再往后按 Wilder 方式平滑。
+type dmState struct {
+	Period      int
+	Count       int
+	PrevHigh    float64
+	PrevLow     float64
+	PrevClose   float64
+	PrevPlusDM  float64
+	PrevMinusDM float64
+	PrevTR      float64
+}
+
+func newDMState(timePeriod int) *dmState {
+	return &dmState{Period: timePeriod}
+}
+
+// update 处理一根价格柱，返回值表示累加阶段是否已经结束。
+func (s *dmState) update(high, low, close float64) bool {
+	s.Count++
+	if s.Count == 1 {
+		s.PrevHigh = high
+		s.PrevLow = low
+		s.PrevClose = close
+		return s.Period == 1
+	}
+
+	diffP := high - s.PrevHigh
+	s.PrevHigh = high
+	diffM := s.PrevLow - low
+	s.PrevLow = low
+
+	period := float64(s.Period)
+	smoothing := s.Count > s.Period
+	if smoothing {
+		s.PrevMinusDM -= s.PrevMinusDM / period
+		s.PrevPlusDM -= s.PrevPlusDM / period
+	}
+	if diffM > 0 && diffP < diffM {
+		s.PrevMinusDM += diffM
+	} else if diffP > 0 && diffP > diffM {
+		s.PrevPlusDM += diffP
+	}
+	if smoothing {
+		s.PrevTR = s.PrevTR - (s.PrevTR / period) + trueRange(s.PrevHigh, s.PrevLow, s.PrevClose)
+	} else {
+		s.PrevTR += trueRange(s.PrevHigh, s.PrevLow, s.PrevClose)
+	}
+	s.PrevClose = close
+	return s.Count >= s.Period
+}
+
+// di 返回当前的 +DI 与 -DI，TR 为零时均为0。
+func (s *dmState) di() (float64, float64) {
+	if isZero(s.PrevTR) {
+		return 0, 0
+	}
+	return 100.0 * (s.PrevPlusDM / s.PrevTR), 100.0 * (s.PrevMinusDM / s.PrevTR)
+}
+
+// dx 返回当前的 DX，ok 为 false 表示 TR 或 DI 之和为零而无法计算。
+func (s *dmState) dx() (float64, bool) {
+	if isZero(s.PrevTR) {
+		return 0, false
+	}
+	minusDI := 100.0 * (s.PrevMinusDM / s.PrevTR)
+	plusDI := 100.0 * (s.PrevPlusDM / s.PrevTR)
+	tempReal := minusDI + plusDI
+	if isZero(tempReal) {
+		return 0, false
+	}
+	return 100.0 * (math.Abs(minusDI-plusDI) / tempReal), true
+}
+
+// adxState 在 dmState 的基础上先累加 timePeriod 个 DX 作为第一个 ADX，之后按 Wilder 方式平滑。
+type adxState struct {
+	DM      dmState
+	Count   int // 已累加的 DX 个数
+	PrevADX float64
+}
+
+func newADXState(timePeriod int) *adxState {
+	return &adxState{DM: *newDMState(timePeriod)}
+}
+
+func (s *adxState) update(high, low, close float64) (float64, bool) {
+	// 累加阶段结束后的那一根才开始平滑，产生第一个 DX
+	s.DM.update(high, low, close)
+	if s.DM.Count <= s.DM.Period {
+		return 0, false
+	}
+	period := float64(s.DM.Period)
+	dx, ok := s.DM.dx()
+	if s.Count < s.DM.Period {
+		s.Count++
+		if ok {
+			s.PrevADX += dx
+		}
+		if s.Count < s.DM.Period {
+			return 0, false
+		}
+		s.PrevADX /= period
+		return s.PrevADX, true
+	}
+	if ok {
+		s.PrevADX = ((s.PrevADX * (period - 1)) + dx) / period
+	}
+	return s.PrevADX, true
+}
+
+func intADX(high, low, close []float64, timePeriod int) (int, []float64) {
+	lookbackTotal := adxLookback(timePeriod)
+	startIdx := lookbackTotal
+	if startIdx > len(high)-1 {
+		return 0, nil
+	}
+
+	s := newADXState(timePeriod)
+	output := make([]float64, 0, len(high)-startIdx)
+	for today := range high {
+		// 不稳定期内照常递推，只是不输出
+		if adx, ok := s.update(high[today], low[today], close[today]); ok && today >= startIdx {
+			output = append(output, adx)
+		}
+	}
+	return startIdx, output
+}
+
+// nativeADXLookback 对应 TA_ADX_Lookback，参数无效时返回 -1。
+func nativeADXLookback(timePeriod int) int {
+	timePeriod, err := adxParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return adxLookback(timePeriod)
+}
+
+// adxParams 按 TA_ADX 的规则处理参数。
+func adxParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_ADX"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,61 @@
+package go4ta
+
+// ADXStream 是 ADX 的流式版本。
+type ADXStream struct {
+	state adxState
+	value float64
+	ready bool
+}
+
+// NewADXStream 创建平均趋向指数流式指标。
+//
+// @param timePeriod  - 计算周期 (例如 14)
+// @return *ADXStream - 流式指标
+// @return error      - 参数无效时返回错误
+func NewADXStream(timePeriod int) (*ADXStream, error) {
+	p, ok := optInteger(timePeriod, 14, 2, 100000)
+	if !ok {
+		return nil, badParam("NewADXStream", "timePeriod", timePeriod)
+	}
+	return &ADXStream{state: *newADXState(p)}, nil
+}
+
+// Update 输入一根新的价格柱，返回最新的 ADX，未就绪时返回 0。
+func (s *ADXStream) Update(high, low, close float64) float64 {
+	s.value, s.ready = s.state.update(high, low, close)
+	return s.value
+}
+
+// Value 返回最近一次 Update 的结果。
+func (s *ADXStream) Value() float64 {
+	return s.value
+}
+
+// Ready 表示当前值是否已越过回看期。
+func (s *ADXStream) Ready() bool {
+	return s.ready
+}
+
+func (s *ADXStream) snapshot() *streamSnapshot {
+	return &streamSnapshot{kind: "ADX", values: []*float64{&s.value}, ready: &s.ready, state: &s.state}
+}
+
+// MarshalBinary 将完整的计算状态编码为二进制快照。
+func (s *ADXStream) MarshalBinary() ([]byte, error) {
+	return s.snapshot().marshalBinary()
+}
+
+// UnmarshalBinary 从 MarshalBinary 生成的快照恢复状态。
+func (s *ADXStream) UnmarshalBinary(data []byte) error {
+	return s.snapshot().unmarshalBinary(data)
+}
+
+// MarshalJSON 将完整的计算状态编码为 JSON 快照。
+func (s *ADXStream) MarshalJSON() ([]byte, error) {
+	return s.snapshot().marshalJSON()
+}
+
+// UnmarshalJSON 从 MarshalJSON 生成的快照恢复状态。
+func (s *ADXStream) UnmarshalJSON(data []byte) error {
+	return s.snapshot().unmarshalJSON(data)
+}
@@ -0,0 +1,44 @@
+package go4ta
+
+// ADXR 计算平均趋向指数评估（ADXR），对应 TA-Lib 的 ADXR。
+// ADXR 为当前 ADX 与 timePeriod-1 根之前的 ADX 的平均值。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - ADXR结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func ADXR(high, low, close []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("ADXR", "high, low, close", high, low, close)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("ADXR", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("ADXR", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taADXR(high, low, close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// ADXRLookback 返回 ADXR 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func ADXRLookback(timePeriod int) (int, error) {
+	_, err := adxrParams(timePeriod)
+	return lookbackResult("TA_ADXR", taADXRLookback(timePeriod), err)
+}
@@ -0,0 +1,44 @@
+package go4ta
+
+// nativeADXR 是 TA_ADXR 的原生实现。
+func nativeADXR(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := adxrParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	adxBegIdx, adx := intADX(high, low, close, timePeriod)
+	outBegIdx, output := intADXR(adxBegIdx, adx, timePeriod)
+	return outBegIdx, output, nil
+}
+
+func adxrLookback(timePeriod int) int {
+	return timePeriod + adxLookback(timePeriod) - 1
+}
+
+// intADXR 由 intADX 的结果计算 ADXR，即当前 ADX 与 timePeriod-1 根之前的 ADX 的平均值。
+func intADXR(adxBegIdx int, adx []float64, timePeriod int) (int, []float64) {
+	if len(adx) < timePeriod {
+		return 0, nil
+	}
+	output := make([]float64, 0, len(adx)-(timePeriod-1))
+	for i, j := timePeriod-1, 0; i < len(adx); i, j = i+1, j+1 {
+		output = append(output, (adx[i]+adx[j])/2.0)
+	}
+	return adxBegIdx + timePeriod - 1, output
+}
+
+// nativeADXRLookback 对应 TA_ADXR_Lookback，参数无效时返回 -1。
+func nativeADXRLookback(timePeriod int) int {
+	timePeriod, err := adxrParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return adxrLookback(timePeriod)
+}
+
+// adxrParams 按 TA_ADXR 的规则处理参数。
+func adxrParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_ADXR"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,48 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taAPO 调用 TA_APO。
+func taAPO(close []float64, fastPeriod, slowPeriod, maType int) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_APO(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(fastPeriod),
+		C.int(slowPeriod),
+		C.TA_MAType(maType),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, _, paramErr := apoParams(fastPeriod, slowPeriod, maType)
+		return 0, nil, taErr("TA_APO", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taAPOLookback 调用 TA_APO_Lookback，参数无效时返回 -1。
+func taAPOLookback(fastPeriod, slowPeriod, maType int) int {
+	defer readSettings()()
+	return int(C.TA_APO_Lookback(C.int(fastPeriod), C.int(slowPeriod), C.TA_MAType(maType)))
+}
@@ -0,0 +1,65 @@
+package go4ta
+
+// nativeAPO 是 TA_APO 的原生实现。
+func nativeAPO(close []float64, fastPeriod, slowPeriod, maType int) (int, []float64, error) {
+	fastPeriod, slowPeriod, maType, err := apoParams(fastPeriod, slowPeriod, maType)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intPO(close, fastPeriod, slowPeriod, maType, false)
+	return outBegIdx, output, nil
+}
+
+func poLookback(fastPeriod, slowPeriod, maType int) int {
+	if slowPeriod < fastPeriod {
+		slowPeriod = fastPeriod
+	}
+	return maLookback(slowPeriod, maType)
+}
+
+// intPO 对应 TA_INT_PO，APO 与 PPO 共用：percentage 为 true 时输出百分比差值。
+func intPO(in []float64, fastPeriod, slowPeriod, maType int, percentage bool) (int, []float64) {
+	if slowPeriod < fastPeriod {
+		slowPeriod, fastPeriod = fastPeriod, slowPeriod
+	}
+
+	outBegIdx2, fastMA := intMA(in, fastPeriod, maType)
+	outBegIdx1, output := intMA(in, slowPeriod, maType)
+	if len(output) == 0 {
+		return 0, nil
+	}
+
+	j := outBegIdx1 - outBegIdx2
+	for i := range output {
+		tempReal := output[i]
+		if percentage {
+			if !isZero(tempReal) {
+				output[i] = ((fastMA[j] - tempReal) / tempReal) * 100.0
+			} else {
+				output[i] = 0.0
+			}
+		} else {
+			output[i] = fastMA[j] - tempReal
+		}
+		j++
+	}
+	return outBegIdx1, output
+}
+
+// nativeAPOLookback 对应 TA_APO_Lookback，参数无效时返回 -1。
+func nativeAPOLookback(fastPeriod, slowPeriod, maType int) int {
+	fastPeriod, slowPeriod, maType, err := apoParams(fastPeriod, slowPeriod, maType)
+	if err != nil {
+		return -1
+	}
+	return poLookback(fastPeriod, slowPeriod, maType)
+}
+
+// apoParams 按 TA_APO 的规则处理参数。
+func apoParams(fastPeriod, slowPeriod, maType int) (int, int, int, error) {
+	c := paramCheck{fn: "TA_APO"}
+	fastPeriod = c.integer("fastPeriod", fastPeriod, 12, 2, 100000)
+	slowPeriod = c.integer("slowPeriod", slowPeriod, 26, 2, 100000)
+	maType = c.maType("maType", maType)
+	return fastPeriod, slowPeriod, maType, c.err
+}
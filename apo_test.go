@@ -43,7 +43,7 @@ func TestAPO(t *testing.T) {
 		}
 	}
 
-	fastPeriod, slowPeriod, maType := 12, 26, 0
+	fastPeriod, slowPeriod, maType := 12, 26, MATypeSMA
 	result, err := APO(closeVals, fastPeriod, slowPeriod, maType)
 	if err != nil {
 		t.Fatalf("APO计算失败: %v", err)
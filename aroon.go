@@ -0,0 +1,59 @@
+package go4ta
+
+// Aroon 计算阿隆指标，按位置返回各输出，结果含义见 CalcAroon。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param timePeriod - 计算周期（如14）
+// @return down, up  - 两个与输入等长的结果序列
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func Aroon(high, low []float64, timePeriod int) (down, up []float64, err error) {
+	r, err := CalcAroon(high, low, timePeriod)
+	return r.Down, r.Up, err
+}
+
+// CalcAroon 计算阿隆指标（Aroon）：Up 与 Down 分别由最近 timePeriod+1 根中最高价、最低价出现的位置得出，
+// 当根创出新高（新低）时为100，极值出现在窗口最早一根时为0。
+//
+// @param high        - 最高价序列
+// @param low         - 最低价序列
+// @param timePeriod  - 计算周期（如14）
+// @return AroonResult - Aroon Down 与 Aroon Up，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error      - 如果输入数据无效或 C 库调用失败，则返回错误。
+func CalcAroon(high, low []float64, timePeriod int) (AroonResult, error) {
+	n, err := checkInputs("Aroon", "high, low", high, low)
+	if err != nil {
+		return AroonResult{}, err
+	}
+	if n == 0 {
+		return AroonResult{[]float64{}, []float64{}}, nil
+	}
+	if n < timePeriod {
+		return AroonResult{}, tooShort("Aroon", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("AROON", float64(timePeriod)); err != nil {
+		return AroonResult{}, err
+	}
+
+	outBegIdx, outDown, outUp, err := taAROON(high, low, timePeriod)
+	if err != nil {
+		return AroonResult{}, err
+	}
+
+	w := newWarmup(0)
+	return AroonResult{
+		Down: w.spread(n, outBegIdx, outDown),
+		Up:   w.spread(n, outBegIdx, outUp),
+	}, nil
+}
+
+// AroonLookback 返回 Aroon 在给定参数下的回看期，即 timePeriod。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func AroonLookback(timePeriod int) (int, error) {
+	_, err := aroonParams(timePeriod, "TA_AROON")
+	return lookbackResult("TA_AROON", taAROONLookback(timePeriod), err)
+}
@@ -0,0 +1,51 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taAROON 调用 TA_AROON。
+func taAROON(high, low []float64, timePeriod int) (int, []float64, []float64, error) {
+	defer readSettings()()
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	outDown := make([]C.double, len(high))
+	outUp := make([]C.double, len(high))
+	cOutDown := (*C.double)(unsafe.Pointer(&outDown[0]))
+	cOutUp := (*C.double)(unsafe.Pointer(&outUp[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_AROON(
+		0,
+		C.int(len(high)-1),
+		cHigh,
+		cLow,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutDown,
+		cOutUp,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := aroonParams(timePeriod, "TA_AROON")
+		return 0, nil, nil, taErr("TA_AROON", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(outDown, outNBElement), fromC(outUp, outNBElement), nil
+}
+
+// taAROONLookback 调用 TA_AROON_Lookback，参数无效时返回 -1。
+func taAROONLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_AROON_Lookback(C.int(timePeriod)))
+}
@@ -0,0 +1,80 @@
+package go4ta
+
+// nativeAROON 是 TA_AROON 的原生实现，计算见 intAroon。
+func nativeAROON(high, low []float64, timePeriod int) (int, []float64, []float64, error) {
+	timePeriod, err := aroonParams(timePeriod, "TA_AROON")
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	factor := 100.0 / float64(timePeriod)
+	var outDown, outUp []float64
+	outBegIdx := intAroon(high, low, timePeriod, func(highestIdx, lowestIdx, today int) {
+		outDown = append(outDown, factor*float64(timePeriod-(today-lowestIdx)))
+		outUp = append(outUp, factor*float64(timePeriod-(today-highestIdx)))
+	})
+	return outBegIdx, outDown, outUp, nil
+}
+
+// nativeAROONLookback 对应 TA_AROON_Lookback，参数无效时返回 -1。
+func nativeAROONLookback(timePeriod int) int {
+	timePeriod, err := aroonParams(timePeriod, "TA_AROON")
+	if err != nil {
+		return -1
+	}
+	return timePeriod
+}
+
+// aroonParams 按 TA_AROON 与 TA_AROONOSC 的规则处理参数，fn 为报错时使用的函数名。
+func aroonParams(timePeriod int, fn string) (int, error) {
+	c := paramCheck{fn: fn}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
+
+// intAroon 对应 TA_AROON 与 TA_AROONOSC 共同的计算，对每根价格柱以最近 timePeriod+1 根中
+// 最高价、最低价所在的下标调用 emit，极值相同时取最近的一根；返回第一个输出的下标。
+// 与 TA-Lib 相同，只在极值移出窗口时才重新扫描。
+func intAroon(high, low []float64, timePeriod int, emit func(highestIdx, lowestIdx, today int)) int {
+	startIdx := timePeriod
+	if startIdx > len(high)-1 {
+		return 0
+	}
+
+	lowestIdx, highestIdx := -1, -1
+	lowest, highest := 0.0, 0.0
+	trailingIdx := startIdx - timePeriod
+	for today := startIdx; today < len(high); today++ {
+		if tmp := low[today]; lowestIdx < trailingIdx {
+			lowestIdx = trailingIdx
+			lowest = low[lowestIdx]
+			for i := lowestIdx + 1; i <= today; i++ {
+				if low[i] <= lowest {
+					lowestIdx = i
+					lowest = low[i]
+				}
+			}
+		} else if tmp <= lowest {
+			lowestIdx = today
+			lowest = tmp
+		}
+
+		if tmp := high[today]; highestIdx < trailingIdx {
+			highestIdx = trailingIdx
+			highest = high[highestIdx]
+			for i := highestIdx + 1; i <= today; i++ {
+				if high[i] >= highest {
+					highestIdx = i
+					highest = high[i]
+				}
+			}
+		} else if tmp >= highest {
+			highestIdx = today
+			highest = tmp
+		}
+
+		emit(highestIdx, lowestIdx, today)
+		trailingIdx++
+	}
+	return startIdx
+}
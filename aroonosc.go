@@ -0,0 +1,42 @@
+package go4ta
+
+// AroonOsc 计算阿隆振荡器（Aroon Oscillator），即 Aroon Up 减 Aroon Down，取值在 -100 到 100 之间。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func AroonOsc(high, low []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("AroonOsc", "high, low", high, low)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("AroonOsc", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("AROONOSC", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taAROONOSC(high, low, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// AroonOscLookback 返回 AroonOsc 在给定参数下的回看期，即 timePeriod。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func AroonOscLookback(timePeriod int) (int, error) {
+	_, err := aroonParams(timePeriod, "TA_AROONOSC")
+	return lookbackResult("TA_AROONOSC", taAROONOSCLookback(timePeriod), err)
+}
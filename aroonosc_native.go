@@ -0,0 +1,26 @@
+package go4ta
+
+// nativeAROONOSC 是 TA_AROONOSC 的原生实现，计算见 intAroon。
+func nativeAROONOSC(high, low []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := aroonParams(timePeriod, "TA_AROONOSC")
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// 与 TA-Lib 相同，直接由两个极值的下标之差得出，而不是分别计算 Up 与 Down 再相减
+	factor := 100.0 / float64(timePeriod)
+	var output []float64
+	outBegIdx := intAroon(high, low, timePeriod, func(highestIdx, lowestIdx, _ int) {
+		output = append(output, factor*float64(highestIdx-lowestIdx))
+	})
+	return outBegIdx, output, nil
+}
+
+// nativeAROONOSCLookback 对应 TA_AROONOSC_Lookback，参数无效时返回 -1。
+func nativeAROONOSCLookback(timePeriod int) int {
+	timePeriod, err := aroonParams(timePeriod, "TA_AROONOSC")
+	if err != nil {
+		return -1
+	}
+	return timePeriod
+}
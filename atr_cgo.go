@@ -0,0 +1,62 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taATR 调用 TA_ATR。
+//
+// TA-Lib 的输出结果是从 output[0] 开始填充的，共 outNBElement 个。
+// outBegIdx 指明了第一个有效结果对应于输入序列的哪个位置。
+// 例如，如果 outBegIdx 是 14，那么 output[0] 的值应该放到 result[14] 的位置。
+func taATR(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	// --- 准备 C 语言格式的输入数据 ---
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+
+	// --- 准备 C 语言格式的输出缓冲区 ---
+	// TA-Lib 会将结果写入我们提供的缓冲区
+	output := make([]C.double, len(high))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	// --- 准备用于接收 TA-Lib 输出元数据的变量 ---
+	// outBegIdx 会告诉我们有效数据是从哪个索引开始的
+	// outNBElement 会告诉我们输出了多少个有效数据点
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	// --- 调用 C 函数 ---
+	retCode := C.TA_ATR(
+		0,                  // startIdx: 从输入数据的第一个元素开始
+		C.int(len(high)-1), // endIdx: 到输入数据的最后一个元素结束
+		cHigh,              // inHigh
+		cLow,               // inLow
+		cClose,             // inClose
+		C.int(timePeriod),  // optInTimePeriod
+		&outBegIdx,         // outBegIdx (输出参数)
+		&outNBElement,      // outNBElement (输出参数)
+		cOutput,            // outReal (输出缓冲区)
+	)
+
+	// --- 检查 C 函数调用结果 ---
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := atrParams(timePeriod)
+		return 0, nil, taErr("TA_ATR", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taATRLookback 调用 TA_ATR_Lookback，参数无效时返回 -1。
+func taATRLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_ATR_Lookback(C.int(timePeriod)))
+}
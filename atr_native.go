@@ -0,0 +1,89 @@
+package go4ta
+
+// nativeATR 是 TA_ATR 的原生实现。
+func nativeATR(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := atrParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intATR(high, low, close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+func atrLookback(timePeriod int) int {
+	return timePeriod + unstablePeriod(FuncUnstATR)
+}
+
+func intATR(high, low, close []float64, timePeriod int) (int, []float64) {
+	return intWilderTR(high, low, close, timePeriod, FuncUnstATR)
+}
+
+// intWilderTR 以 Wilder 方式平滑真实波幅，ATR 与 NATR 共用，只在不稳定期的标识上不同。
+func intWilderTR(high, low, close []float64, timePeriod int, id FuncUnstID) (int, []float64) {
+	lookbackTotal := timePeriod + unstablePeriod(id)
+	startIdx := lookbackTotal
+	if startIdx > len(high)-1 {
+		return 0, nil
+	}
+
+	// 周期为1时 ATR 退化为真实波幅，与 TA-Lib 一样从 startIdx 开始输出
+	if timePeriod <= 1 {
+		_, tr := intTRANGE(high, low, close)
+		return startIdx, tr[startIdx-1:]
+	}
+
+	_, tr := intTRANGE(high, low, close)
+	period := float64(timePeriod)
+
+	// 第一个 ATR 是前 timePeriod 个真实波幅的简单平均
+	_, seed := intSMA(tr[:timePeriod], timePeriod)
+	prevATR := seed[0]
+	today := timePeriod
+
+	// 跳过不稳定期
+	for i := unstablePeriod(id); i > 0; i-- {
+		prevATR *= period - 1
+		prevATR += tr[today]
+		today++
+		prevATR /= period
+	}
+
+	output := make([]float64, 0, len(high)-startIdx)
+	output = append(output, prevATR)
+	for nbATR := len(high) - startIdx; nbATR > 1; nbATR-- {
+		prevATR *= period - 1
+		prevATR += tr[today]
+		today++
+		prevATR /= period
+		output = append(output, prevATR)
+	}
+	return startIdx, output
+}
+
+// intTRANGE 对应 TA_TRANGE，第一个有效值位于下标1。
+func intTRANGE(high, low, close []float64) (int, []float64) {
+	if len(high) < 2 {
+		return 0, nil
+	}
+	output := make([]float64, 0, len(high)-1)
+	for today := 1; today < len(high); today++ {
+		output = append(output, trueRange(high[today], low[today], close[today-1]))
+	}
+	return 1, output
+}
+
+// nativeATRLookback 对应 TA_ATR_Lookback，参数无效时返回 -1。
+func nativeATRLookback(timePeriod int) int {
+	timePeriod, err := atrParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return atrLookback(timePeriod)
+}
+
+// atrParams 按 TA_ATR 的规则处理参数。
+func atrParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_ATR"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 1, 100000)
+	return timePeriod, c.err
+}
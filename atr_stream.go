@@ -0,0 +1,97 @@
+package go4ta
+
+// ATRStream 是 ATR 的流式版本。
+type ATRStream struct {
+	state atrState
+	value float64
+	ready bool
+}
+
+// NewATRStream 创建平均真实波幅流式指标。
+//
+// @param timePeriod  - 计算周期 (例如 14)
+// @return *ATRStream - 流式指标
+// @return error      - 参数无效时返回错误
+func NewATRStream(timePeriod int) (*ATRStream, error) {
+	p, ok := optInteger(timePeriod, 14, 1, 100000)
+	if !ok {
+		return nil, badParam("NewATRStream", "timePeriod", timePeriod)
+	}
+	return &ATRStream{state: atrState{Period: p}}, nil
+}
+
+// Update 输入一根新的价格柱，返回最新的 ATR，未就绪时返回 0。
+func (s *ATRStream) Update(high, low, close float64) float64 {
+	s.value, s.ready = s.state.update(high, low, close)
+	return s.value
+}
+
+// Value 返回最近一次 Update 的结果。
+func (s *ATRStream) Value() float64 {
+	return s.value
+}
+
+// Ready 表示当前值是否已越过回看期。
+func (s *ATRStream) Ready() bool {
+	return s.ready
+}
+
+func (s *ATRStream) snapshot() *streamSnapshot {
+	return &streamSnapshot{kind: "ATR", values: []*float64{&s.value}, ready: &s.ready, state: &s.state}
+}
+
+// MarshalBinary 将完整的计算状态编码为二进制快照。
+func (s *ATRStream) MarshalBinary() ([]byte, error) {
+	return s.snapshot().marshalBinary()
+}
+
+// UnmarshalBinary 从 MarshalBinary 生成的快照恢复状态。
+func (s *ATRStream) UnmarshalBinary(data []byte) error {
+	return s.snapshot().unmarshalBinary(data)
+}
+
+// MarshalJSON 将完整的计算状态编码为 JSON 快照。
+func (s *ATRStream) MarshalJSON() ([]byte, error) {
+	return s.snapshot().marshalJSON()
+}
+
+// UnmarshalJSON 从 MarshalJSON 生成的快照恢复状态。
+func (s *ATRStream) UnmarshalJSON(data []byte) error {
+	return s.snapshot().unmarshalJSON(data)
+}
+
+// atrState 对应 intATR：第一个 ATR 是前 timePeriod 个真实波幅的平均，之后按 Wilder 方式平滑。
+type atrState struct {
+	Period    int
+	Count     int
+	PrevClose float64
+	PrevATR   float64
+}
+
+func (s *atrState) update(high, low, close float64) (float64, bool) {
+	s.Count++
+	if s.Count == 1 {
+		s.PrevClose = close
+		return 0, false
+	}
+	tr := trueRange(high, low, s.PrevClose)
+	s.PrevClose = close
+
+	// 周期为1时 ATR 退化为真实波幅
+	if s.Period <= 1 {
+		return tr, true
+	}
+	period := float64(s.Period)
+	if s.Count <= s.Period+1 {
+		s.PrevATR += tr
+		if s.Count <= s.Period {
+			return 0, false
+		}
+		s.PrevATR /= period
+		return s.PrevATR, true
+	}
+	s.PrevATR *= period - 1
+	s.PrevATR += tr
+	s.PrevATR /= period
+	return s.PrevATR, true
+}
@@ -0,0 +1,10 @@
+package go4ta
+
+// lookbackResult 将 TA_*_Lookback 的返回值转换为导出函数的结果。-1 表示参数无效，
+// paramErr 是 Go 侧按同样规则检查参数的结果，用于指出具体是哪个参数。
+func lookbackResult(fn string, lookback int, paramErr error) (int, error) {
+	if lookback < 0 {
+		return 0, taErr(fn, RetBadParam, paramErr)
+	}
+	return lookback, nil
+}
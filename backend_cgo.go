@@ -0,0 +1,53 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#include <ta-lib/ta_libc.h>
+*/
+import "C"
+
+// fromC 复制 TA-Lib 输出缓冲区中前 nb 个有效值。
+func fromC(output []C.double, nb C.int) []float64 {
+	result := make([]float64, int(nb))
+	for i := range result {
+		result[i] = float64(output[i])
+	}
+	return result
+}
+
+// fromCInt 复制 TA-Lib 整型输出缓冲区中前 nb 个有效值。
+func fromCInt(output []C.int, nb C.int) []int {
+	result := make([]int, int(nb))
+	for i := range result {
+		result[i] = int(output[i])
+	}
+	return result
+}
+
+func taInitialize() RetCode {
+	return RetCode(C.TA_Initialize())
+}
+
+func taShutdown() RetCode {
+	return RetCode(C.TA_Shutdown())
+}
+
+func taSetUnstablePeriod(id FuncUnstID, period int) RetCode {
+	return RetCode(C.TA_SetUnstablePeriod(C.TA_FuncUnstId(id), C.uint(period)))
+}
+
+func taSetCompatibility(compat Compatibility) RetCode {
+	return RetCode(C.TA_SetCompatibility(C.TA_Compatibility(compat)))
+}
+
+// taSetCandleSettings 逐项调用 TA_SetCandleSettings，遇到错误时停止。
+func taSetCandleSettings(s *CandleSettings) RetCode {
+	for t, c := range s {
+		code := C.TA_SetCandleSettings(C.TA_CandleSettingType(t), C.TA_RangeType(c.RangeType), C.int(c.AvgPeriod), C.double(c.Factor))
+		if code != C.TA_SUCCESS {
+			return RetCode(code)
+		}
+	}
+	return RetSuccess
+}
@@ -0,0 +1,746 @@
+//go:build !cgo || purego
+
+package go4ta
+
+// 在 CGO_ENABLED=0 或指定 purego 构建标签时，所有指标改由原生 Go 实现计算，无需链接 TA-Lib。
+// 全局设置直接保存在 settings 中，以下函数只需返回成功。
+
+func taInitialize() RetCode {
+	return RetSuccess
+}
+
+func taShutdown() RetCode {
+	return RetSuccess
+}
+
+func taSetUnstablePeriod(id FuncUnstID, period int) RetCode {
+	return RetSuccess
+}
+
+func taSetCompatibility(compat Compatibility) RetCode {
+	return RetSuccess
+}
+
+func taSetCandleSettings(s *CandleSettings) RetCode {
+	return RetSuccess
+}
+
+func taMA(close []float64, timePeriod int, maType int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeMA(close, timePeriod, maType)
+}
+
+func taRSI(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeRSI(close, timePeriod)
+}
+
+func taMACD(close []float64, fastPeriod, slowPeriod, signalPeriod int) (int, []float64, []float64, []float64, error) {
+	defer readSettings()()
+	return nativeMACD(close, fastPeriod, slowPeriod, signalPeriod)
+}
+
+func taBBands(close []float64, timePeriod int, nbDevUp, nbDevDn float64, maType int) (int, []float64, []float64, []float64, error) {
+	defer readSettings()()
+	return nativeBBands(close, timePeriod, nbDevUp, nbDevDn, maType)
+}
+
+func taATR(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeATR(high, low, close, timePeriod)
+}
+
+func taADX(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeADX(high, low, close, timePeriod)
+}
+
+func taSTOCH(high, low, close []float64, fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD int) (int, []float64, []float64, error) {
+	defer readSettings()()
+	return nativeSTOCH(high, low, close, fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD)
+}
+
+func taSTOCHRSI(close []float64, timePeriod, fastKPeriod, fastDPeriod, maType int) (int, []float64, []float64, error) {
+	defer readSettings()()
+	return nativeSTOCHRSI(close, timePeriod, fastKPeriod, fastDPeriod, maType)
+}
+
+func taOBV(close, volume []float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeOBV(close, volume)
+}
+
+func taAD(high, low, close, volume []float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeAD(high, low, close, volume)
+}
+
+func taAPO(close []float64, fastPeriod, slowPeriod, maType int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeAPO(close, fastPeriod, slowPeriod, maType)
+}
+
+func taPPO(close []float64, fastPeriod, slowPeriod, maType int) (int, []float64, error) {
+	defer readSettings()()
+	return nativePPO(close, fastPeriod, slowPeriod, maType)
+}
+
+func taSTDDEV(close []float64, timePeriod int, nbDev float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeSTDDEV(close, timePeriod, nbDev)
+}
+
+func taLINEARREG(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeLINEARREG(close, timePeriod)
+}
+
+func taLINEARREGSLOPE(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeLINEARREGSLOPE(close, timePeriod)
+}
+
+func taLINEARREGANGLE(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeLINEARREGANGLE(close, timePeriod)
+}
+
+func taLINEARREGINTERCEPT(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeLINEARREGINTERCEPT(close, timePeriod)
+}
+
+func taTSF(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeTSF(close, timePeriod)
+}
+
+func taDEMA(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeDEMA(close, timePeriod)
+}
+
+func taTEMA(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeTEMA(close, timePeriod)
+}
+
+func taTRIMA(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeTRIMA(close, timePeriod)
+}
+
+func taKAMA(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeKAMA(close, timePeriod)
+}
+
+func taT3(close []float64, timePeriod int, vFactor float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeT3(close, timePeriod, vFactor)
+}
+
+func taMAMA(close []float64, fastLimit, slowLimit float64) (int, []float64, []float64, error) {
+	defer readSettings()()
+	return nativeMAMA(close, fastLimit, slowLimit)
+}
+
+func taPLUSDM(high, low []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativePLUSDM(high, low, timePeriod)
+}
+
+func taMINUSDM(high, low []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeMINUSDM(high, low, timePeriod)
+}
+
+func taPLUSDI(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativePLUSDI(high, low, close, timePeriod)
+}
+
+func taMINUSDI(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeMINUSDI(high, low, close, timePeriod)
+}
+
+func taDX(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeDX(high, low, close, timePeriod)
+}
+
+func taADXR(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeADXR(high, low, close, timePeriod)
+}
+
+func taDMI(high, low, close []float64, timePeriod int) (dmiOutput, error) {
+	defer readSettings()()
+	return nativeDMI(high, low, close, timePeriod)
+}
+
+func taMOM(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeMOM(close, timePeriod)
+}
+
+func taROC(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeROC(close, timePeriod)
+}
+
+func taROCP(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeROCP(close, timePeriod)
+}
+
+func taROCR(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeROCR(close, timePeriod)
+}
+
+func taROCR100(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeROCR100(close, timePeriod)
+}
+
+func taCMO(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeCMO(close, timePeriod)
+}
+
+func taTRIX(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeTRIX(close, timePeriod)
+}
+
+func taCCI(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeCCI(high, low, close, timePeriod)
+}
+
+func taWILLR(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeWILLR(high, low, close, timePeriod)
+}
+
+func taMFI(high, low, close, volume []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeMFI(high, low, close, volume, timePeriod)
+}
+
+func taULTOSC(high, low, close []float64, timePeriod1, timePeriod2, timePeriod3 int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeULTOSC(high, low, close, timePeriod1, timePeriod2, timePeriod3)
+}
+
+func taBOP(open, high, low, close []float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeBOP(open, high, low, close)
+}
+
+func taNATR(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeNATR(high, low, close, timePeriod)
+}
+
+func taTRANGE(high, low, close []float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeTRANGE(high, low, close)
+}
+
+func taADOSC(high, low, close, volume []float64, fastPeriod, slowPeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeADOSC(high, low, close, volume, fastPeriod, slowPeriod)
+}
+
+func taSAR(high, low []float64, acceleration, maximum float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeSAR(high, low, acceleration, maximum)
+}
+
+func taSAREXT(high, low []float64, startValue, offsetOnReverse,
+	accelerationInitLong, accelerationLong, accelerationMaxLong,
+	accelerationInitShort, accelerationShort, accelerationMaxShort float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeSAREXT(high, low, startValue, offsetOnReverse,
+		accelerationInitLong, accelerationLong, accelerationMaxLong,
+		accelerationInitShort, accelerationShort, accelerationMaxShort)
+}
+
+func taAROON(high, low []float64, timePeriod int) (int, []float64, []float64, error) {
+	defer readSettings()()
+	return nativeAROON(high, low, timePeriod)
+}
+
+func taAROONOSC(high, low []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeAROONOSC(high, low, timePeriod)
+}
+
+// taCDL 与 taCDLLookback 由调用方通过 withCandleSettings 持有锁。
+func taCDL(name string, open, high, low, close []float64, penetration float64) (int, []int, error) {
+	return nativeCDL(name, open, high, low, close, penetration)
+}
+
+func taHTDCPERIOD(close []float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeHTDCPERIOD(close)
+}
+
+func taHTDCPHASE(close []float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeHTDCPHASE(close)
+}
+
+func taHTPHASOR(close []float64) (int, []float64, []float64, error) {
+	defer readSettings()()
+	return nativeHTPHASOR(close)
+}
+
+func taHTSINE(close []float64) (int, []float64, []float64, error) {
+	defer readSettings()()
+	return nativeHTSINE(close)
+}
+
+func taHTTRENDLINE(close []float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeHTTRENDLINE(close)
+}
+
+func taHTTRENDMODE(close []float64) (int, []int, error) {
+	defer readSettings()()
+	return nativeHTTRENDMODE(close)
+}
+
+func taMAVP(close, periods []float64, minPeriod, maxPeriod, maType int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeMAVP(close, periods, minPeriod, maxPeriod, maType)
+}
+
+func taVAR(close []float64, timePeriod int, nbDev float64) (int, []float64, error) {
+	defer readSettings()()
+	return nativeVAR(close, timePeriod, nbDev)
+}
+
+func taCORREL(real0, real1 []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeCORREL(real0, real1, timePeriod)
+}
+
+func taBETA(real0, real1 []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeBETA(real0, real1, timePeriod)
+}
+
+func taSUM(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeSUM(close, timePeriod)
+}
+
+func taMIDPOINT(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeMIDPOINT(close, timePeriod)
+}
+
+func taMIDPRICE(high, low []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeMIDPRICE(high, low, timePeriod)
+}
+
+func taMAX(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeMAX(close, timePeriod)
+}
+
+func taMIN(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	return nativeMIN(close, timePeriod)
+}
+
+func taMINMAX(close []float64, timePeriod int) (int, []float64, []float64, error) {
+	defer readSettings()()
+	return nativeMINMAX(close, timePeriod)
+}
+
+func taMAXINDEX(close []float64, timePeriod int) (int, []int, error) {
+	defer readSettings()()
+	return nativeMAXINDEX(close, timePeriod)
+}
+
+func taMININDEX(close []float64, timePeriod int) (int, []int, error) {
+	defer readSettings()()
+	return nativeMININDEX(close, timePeriod)
+}
+
+func taMINMAXINDEX(close []float64, timePeriod int) (int, []int, []int, error) {
+	defer readSettings()()
+	return nativeMINMAXINDEX(close, timePeriod)
+}
+
+func taMALookback(timePeriod, maType int) int {
+	defer readSettings()()
+	return nativeMALookback(timePeriod, maType)
+}
+
+func taRSILookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeRSILookback(timePeriod)
+}
+
+func taMACDLookback(fastPeriod, slowPeriod, signalPeriod int) int {
+	defer readSettings()()
+	return nativeMACDLookback(fastPeriod, slowPeriod, signalPeriod)
+}
+
+func taBBandsLookback(timePeriod int, nbDevUp, nbDevDn float64, maType int) int {
+	defer readSettings()()
+	return nativeBBANDSLookback(timePeriod, nbDevUp, nbDevDn, maType)
+}
+
+func taATRLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeATRLookback(timePeriod)
+}
+
+func taADXLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeADXLookback(timePeriod)
+}
+
+func taSTOCHLookback(fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD int) int {
+	defer readSettings()()
+	return nativeSTOCHLookback(fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD)
+}
+
+func taSTOCHRSILookback(timePeriod, fastKPeriod, fastDPeriod, maType int) int {
+	defer readSettings()()
+	return nativeSTOCHRSILookback(timePeriod, fastKPeriod, fastDPeriod, maType)
+}
+
+func taOBVLookback() int {
+	defer readSettings()()
+	return nativeOBVLookback()
+}
+
+func taADLookback() int {
+	defer readSettings()()
+	return nativeADLookback()
+}
+
+func taAPOLookback(fastPeriod, slowPeriod, maType int) int {
+	defer readSettings()()
+	return nativeAPOLookback(fastPeriod, slowPeriod, maType)
+}
+
+func taPPOLookback(fastPeriod, slowPeriod, maType int) int {
+	defer readSettings()()
+	return nativePPOLookback(fastPeriod, slowPeriod, maType)
+}
+
+func taSTDDEVLookback(timePeriod int, nbDev float64) int {
+	defer readSettings()()
+	return nativeSTDDEVLookback(timePeriod, nbDev)
+}
+
+func taLINEARREGLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeLINEARREGLookback(timePeriod)
+}
+
+func taLINEARREGSLOPELookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeLINEARREGSLOPELookback(timePeriod)
+}
+
+func taLINEARREGANGLELookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeLINEARREGANGLELookback(timePeriod)
+}
+
+func taLINEARREGINTERCEPTLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeLINEARREGINTERCEPTLookback(timePeriod)
+}
+
+func taTSFLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeTSFLookback(timePeriod)
+}
+
+func taDEMALookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeDEMALookback(timePeriod)
+}
+
+func taTEMALookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeTEMALookback(timePeriod)
+}
+
+func taTRIMALookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeTRIMALookback(timePeriod)
+}
+
+func taKAMALookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeKAMALookback(timePeriod)
+}
+
+func taT3Lookback(timePeriod int, vFactor float64) int {
+	defer readSettings()()
+	return nativeT3Lookback(timePeriod, vFactor)
+}
+
+func taMAMALookback(fastLimit, slowLimit float64) int {
+	defer readSettings()()
+	return nativeMAMALookback(fastLimit, slowLimit)
+}
+
+func taPLUSDMLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativePLUSDMLookback(timePeriod)
+}
+
+func taMINUSDMLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMINUSDMLookback(timePeriod)
+}
+
+func taPLUSDILookback(timePeriod int) int {
+	defer readSettings()()
+	return nativePLUSDILookback(timePeriod)
+}
+
+func taMINUSDILookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMINUSDILookback(timePeriod)
+}
+
+func taDXLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeDXLookback(timePeriod)
+}
+
+func taADXRLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeADXRLookback(timePeriod)
+}
+
+func taDMILookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeDMILookback(timePeriod)
+}
+
+func taMOMLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMOMLookback(timePeriod)
+}
+
+func taROCLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeROCLookback(timePeriod)
+}
+
+func taROCPLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeROCPLookback(timePeriod)
+}
+
+func taROCRLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeROCRLookback(timePeriod)
+}
+
+func taROCR100Lookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeROCR100Lookback(timePeriod)
+}
+
+func taCMOLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeCMOLookback(timePeriod)
+}
+
+func taTRIXLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeTRIXLookback(timePeriod)
+}
+
+func taCCILookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeCCILookback(timePeriod)
+}
+
+func taWILLRLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeWILLRLookback(timePeriod)
+}
+
+func taMFILookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMFILookback(timePeriod)
+}
+
+func taULTOSCLookback(timePeriod1, timePeriod2, timePeriod3 int) int {
+	defer readSettings()()
+	return nativeULTOSCLookback(timePeriod1, timePeriod2, timePeriod3)
+}
+
+func taBOPLookback() int {
+	defer readSettings()()
+	return nativeBOPLookback()
+}
+
+func taNATRLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeNATRLookback(timePeriod)
+}
+
+func taTRANGELookback() int {
+	defer readSettings()()
+	return nativeTRANGELookback()
+}
+
+func taADOSCLookback(fastPeriod, slowPeriod int) int {
+	defer readSettings()()
+	return nativeADOSCLookback(fastPeriod, slowPeriod)
+}
+
+func taSARLookback(acceleration, maximum float64) int {
+	defer readSettings()()
+	return nativeSARLookback(acceleration, maximum)
+}
+
+func taSAREXTLookback(startValue, offsetOnReverse,
+	accelerationInitLong, accelerationLong, accelerationMaxLong,
+	accelerationInitShort, accelerationShort, accelerationMaxShort float64) int {
+	defer readSettings()()
+	return nativeSAREXTLookback(startValue, offsetOnReverse,
+		accelerationInitLong, accelerationLong, accelerationMaxLong,
+		accelerationInitShort, accelerationShort, accelerationMaxShort)
+}
+
+func taAROONLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeAROONLookback(timePeriod)
+}
+
+func taAROONOSCLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeAROONOSCLookback(timePeriod)
+}
+
+func taCDLLookback(name string, penetration float64) int {
+	return nativeCDLLookback(name, penetration)
+}
+
+func taHTDCPERIODLookback() int {
+	defer readSettings()()
+	return nativeHTDCPERIODLookback()
+}
+
+func taHTDCPHASELookback() int {
+	defer readSettings()()
+	return nativeHTDCPHASELookback()
+}
+
+func taHTPHASORLookback() int {
+	defer readSettings()()
+	return nativeHTPHASORLookback()
+}
+
+func taHTSINELookback() int {
+	defer readSettings()()
+	return nativeHTSINELookback()
+}
+
+func taHTTRENDLINELookback() int {
+	defer readSettings()()
+	return nativeHTTRENDLINELookback()
+}
+
+func taHTTRENDMODELookback() int {
+	defer readSettings()()
+	return nativeHTTRENDMODELookback()
+}
+
+func taMAVPLookback(minPeriod, maxPeriod, maType int) int {
+	defer readSettings()()
+	return nativeMAVPLookback(minPeriod, maxPeriod, maType)
+}
+
+func taVARLookback(timePeriod int, nbDev float64) int {
+	defer readSettings()()
+	return nativeVARLookback(timePeriod, nbDev)
+}
+
+func taCORRELLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeCORRELLookback(timePeriod)
+}
+
+func taBETALookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeBETALookback(timePeriod)
+}
+
+func taSUMLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeSUMLookback(timePeriod)
+}
+
+func taMIDPOINTLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMIDPOINTLookback(timePeriod)
+}
+
+func taMIDPRICELookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMIDPRICELookback(timePeriod)
+}
+
+func taMAXLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMAXLookback(timePeriod)
+}
+
+func taMINLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMINLookback(timePeriod)
+}
+
+func taMINMAXLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMINMAXLookback(timePeriod)
+}
+
+func taMAXINDEXLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMAXINDEXLookback(timePeriod)
+}
+
+func taMININDEXLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMININDEXLookback(timePeriod)
+}
+
+func taMINMAXINDEXLookback(timePeriod int) int {
+	defer readSettings()()
+	return nativeMINMAXINDEXLookback(timePeriod)
+}
+
+func taFuncInfo(name string) (*FuncInfo, error) {
+	return nativeFuncInfo(name)
+}
+
+func taFuncNames() []string {
+	return nativeFuncNames()
+}
+
+func taCall(info *FuncInfo, inputs [][]float64, params []float64) (int, [][]float64, error) {
+	defer readSettings()()
+	return nativeCall(info, inputs, params)
+}
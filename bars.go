@@ -0,0 +1,131 @@
+package go4ta
+
+import "time"
+
+// Candle 是一根价格柱（K线）。
+type Candle struct {
+	Time   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// Bars 以按列存储（struct-of-arrays）的方式保存一段价格柱序列，
+// 各列可以直接作为切片传给指标函数，也可以通过方法计算，如 bars.ATR(14)。
+//
+// Time、Open、Volume 允许为空（未提供），其余列的长度须相同；
+// 需要成交量的指标在 Volume 为空时返回 LengthMismatchError。
+// 指标结果与 Close 等长，第 i 个值对应 Time[i]。
+type Bars struct {
+	Time   []time.Time
+	Open   []float64
+	High   []float64
+	Low    []float64
+	Close  []float64
+	Volume []float64
+}
+
+// NewBars 由逐根排列的价格柱构造 Bars。
+//
+// @param candles - 按时间先后排列的价格柱
+// @return *Bars  - 各列长度均为 len(candles)
+func NewBars(candles []Candle) *Bars {
+	n := len(candles)
+	b := &Bars{
+		Time:   make([]time.Time, n),
+		Open:   make([]float64, n),
+		High:   make([]float64, n),
+		Low:    make([]float64, n),
+		Close:  make([]float64, n),
+		Volume: make([]float64, n),
+	}
+	for i, c := range candles {
+		b.Time[i] = c.Time
+		b.Open[i] = c.Open
+		b.High[i] = c.High
+		b.Low[i] = c.Low
+		b.Close[i] = c.Close
+		b.Volume[i] = c.Volume
+	}
+	return b
+}
+
+// NewBarsFromSlices 由已按列排列的序列构造 Bars，切片不会被复制。
+//
+// @param times, open, high, low, close, volume - 各列序列，times、open、volume 可为 nil
+// @return *Bars - 构造出的 Bars
+// @return error - 各列长度不一致时返回 LengthMismatchError
+func NewBarsFromSlices(times []time.Time, open, high, low, close, volume []float64) (*Bars, error) {
+	b := &Bars{Time: times, Open: open, High: high, Low: low, Close: close, Volume: volume}
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Len 返回价格柱的根数。
+func (b *Bars) Len() int {
+	return len(b.Close)
+}
+
+// At 返回第 i 根价格柱，未提供的列取零值。
+func (b *Bars) At(i int) Candle {
+	c := Candle{High: b.High[i], Low: b.Low[i], Close: b.Close[i]}
+	if b.Time != nil {
+		c.Time = b.Time[i]
+	}
+	if b.Open != nil {
+		c.Open = b.Open[i]
+	}
+	if b.Volume != nil {
+		c.Volume = b.Volume[i]
+	}
+	return c
+}
+
+// Append 在末尾追加一根价格柱。Bars 为空时各列都会被填充，否则只追加已提供的列。
+func (b *Bars) Append(c Candle) {
+	empty := b.Len() == 0
+	if empty || b.Time != nil {
+		b.Time = append(b.Time, c.Time)
+	}
+	if empty || b.Open != nil {
+		b.Open = append(b.Open, c.Open)
+	}
+	b.High = append(b.High, c.High)
+	b.Low = append(b.Low, c.Low)
+	b.Close = append(b.Close, c.Close)
+	if empty || b.Volume != nil {
+		b.Volume = append(b.Volume, c.Volume)
+	}
+}
+
+// Slice 返回 [from, to) 区间的价格柱，与 b 共享底层数组。
+func (b *Bars) Slice(from, to int) *Bars {
+	s := &Bars{High: b.High[from:to], Low: b.Low[from:to], Close: b.Close[from:to]}
+	if b.Time != nil {
+		s.Time = b.Time[from:to]
+	}
+	if b.Open != nil {
+		s.Open = b.Open[from:to]
+	}
+	if b.Volume != nil {
+		s.Volume = b.Volume[from:to]
+	}
+	return s
+}
+
+// Validate 检查各列长度是否一致，未提供的 Time、Open、Volume 不参与检查。
+func (b *Bars) Validate() error {
+	n := len(b.Close)
+	if len(b.High) != n || len(b.Low) != n ||
+		(b.Time != nil && len(b.Time) != n) ||
+		(b.Open != nil && len(b.Open) != n) ||
+		(b.Volume != nil && len(b.Volume) != n) {
+		return lengthMismatch("Bars", "time, open, high, low, close, volume",
+			len(b.Time), len(b.Open), len(b.High), len(b.Low), len(b.Close), len(b.Volume))
+	}
+	return nil
+}
@@ -0,0 +1,720 @@
+package go4ta
+
+// Bars 上的指标方法：先用 Validate 统一校验各列长度，再以对应的列调用同名函数，
+// 参数、结果与错误均与同名函数相同；多输出指标返回 CalcXxx 的结果结构体。
+
+// MA 以收盘价计算移动平均线，见 MA。
+func (b *Bars) MA(timePeriod int, maType MAType) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MA(b.Close, timePeriod, maType)
+}
+
+// SMA 以收盘价计算简单移动平均，见 SMA。
+func (b *Bars) SMA(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return SMA(b.Close, timePeriod)
+}
+
+// EMA 以收盘价计算指数移动平均，见 EMA。
+func (b *Bars) EMA(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return EMA(b.Close, timePeriod)
+}
+
+// WMA 以收盘价计算加权移动平均，见 WMA。
+func (b *Bars) WMA(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return WMA(b.Close, timePeriod)
+}
+
+// DEMA 以收盘价计算双指数移动平均，见 DEMA。
+func (b *Bars) DEMA(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return DEMA(b.Close, timePeriod)
+}
+
+// TEMA 以收盘价计算三指数移动平均，见 TEMA。
+func (b *Bars) TEMA(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return TEMA(b.Close, timePeriod)
+}
+
+// TRIMA 以收盘价计算三角移动平均，见 TRIMA。
+func (b *Bars) TRIMA(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return TRIMA(b.Close, timePeriod)
+}
+
+// KAMA 以收盘价计算考夫曼自适应移动平均，见 KAMA。
+func (b *Bars) KAMA(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return KAMA(b.Close, timePeriod)
+}
+
+// T3 以收盘价计算三倍平滑移动平均，见 T3。
+func (b *Bars) T3(timePeriod int, vFactor float64) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return T3(b.Close, timePeriod, vFactor)
+}
+
+// MAMA 以收盘价计算 MESA 自适应移动平均，见 CalcMAMA。
+func (b *Bars) MAMA(fastLimit, slowLimit float64) (MAMAResult, error) {
+	if err := b.Validate(); err != nil {
+		return MAMAResult{}, err
+	}
+	return CalcMAMA(b.Close, fastLimit, slowLimit)
+}
+
+// RSI 以收盘价计算相对强弱指数，见 RSI。
+func (b *Bars) RSI(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return RSI(b.Close, timePeriod)
+}
+
+// MACD 以收盘价计算 MACD，见 CalcMACD。
+func (b *Bars) MACD(fastPeriod, slowPeriod, signalPeriod int) (MACDResult, error) {
+	if err := b.Validate(); err != nil {
+		return MACDResult{}, err
+	}
+	return CalcMACD(b.Close, fastPeriod, slowPeriod, signalPeriod)
+}
+
+// BBands 以收盘价计算布林带，见 CalcBBands。
+func (b *Bars) BBands(timePeriod int, nbDevUp, nbDevDn float64, maType MAType) (BBandsResult, error) {
+	if err := b.Validate(); err != nil {
+		return BBandsResult{}, err
+	}
+	return CalcBBands(b.Close, timePeriod, nbDevUp, nbDevDn, maType)
+}
+
+// SAR 计算抛物线转向指标，见 SAR。
+func (b *Bars) SAR(acceleration, maximum float64) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return SAR(b.High, b.Low, acceleration, maximum)
+}
+
+// SAREXT 计算扩展的抛物线转向指标，见 SAREXT。
+func (b *Bars) SAREXT(startValue, offsetOnReverse,
+	accelerationInitLong, accelerationLong, accelerationMaxLong,
+	accelerationInitShort, accelerationShort, accelerationMaxShort float64) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return SAREXT(b.High, b.Low, startValue, offsetOnReverse,
+		accelerationInitLong, accelerationLong, accelerationMaxLong,
+		accelerationInitShort, accelerationShort, accelerationMaxShort)
+}
+
+// SARDirection 返回抛物线转向指标所指示的方向，见 SARDirection。
+func (b *Bars) SARDirection(acceleration, maximum float64) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return SARDirection(b.High, b.Low, acceleration, maximum)
+}
+
+// APO 以收盘价计算绝对价格振荡器，见 APO。
+func (b *Bars) APO(fastPeriod, slowPeriod int, maType MAType) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return APO(b.Close, fastPeriod, slowPeriod, maType)
+}
+
+// PPO 以收盘价计算百分比价格振荡器，见 PPO。
+func (b *Bars) PPO(fastPeriod, slowPeriod int, maType MAType) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return PPO(b.Close, fastPeriod, slowPeriod, maType)
+}
+
+// PPOWithSignal 以收盘价计算 PPO 及其信号线与柱状图，见 CalcPPOWithSignal。
+func (b *Bars) PPOWithSignal(fastPeriod, slowPeriod, signalPeriod int, maType MAType) (PPOResult, error) {
+	if err := b.Validate(); err != nil {
+		return PPOResult{}, err
+	}
+	return CalcPPOWithSignal(b.Close, fastPeriod, slowPeriod, signalPeriod, maType)
+}
+
+// STDDEV 以收盘价计算标准差，见 STDDEV。
+func (b *Bars) STDDEV(timePeriod int, nbDev float64) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return STDDEV(b.Close, timePeriod, nbDev)
+}
+
+// LinearReg 以收盘价计算线性回归值，见 LinearReg。
+func (b *Bars) LinearReg(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return LinearReg(b.Close, timePeriod)
+}
+
+// LinearRegSlope 以收盘价计算线性回归斜率，见 LinearRegSlope。
+func (b *Bars) LinearRegSlope(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return LinearRegSlope(b.Close, timePeriod)
+}
+
+// LinearRegAngle 以收盘价计算线性回归角度，见 LinearRegAngle。
+func (b *Bars) LinearRegAngle(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return LinearRegAngle(b.Close, timePeriod)
+}
+
+// LinearRegIntercept 以收盘价计算线性回归截距，见 LinearRegIntercept。
+func (b *Bars) LinearRegIntercept(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return LinearRegIntercept(b.Close, timePeriod)
+}
+
+// TSF 以收盘价计算时间序列预测，见 TSF。
+func (b *Bars) TSF(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return TSF(b.Close, timePeriod)
+}
+
+// LinearRegression 以收盘价计算滚动线性回归，见 CalcLinearRegression。
+func (b *Bars) LinearRegression(timePeriod int) (LinearRegressionResult, error) {
+	if err := b.Validate(); err != nil {
+		return LinearRegressionResult{}, err
+	}
+	return CalcLinearRegression(b.Close, timePeriod)
+}
+
+// STOCHRSI 以收盘价计算随机 RSI，见 CalcSTOCHRSI。
+func (b *Bars) STOCHRSI(timePeriod, fastKPeriod, fastDPeriod int, maType MAType) (STOCHRSIResult, error) {
+	if err := b.Validate(); err != nil {
+		return STOCHRSIResult{}, err
+	}
+	return CalcSTOCHRSI(b.Close, timePeriod, fastKPeriod, fastDPeriod, maType)
+}
+
+// ATR 计算平均真实波幅，见 ATR。
+func (b *Bars) ATR(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return ATR(b.High, b.Low, b.Close, timePeriod)
+}
+
+// NATR 计算归一化平均真实波幅，见 NATR。
+func (b *Bars) NATR(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return NATR(b.High, b.Low, b.Close, timePeriod)
+}
+
+// TRANGE 计算真实波幅，见 TRANGE。
+func (b *Bars) TRANGE() ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return TRANGE(b.High, b.Low, b.Close)
+}
+
+// Keltner 计算肯特纳通道，见 CalcKeltner。
+func (b *Bars) Keltner(timePeriod, atrPeriod int, multiplier float64, maType MAType) (KeltnerResult, error) {
+	if err := b.Validate(); err != nil {
+		return KeltnerResult{}, err
+	}
+	return CalcKeltner(b.High, b.Low, b.Close, timePeriod, atrPeriod, multiplier, maType)
+}
+
+// Donchian 计算唐奇安通道，见 CalcDonchian。
+func (b *Bars) Donchian(timePeriod int) (DonchianResult, error) {
+	if err := b.Validate(); err != nil {
+		return DonchianResult{}, err
+	}
+	return CalcDonchian(b.High, b.Low, timePeriod)
+}
+
+// ChandelierExit 计算吊灯止损，见 CalcChandelierExit。
+func (b *Bars) ChandelierExit(timePeriod int, multiplier float64) (ChandelierResult, error) {
+	if err := b.Validate(); err != nil {
+		return ChandelierResult{}, err
+	}
+	return CalcChandelierExit(b.High, b.Low, b.Close, timePeriod, multiplier)
+}
+
+// ADX 计算平均趋向指数，见 ADX。
+func (b *Bars) ADX(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return ADX(b.High, b.Low, b.Close, timePeriod)
+}
+
+// ADXR 计算平均趋向指数评估，见 ADXR。
+func (b *Bars) ADXR(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return ADXR(b.High, b.Low, b.Close, timePeriod)
+}
+
+// DX 计算动向指数，见 DX。
+func (b *Bars) DX(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return DX(b.High, b.Low, b.Close, timePeriod)
+}
+
+// PlusDI 计算正向指标 +DI，见 PlusDI。
+func (b *Bars) PlusDI(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return PlusDI(b.High, b.Low, b.Close, timePeriod)
+}
+
+// MinusDI 计算负向指标 -DI，见 MinusDI。
+func (b *Bars) MinusDI(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MinusDI(b.High, b.Low, b.Close, timePeriod)
+}
+
+// PlusDM 计算正向动向值 +DM，见 PlusDM。
+func (b *Bars) PlusDM(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return PlusDM(b.High, b.Low, timePeriod)
+}
+
+// MinusDM 计算负向动向值 -DM，见 MinusDM。
+func (b *Bars) MinusDM(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MinusDM(b.High, b.Low, timePeriod)
+}
+
+// MOM 以收盘价计算动量，见 MOM。
+func (b *Bars) MOM(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MOM(b.Close, timePeriod)
+}
+
+// ROC 以收盘价计算变化率，见 ROC。
+func (b *Bars) ROC(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return ROC(b.Close, timePeriod)
+}
+
+// ROCP 以收盘价计算变化百分比，见 ROCP。
+func (b *Bars) ROCP(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return ROCP(b.Close, timePeriod)
+}
+
+// ROCR 以收盘价计算变化比率，见 ROCR。
+func (b *Bars) ROCR(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return ROCR(b.Close, timePeriod)
+}
+
+// ROCR100 以收盘价计算以100为基准的变化比率，见 ROCR100。
+func (b *Bars) ROCR100(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return ROCR100(b.Close, timePeriod)
+}
+
+// CMO 以收盘价计算钱德动量摆动指标，见 CMO。
+func (b *Bars) CMO(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return CMO(b.Close, timePeriod)
+}
+
+// TRIX 以收盘价计算 TRIX，见 TRIX。
+func (b *Bars) TRIX(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return TRIX(b.Close, timePeriod)
+}
+
+// CCI 计算顺势指标，见 CCI。
+func (b *Bars) CCI(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return CCI(b.High, b.Low, b.Close, timePeriod)
+}
+
+// WILLR 计算威廉指标 %R，见 WILLR。
+func (b *Bars) WILLR(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return WILLR(b.High, b.Low, b.Close, timePeriod)
+}
+
+// MFI 计算资金流量指标，需要 Volume 列，见 MFI。
+func (b *Bars) MFI(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MFI(b.High, b.Low, b.Close, b.Volume, timePeriod)
+}
+
+// ULTOSC 计算终极振荡指标，见 ULTOSC。
+func (b *Bars) ULTOSC(timePeriod1, timePeriod2, timePeriod3 int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return ULTOSC(b.High, b.Low, b.Close, timePeriod1, timePeriod2, timePeriod3)
+}
+
+// BOP 计算均势指标，需要 Open 列，见 BOP。
+func (b *Bars) BOP() ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return BOP(b.Open, b.High, b.Low, b.Close)
+}
+
+// DMI 一次计算 ADX、ADXR、+DI 与 -DI，见 CalcDMI。
+func (b *Bars) DMI(timePeriod int) (DMIResult, error) {
+	if err := b.Validate(); err != nil {
+		return DMIResult{}, err
+	}
+	return CalcDMI(b.High, b.Low, b.Close, timePeriod)
+}
+
+// Aroon 计算阿隆指标，见 CalcAroon。
+func (b *Bars) Aroon(timePeriod int) (AroonResult, error) {
+	if err := b.Validate(); err != nil {
+		return AroonResult{}, err
+	}
+	return CalcAroon(b.High, b.Low, timePeriod)
+}
+
+// AroonOsc 计算阿隆振荡器，见 AroonOsc。
+func (b *Bars) AroonOsc(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return AroonOsc(b.High, b.Low, timePeriod)
+}
+
+// Vortex 计算涡旋指标，见 CalcVortex。
+func (b *Bars) Vortex(timePeriod int) (VortexResult, error) {
+	if err := b.Validate(); err != nil {
+		return VortexResult{}, err
+	}
+	return CalcVortex(b.High, b.Low, b.Close, timePeriod)
+}
+
+// STOCH 计算随机指标，见 CalcSTOCH。
+func (b *Bars) STOCH(fastKPeriod, slowKPeriod, slowDPeriod int, maTypeK, maTypeD MAType) (STOCHResult, error) {
+	if err := b.Validate(); err != nil {
+		return STOCHResult{}, err
+	}
+	return CalcSTOCH(b.High, b.Low, b.Close, fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD)
+}
+
+// SuperTrend 计算 SuperTrend，见 CalcSuperTrend。
+func (b *Bars) SuperTrend(period int, multiplier float64) (SuperTrendResult, error) {
+	if err := b.Validate(); err != nil {
+		return SuperTrendResult{}, err
+	}
+	return CalcSuperTrend(b.High, b.Low, b.Close, period, multiplier)
+}
+
+// OBV 计算能量潮，需要 Volume 列，见 OBV。
+func (b *Bars) OBV() ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return OBV(b.Close, b.Volume)
+}
+
+// AD 计算累积/派发线，需要 Volume 列，见 AD。
+func (b *Bars) AD() ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return AD(b.High, b.Low, b.Close, b.Volume)
+}
+
+// ADOSC 计算佳庆振荡器，需要 Volume 列，见 ADOSC。
+func (b *Bars) ADOSC(fastPeriod, slowPeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return ADOSC(b.High, b.Low, b.Close, b.Volume, fastPeriod, slowPeriod)
+}
+
+// VWAP 计算按交易时段累计的成交量加权平均价，需要 Time 与 Volume 列，见 VWAP。
+func (b *Bars) VWAP(session Session) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return VWAP(b.Time, b.High, b.Low, b.Close, b.Volume, session)
+}
+
+// AnchoredVWAP 计算锚定 VWAP，需要 Volume 列，见 AnchoredVWAP。
+func (b *Bars) AnchoredVWAP(anchors ...int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return AnchoredVWAP(b.High, b.Low, b.Close, b.Volume, anchors...)
+}
+
+// VWMA 计算成交量加权移动平均线，需要 Volume 列，见 VWMA。
+func (b *Bars) VWMA(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return VWMA(b.Close, b.Volume, timePeriod)
+}
+
+// CMF 计算佳庆资金流量，需要 Volume 列，见 CMF。
+func (b *Bars) CMF(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return CMF(b.High, b.Low, b.Close, b.Volume, timePeriod)
+}
+
+// ForceIndex 计算埃尔德强力指数，需要 Volume 列，见 ForceIndex。
+func (b *Bars) ForceIndex(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return ForceIndex(b.Close, b.Volume, timePeriod)
+}
+
+// EMV 计算简易波动指标，需要 Volume 列，见 EMV。
+func (b *Bars) EMV(timePeriod int, divisor float64) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return EMV(b.High, b.Low, b.Volume, timePeriod, divisor)
+}
+
+// CandlePattern 识别一个K线形态，需要 Open 列，见 CandlePattern。
+func (b *Bars) CandlePattern(name string, params ...float64) ([]int, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return CandlePattern(name, b.Open, b.High, b.Low, b.Close, params...)
+}
+
+// CandlePatterns 一次识别全部K线形态，需要 Open 列，见 CandlePatterns。
+func (b *Bars) CandlePatterns() ([][]string, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return CandlePatterns(b.Open, b.High, b.Low, b.Close)
+}
+
+// HTDCPeriod 以收盘价计算希尔伯特变换的主导周期，见 HTDCPeriod。
+func (b *Bars) HTDCPeriod() ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return HTDCPeriod(b.Close)
+}
+
+// HTDCPhase 以收盘价计算主导周期的相位，见 HTDCPhase。
+func (b *Bars) HTDCPhase() ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return HTDCPhase(b.Close)
+}
+
+// HTPhasor 以收盘价计算希尔伯特变换的相量分量，见 CalcHTPhasor。
+func (b *Bars) HTPhasor() (HTPhasorResult, error) {
+	if err := b.Validate(); err != nil {
+		return HTPhasorResult{}, err
+	}
+	return CalcHTPhasor(b.Close)
+}
+
+// HTSine 以收盘价计算希尔伯特变换的正弦线，见 CalcHTSine。
+func (b *Bars) HTSine() (HTSineResult, error) {
+	if err := b.Validate(); err != nil {
+		return HTSineResult{}, err
+	}
+	return CalcHTSine(b.Close)
+}
+
+// HTTrendline 以收盘价计算瞬时趋势线，见 HTTrendline。
+func (b *Bars) HTTrendline() ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return HTTrendline(b.Close)
+}
+
+// HTTrendMode 以收盘价判断趋势模式与周期模式，见 HTTrendMode。
+func (b *Bars) HTTrendMode() ([]int, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return HTTrendMode(b.Close)
+}
+
+// CycleMA 以收盘价计算以主导周期为周期的自适应均线，见 CycleMA。
+func (b *Bars) CycleMA(minPeriod, maxPeriod int, maType MAType) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return CycleMA(b.Close, minPeriod, maxPeriod, maType)
+}
+
+// MAVP 以收盘价计算可变周期的移动平均线，periods 须与价格柱等长，见 MAVP。
+func (b *Bars) MAVP(periods []float64, minPeriod, maxPeriod int, maType MAType) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MAVP(b.Close, periods, minPeriod, maxPeriod, maType)
+}
+
+// VAR 以收盘价计算方差，见 VAR。
+func (b *Bars) VAR(timePeriod int, nbDev float64) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return VAR(b.Close, timePeriod, nbDev)
+}
+
+// CORREL 计算收盘价与 other 的相关系数，other 须与价格柱等长，见 CORREL。
+func (b *Bars) CORREL(other []float64, timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return CORREL(b.Close, other, timePeriod)
+}
+
+// BETA 计算收盘价相对基准 benchmark 的贝塔系数，benchmark 须与价格柱等长，见 BETA。
+func (b *Bars) BETA(benchmark []float64, timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return BETA(benchmark, b.Close, timePeriod)
+}
+
+// SUM 以收盘价计算累加和，见 SUM。
+func (b *Bars) SUM(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return SUM(b.Close, timePeriod)
+}
+
+// MAX 以收盘价计算区间最高值，见 MAX。
+func (b *Bars) MAX(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MAX(b.Close, timePeriod)
+}
+
+// MIN 以收盘价计算区间最低值，见 MIN。
+func (b *Bars) MIN(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MIN(b.Close, timePeriod)
+}
+
+// MINMAX 以收盘价计算区间最低值与最高值，见 CalcMINMAX。
+func (b *Bars) MINMAX(timePeriod int) (MINMAXResult, error) {
+	if err := b.Validate(); err != nil {
+		return MINMAXResult{}, err
+	}
+	return CalcMINMAX(b.Close, timePeriod)
+}
+
+// MAXINDEX 以收盘价计算区间最高值的下标，见 MAXINDEX。
+func (b *Bars) MAXINDEX(timePeriod int) ([]int, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MAXINDEX(b.Close, timePeriod)
+}
+
+// MININDEX 以收盘价计算区间最低值的下标，见 MININDEX。
+func (b *Bars) MININDEX(timePeriod int) ([]int, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MININDEX(b.Close, timePeriod)
+}
+
+// MINMAXINDEX 以收盘价计算区间最低值与最高值的下标，见 MINMAXINDEX。
+func (b *Bars) MINMAXINDEX(timePeriod int) (minIdx, maxIdx []int, err error) {
+	if err := b.Validate(); err != nil {
+		return nil, nil, err
+	}
+	return MINMAXINDEX(b.Close, timePeriod)
+}
+
+// MIDPOINT 以收盘价计算区间中点，见 MIDPOINT。
+func (b *Bars) MIDPOINT(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MIDPOINT(b.Close, timePeriod)
+}
+
+// MIDPRICE 计算区间最高价与最低价的中点，见 MIDPRICE。
+func (b *Bars) MIDPRICE(timePeriod int) ([]float64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return MIDPRICE(b.High, b.Low, timePeriod)
+}
@@ -0,0 +1,134 @@
+package go4ta
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func testBars() *Bars {
+	s := randomWalk(rand.New(rand.NewSource(1)), "trending", 120, 100, 0.002, 0.01, 0)
+	start := time.Date(2024, 1, 2, 9, 30, 0, 0, time.UTC)
+	candles := make([]Candle, len(s.close))
+	for i := range candles {
+		candles[i] = Candle{
+			Time:   start.Add(time.Duration(i) * time.Minute),
+			Open:   s.open[i],
+			High:   s.high[i],
+			Low:    s.low[i],
+			Close:  s.close[i],
+			Volume: s.volume[i],
+		}
+	}
+	return NewBars(candles)
+}
+
+func TestBarsMethods(t *testing.T) {
+	b := testBars()
+	if b.Len() != 120 || b.At(5).Close != b.Close[5] || !b.At(5).Time.Equal(b.Time[5]) {
+		t.Fatalf("NewBars did not keep the candles: %+v", b.At(5))
+	}
+
+	check := func(name string, got, want []float64, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !equalFloats(got, want) {
+			t.Errorf("%s: method result differs from function", name)
+		}
+	}
+	atr, _ := ATR(b.High, b.Low, b.Close, 14)
+	got, err := b.ATR(14)
+	check("ATR", got, atr, err)
+	rsi, _ := RSI(b.Close, 14)
+	got, err = b.RSI(14)
+	check("RSI", got, rsi, err)
+	obv, _ := OBV(b.Close, b.Volume)
+	got, err = b.OBV()
+	check("OBV", got, obv, err)
+	_, signal, _, _ := MACD(b.Close, 12, 26, 9)
+	macd, err := b.MACD(12, 26, 9)
+	check("MACD", macd.Signal, signal, err)
+	_, slowD, _ := STOCH(b.High, b.Low, b.Close, 5, 3, 3, 0, 0)
+	stoch, err := b.STOCH(5, 3, 3, 0, 0)
+	check("STOCH", stoch.SlowD, slowD, err)
+}
+
+func TestBarsValidate(t *testing.T) {
+	b := testBars()
+	b.Low = b.Low[1:]
+	if _, err := b.ATR(14); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("ATR on ragged bars: got %v, want ErrLengthMismatch", err)
+	}
+	// 收盘价之类只用一列的指标同样要求 Bars 本身有效
+	if _, err := b.RSI(14); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("RSI on ragged bars: got %v, want ErrLengthMismatch", err)
+	}
+
+	full := testBars()
+	noVolume, err := NewBarsFromSlices(nil, nil, full.High, full.Low, full.Close, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := noVolume.ATR(14); err != nil {
+		t.Errorf("ATR without volume: %v", err)
+	}
+	var mismatch *LengthMismatchError
+	if _, err := noVolume.OBV(); !errors.As(err, &mismatch) || mismatch.Func != "OBV" {
+		t.Errorf("OBV without volume: got %v, want LengthMismatchError", err)
+	}
+	if _, err := NewBarsFromSlices(full.Time[1:], nil, full.High, full.Low, full.Close, nil); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("NewBarsFromSlices with short time: got %v", err)
+	}
+}
+
+func TestBarsAppendSlice(t *testing.T) {
+	full := testBars()
+	var b Bars
+	for i := range full.Len() {
+		b.Append(full.At(i))
+	}
+	if err := b.Validate(); err != nil || !equalFloats(b.Volume, full.Volume) {
+		t.Fatalf("Append did not rebuild the bars: %v", err)
+	}
+
+	s := full.Slice(20, 60)
+	if s.Len() != 40 || !s.Time[0].Equal(full.Time[20]) {
+		t.Fatalf("Slice: len %d, first time %v", s.Len(), s.Time[0])
+	}
+	want, _ := EMA(full.Close[20:60], 10)
+	got, err := s.EMA(10)
+	if err != nil || !equalFloats(got, want) {
+		t.Errorf("EMA on slice: %v", err)
+	}
+
+	// 只提供了部分列时，追加只作用于已有的列
+	partial := Bars{High: []float64{2}, Low: []float64{1}, Close: []float64{1.5}}
+	partial.Append(Candle{High: 3, Low: 2, Close: 2.5, Volume: 100})
+	if partial.Volume != nil || partial.Len() != 2 || partial.Validate() != nil {
+		t.Errorf("Append on partial bars: %+v", partial)
+	}
+}
+
+// TestMultiInputValidation 检查多输入函数对长度不一致的处理一致：即使其中一条为空也返回错误。
+func TestMultiInputValidation(t *testing.T) {
+	x := []float64{1, 2, 3}
+	var empty []float64
+	for name, err := range map[string]error{
+		"ATR":   func() error { _, err := ATR(x, x, empty, 1); return err }(),
+		"ADX":   func() error { _, err := ADX(x, empty, x, 1); return err }(),
+		"STOCH": func() error { _, _, err := STOCH(empty, x, x, 1, 1, 1, 0, 0); return err }(),
+		"OBV":   func() error { _, err := OBV(x, empty); return err }(),
+		"AD":    func() error { _, err := AD(x, x, x, empty); return err }(),
+		"SuperTrend": func() error {
+			_, _, _, _, err := SuperTrend(x, x, empty, 1, 3)
+			return err
+		}(),
+	} {
+		if !errors.Is(err, ErrLengthMismatch) {
+			t.Errorf("%s: got %v, want ErrLengthMismatch", name, err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+TA_RetCode TA_BBANDS(int startIdx, int endIdx, const double inReal[], int optInTimePeriod, double optInNbDevUp, double optInNbDevDn, unsigned int optInMAType, int *outBegIdx, int *outNBElement, double *outUpperBand, double *outMiddleBand, double *outLowerBand);
+*/
+import "C"
+import "unsafe"
+
+// taBBands 调用 TA_BBANDS。
+func taBBands(close []float64, timePeriod int, nbDevUp, nbDevDn float64, maType int) (int, []float64, []float64, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	outUpper := make([]C.double, len(close))
+	outMiddle := make([]C.double, len(close))
+	outLower := make([]C.double, len(close))
+
+	cOutUpper := (*C.double)(unsafe.Pointer(&outUpper[0]))
+	cOutMiddle := (*C.double)(unsafe.Pointer(&outMiddle[0]))
+	cOutLower := (*C.double)(unsafe.Pointer(&outLower[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_BBANDS(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		C.double(nbDevUp),
+		C.double(nbDevDn),
+		C.TA_MAType(maType),
+		&outBegIdx,
+		&outNBElement,
+		cOutUpper,
+		cOutMiddle,
+		cOutLower,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, _, _, paramErr := bbandsParams(timePeriod, nbDevUp, nbDevDn, maType)
+		return 0, nil, nil, nil, taErr("TA_BBANDS", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(outUpper, outNBElement), fromC(outMiddle, outNBElement), fromC(outLower, outNBElement), nil
+}
+
+// taBBandsLookback 调用 TA_BBANDS_Lookback，参数无效时返回 -1。
+func taBBandsLookback(timePeriod int, nbDevUp, nbDevDn float64, maType int) int {
+	defer readSettings()()
+	return int(C.TA_BBANDS_Lookback(C.int(timePeriod), C.double(nbDevUp), C.double(nbDevDn), C.TA_MAType(maType)))
+}
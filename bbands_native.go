@@ -0,0 +1,95 @@
+package go4ta
+
+import "math"
+
+// nativeBBands 是 TA_BBANDS 的原生实现。
+func nativeBBands(close []float64, timePeriod int, nbDevUp, nbDevDn float64, maType int) (int, []float64, []float64, []float64, error) {
+	timePeriod, nbDevUp, nbDevDn, maType, err := bbandsParams(timePeriod, nbDevUp, nbDevDn, maType)
+	if err != nil {
+		return 0, nil, nil, nil, err
+	}
+
+	outBegIdx, middle := intMA(close, timePeriod, maType)
+	if len(middle) == 0 {
+		return 0, nil, nil, nil, nil
+	}
+
+	var stdDev []float64
+	if maType == 0 {
+		stdDev = stdDevUsingPrecalcMA(close, middle, outBegIdx, timePeriod)
+	} else {
+		var stdDevBegIdx int
+		stdDevBegIdx, stdDev = intSTDDEV(close, outBegIdx, timePeriod, 1.0)
+		// MAMA 的回看期与周期无关，周期较大时标准差比均线起始得更晚，
+		// TA-Lib 在这种情况下会读到未初始化的缓冲区，这里改为只输出两者都有效的部分。
+		if len(stdDev) == 0 {
+			return 0, nil, nil, nil, nil
+		}
+		middle = middle[stdDevBegIdx-outBegIdx:]
+		outBegIdx = stdDevBegIdx
+	}
+
+	upper := make([]float64, len(middle))
+	lower := make([]float64, len(middle))
+	for i := range middle {
+		tempReal := stdDev[i]
+		tempReal2 := middle[i]
+		upper[i] = tempReal2 + (tempReal * nbDevUp)
+		lower[i] = tempReal2 - (tempReal * nbDevDn)
+	}
+	return outBegIdx, upper, middle, lower, nil
+}
+
+// stdDevUsingPrecalcMA 对应 TA_INT_stddev_using_precalc_ma，复用已算好的 SMA 计算标准差。
+func stdDevUsingPrecalcMA(in, movAvg []float64, movAvgBegIdx, timePeriod int) []float64 {
+	output := make([]float64, len(movAvg))
+	startSum := 1 + movAvgBegIdx - timePeriod
+	endSum := movAvgBegIdx
+	periodTotal2 := 0.0
+	for i := startSum; i < endSum; i++ {
+		tempReal := in[i]
+		tempReal *= tempReal
+		periodTotal2 += tempReal
+	}
+	for i := range movAvg {
+		tempReal := in[endSum]
+		tempReal *= tempReal
+		periodTotal2 += tempReal
+		meanValue2 := periodTotal2 / float64(timePeriod)
+
+		tempReal = in[startSum]
+		tempReal *= tempReal
+		periodTotal2 -= tempReal
+
+		tempReal = movAvg[i]
+		tempReal *= tempReal
+		meanValue2 -= tempReal
+
+		if !isZeroOrNeg(meanValue2) {
+			output[i] = math.Sqrt(meanValue2)
+		}
+		startSum++
+		endSum++
+	}
+	return output
+}
+
+// nativeBBANDSLookback 对应 TA_BBANDS_Lookback，参数无效时返回 -1。
+func nativeBBANDSLookback(timePeriod int, nbDevUp, nbDevDn float64, maType int) int {
+	timePeriod, _, _, maType, err := bbandsParams(timePeriod, nbDevUp, nbDevDn, maType)
+	if err != nil {
+		return -1
+	}
+	// 与 nativeBBands 一致，标准差晚于均线起始时以标准差为准（TA-Lib 仍返回均线的回看期）
+	return max(maLookback(timePeriod, maType), timePeriod-1)
+}
+
+// bbandsParams 按 TA_BBANDS 的规则处理参数。
+func bbandsParams(timePeriod int, nbDevUp, nbDevDn float64, maType int) (int, float64, float64, int, error) {
+	c := paramCheck{fn: "TA_BBANDS"}
+	timePeriod = c.integer("timePeriod", timePeriod, 5, 2, 100000)
+	nbDevUp = c.real("nbDevUp", nbDevUp, 2.0, taRealMin, taRealMax)
+	nbDevDn = c.real("nbDevDn", nbDevDn, 2.0, taRealMin, taRealMax)
+	maType = c.maType("maType", maType)
+	return timePeriod, nbDevUp, nbDevDn, maType, c.err
+}
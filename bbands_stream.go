@@ -0,0 +1,153 @@
+package go4ta
+
+import "math"
+
+// BBandsStream 是 BBands 的流式版本。
+type BBandsStream struct {
+	state                bbandsState
+	upper, middle, lower float64
+	ready                bool
+}
+
+// NewBBandsStream 创建布林带流式指标。
+//
+// @param timePeriod     - 计算周期 (例如 20)
+// @param nbDevUp        - 上轨标准差倍数
+// @param nbDevDn        - 下轨标准差倍数
+// @param maType         - 中轨均线类型
+// @return *BBandsStream - 流式指标
+// @return error         - 参数无效时返回错误
+func NewBBandsStream(timePeriod int, nbDevUp, nbDevDn float64, maType MAType) (*BBandsStream, error) {
+	p, ok := optInteger(timePeriod, 5, 2, 100000)
+	if !ok {
+		return nil, badParam("NewBBandsStream", "timePeriod", timePeriod)
+	}
+	up, ok := optReal(nbDevUp, 2.0, taRealMin, taRealMax)
+	if !ok {
+		return nil, badParam("NewBBandsStream", "nbDevUp", nbDevUp)
+	}
+	dn, ok := optReal(nbDevDn, 2.0, taRealMin, taRealMax)
+	if !ok {
+		return nil, badParam("NewBBandsStream", "nbDevDn", nbDevDn)
+	}
+	t, ok := optMAType(int(maType))
+	if !ok {
+		return nil, badParam("NewBBandsStream", "maType", int(maType))
+	}
+	return &BBandsStream{state: *newBBandsState(p, up, dn, t)}, nil
+}
+
+// Update 输入一个新的收盘价，返回最新的上轨、中轨、下轨，未就绪时均为 0。
+func (s *BBandsStream) Update(close float64) (upper, middle, lower float64) {
+	s.upper, s.middle, s.lower, s.ready = s.state.update(close)
+	return s.upper, s.middle, s.lower
+}
+
+// Value 返回最近一次 Update 的结果。
+func (s *BBandsStream) Value() (upper, middle, lower float64) {
+	return s.upper, s.middle, s.lower
+}
+
+// Ready 表示当前值是否已越过回看期。
+func (s *BBandsStream) Ready() bool {
+	return s.ready
+}
+
+func (s *BBandsStream) snapshot() *streamSnapshot {
+	return &streamSnapshot{kind: "BBANDS", values: []*float64{&s.upper, &s.middle, &s.lower}, ready: &s.ready, state: &s.state}
+}
+
+// MarshalBinary 将完整的计算状态编码为二进制快照。
+func (s *BBandsStream) MarshalBinary() ([]byte, error) {
+	return s.snapshot().marshalBinary()
+}
+
+// UnmarshalBinary 从 MarshalBinary 生成的快照恢复状态。
+func (s *BBandsStream) UnmarshalBinary(data []byte) error {
+	return s.snapshot().unmarshalBinary(data)
+}
+
+// MarshalJSON 将完整的计算状态编码为 JSON 快照。
+func (s *BBandsStream) MarshalJSON() ([]byte, error) {
+	return s.snapshot().marshalJSON()
+}
+
+// UnmarshalJSON 从 MarshalJSON 生成的快照恢复状态。
+func (s *BBandsStream) UnmarshalJSON(data []byte) error {
+	return s.snapshot().unmarshalJSON(data)
+}
+
+// bbandsState 对应 nativeBBands。SMA 中轨沿用 stdDevUsingPrecalcMA 的算法，
+// 其他均线类型的方差与 intVAR 一样从均线第一个有效值之前 timePeriod-1 根开始累加。
+type bbandsState struct {
+	Period           int
+	NbDevUp, NbDevDn float64
+	Count            int
+	VarStart         int // 方差第一个有效值的下标
+	MA               maState
+	Total1, Total2   float64
+	Window           ring
+}
+
+func newBBandsState(timePeriod int, nbDevUp, nbDevDn float64, maType int) *bbandsState {
+	ma := newMAState(timePeriod, maType)
+	return &bbandsState{
+		Period:   timePeriod,
+		NbDevUp:  nbDevUp,
+		NbDevDn:  nbDevDn,
+		VarStart: max(ma.lookback(), timePeriod-1),
+		MA:       ma,
+		Window:   newRing(timePeriod),
+	}
+}
+
+func (s *bbandsState) checkState() error {
+	return s.Window.checkSize(s.Period)
+}
+
+func (s *bbandsState) update(close float64) (upper, middle, lower float64, ok bool) {
+	today := s.Count
+	s.Count++
+	s.Window.push(close)
+	middle, ok = s.MA.update(close)
+	if today < s.VarStart-(s.Period-1) {
+		return 0, 0, 0, false
+	}
+
+	period := float64(s.Period)
+	tempReal := close
+	s.Total1 += tempReal
+	tempReal *= tempReal
+	s.Total2 += tempReal
+	if today < s.VarStart {
+		return 0, 0, 0, false
+	}
+
+	var stdDev float64
+	if s.MA.Type == 0 {
+		meanValue2 := s.Total2 / period
+		tempReal = s.Window.back(s.Period - 1)
+		tempReal *= tempReal
+		s.Total2 -= tempReal
+		tempReal = middle
+		tempReal *= tempReal
+		meanValue2 -= tempReal
+		if !isZeroOrNeg(meanValue2) {
+			stdDev = math.Sqrt(meanValue2)
+		}
+	} else {
+		meanValue1 := s.Total1 / period
+		meanValue2 := s.Total2 / period
+		tempReal = s.Window.back(s.Period - 1)
+		s.Total1 -= tempReal
+		tempReal *= tempReal
+		s.Total2 -= tempReal
+		if variance := meanValue2 - (meanValue1 * meanValue1); !isZeroOrNeg(variance) {
+			stdDev = math.Sqrt(variance)
+		}
+	}
+	if !ok {
+		return 0, 0, 0, false
+	}
+	return middle + (stdDev * s.NbDevUp), middle, middle - (stdDev * s.NbDevDn), true
+}
@@ -54,7 +54,7 @@ func TestBBands(t *testing.T) {
 
 	timePeriod := 5
 	nbDevUp, nbDevDn := 2.0, 2.0
-	maType := 0 // 0=SMA
+	maType := MATypeSMA
 	upper, middle, lower, err := BBands(closeVals, timePeriod, nbDevUp, nbDevDn, maType)
 	if err != nil {
 		t.Fatalf("BBands计算失败: %v", err)
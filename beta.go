@@ -0,0 +1,43 @@
+package go4ta
+
+// BETA 计算贝塔系数（Beta）：以两条序列逐根的变化率做线性回归，real1 的变化率对 real0 的变化率的斜率。
+// real0 通常为指数或基准，real1 为个股；贝塔为1.5表示个股的涨跌幅约为基准的1.5倍。
+//
+// @param real0      - 基准序列，如指数收盘价
+// @param real1      - 个股序列，如个股收盘价，与 real0 等长
+// @param timePeriod - 计算周期，即参与回归的变化率个数（如5）
+// @return []float64 - 贝塔序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func BETA(real0, real1 []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("BETA", "real0, real1", real0, real1)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("BETA", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("BETA", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taBETA(real0, real1, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// BETALookback 返回 BETA 在给定参数下的回看期，即 timePeriod：第一个变化率需要前一根价格。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func BETALookback(timePeriod int) (int, error) {
+	_, err := betaParams(timePeriod)
+	return lookbackResult("TA_BETA", taBETALookback(timePeriod), err)
+}
@@ -0,0 +1,48 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taBETA 调用 TA_BETA。
+func taBETA(real0, real1 []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	cReal0 := (*C.double)(unsafe.Pointer(&real0[0]))
+	cReal1 := (*C.double)(unsafe.Pointer(&real1[0]))
+	output := make([]C.double, len(real0))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_BETA(
+		0,
+		C.int(len(real0)-1),
+		cReal0,
+		cReal1,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := betaParams(timePeriod)
+		return 0, nil, taErr("TA_BETA", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taBETALookback 调用 TA_BETA_Lookback，参数无效时返回 -1。
+func taBETALookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_BETA_Lookback(C.int(timePeriod)))
+}
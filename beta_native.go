@@ -0,0 +1,92 @@
+package go4ta
+
+// nativeBETA 是 TA_BETA 的原生实现。
+func nativeBETA(real0, real1 []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := betaParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intBETA(real0, real1, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeBETALookback 对应 TA_BETA_Lookback，参数无效时返回 -1。
+func nativeBETALookback(timePeriod int) int {
+	timePeriod, err := betaParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod
+}
+
+// betaParams 按 TA_BETA 的规则处理参数。
+func betaParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_BETA"}
+	timePeriod = c.integer("timePeriod", timePeriod, 5, 1, 100000)
+	return timePeriod, c.err
+}
+
+// changeRate 返回 price 相对 last 的变化率，last 为0时返回0。
+func changeRate(price, last float64) float64 {
+	if isZero(last) {
+		return 0.0
+	}
+	return (price - last) / last
+}
+
+// intBETA 对应 TA_BETA：x、y 分别为 in0、in1 逐根的变化率，输出 y 对 x 做线性回归的斜率。
+// 窗口的首尾各用一组上一根价格求变化率，与 TA-Lib 一样在输出之后才移除窗口外的那一对变化率。
+func intBETA(in0, in1 []float64, timePeriod int) (int, []float64) {
+	startIdx := timePeriod
+	if startIdx > len(in0)-1 {
+		return 0, nil
+	}
+
+	output := make([]float64, 0, len(in0)-startIdx)
+	sxx, sxy, sx, sy := 0.0, 0.0, 0.0, 0.0
+	trailingIdx := 0
+	lastX, lastY := in0[trailingIdx], in1[trailingIdx]
+	trailingLastX, trailingLastY := lastX, lastY
+	trailingIdx++
+	i := trailingIdx
+	for ; i < startIdx; i++ {
+		x := changeRate(in0[i], lastX)
+		lastX = in0[i]
+		y := changeRate(in1[i], lastY)
+		lastY = in1[i]
+		sxx += x * x
+		sxy += x * y
+		sx += x
+		sy += y
+	}
+
+	n := float64(timePeriod)
+	for ; i < len(in0); i++ {
+		x := changeRate(in0[i], lastX)
+		lastX = in0[i]
+		y := changeRate(in1[i], lastY)
+		lastY = in1[i]
+		sxx += x * x
+		sxy += x * y
+		sx += x
+		sy += y
+
+		x = changeRate(in0[trailingIdx], trailingLastX)
+		trailingLastX = in0[trailingIdx]
+		y = changeRate(in1[trailingIdx], trailingLastY)
+		trailingLastY = in1[trailingIdx]
+		trailingIdx++
+
+		if tmpReal := (n * sxx) - (sx * sx); !isZero(tmpReal) {
+			output = append(output, ((n*sxy)-(sx*sy))/tmpReal)
+		} else {
+			output = append(output, 0.0)
+		}
+
+		sxx -= x * x
+		sxy -= x * y
+		sx -= x
+		sy -= y
+	}
+	return startIdx, output
+}
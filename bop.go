@@ -0,0 +1,31 @@
+package go4ta
+
+// BOP 计算均势指标（Balance Of Power），(close-open)/(high-low)，取值在 -1 到 1 之间。
+//
+// @param open       - 开盘价序列
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @return []float64 - BOP 结果序列，与输入等长，最高价不高于最低价的价格柱为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func BOP(open, high, low, close []float64) ([]float64, error) {
+	n, err := checkInputs("BOP", "open, high, low, close", open, high, low, close)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	outBegIdx, output, err := taBOP(open, high, low, close)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// BOPLookback 返回 BOP 的回看期。BOP 从第一根价格柱起即有输出，因此恒为 0。
+func BOPLookback() int {
+	return taBOPLookback()
+}
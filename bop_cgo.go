@@ -0,0 +1,50 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taBOP 调用 TA_BOP。
+func taBOP(open, high, low, close []float64) (int, []float64, error) {
+	defer readSettings()()
+	cOpen := (*C.double)(unsafe.Pointer(&open[0]))
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(open))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_BOP(
+		0,
+		C.int(len(open)-1),
+		cOpen,
+		cHigh,
+		cLow,
+		cClose,
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		return 0, nil, taErr("TA_BOP", RetCode(retCode), nil)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taBOPLookback 调用 TA_BOP_Lookback。
+func taBOPLookback() int {
+	defer readSettings()()
+	return int(C.TA_BOP_Lookback())
+}
@@ -0,0 +1,18 @@
+package go4ta
+
+// nativeBOP 是 TA_BOP 的原生实现，最高价不高于最低价时输出0。
+func nativeBOP(open, high, low, close []float64) (int, []float64, error) {
+	output := make([]float64, len(open))
+	for i := range open {
+		tempReal := high[i] - low[i]
+		if !isZeroOrNeg(tempReal) {
+			output[i] = (close[i] - open[i]) / tempReal
+		}
+	}
+	return 0, output, nil
+}
+
+// nativeBOPLookback 对应 TA_BOP_Lookback。
+func nativeBOPLookback() int {
+	return 0
+}
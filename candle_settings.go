@@ -0,0 +1,234 @@
+package go4ta
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// K线形态识别所用的阈值，对应 TA-Lib 的 TA_CandleSetting。
+// 形态判断中的"长实体""极短影线""接近"等都是与前若干根的平均范围相比较，
+// 每项设置给出比较所用的范围、求平均的根数与倍数。
+// 全局设置对所有K线形态函数生效；只想让部分计算使用不同的阈值时（如加密货币与股票的K线），
+// 用 CandleSettings 的方法计算，不影响全局设置。
+
+// CandleRangeType 对应 TA-Lib 的 TA_RangeType，是计算平均范围时每根价格柱取的量。
+type CandleRangeType int
+
+const (
+	CandleRangeRealBody CandleRangeType = iota // 实体长度 |close-open|
+	CandleRangeHighLow                         // 最高价与最低价之差
+	CandleRangeShadows                         // 上下影线之和
+)
+
+// CandleSettingType 对应 TA-Lib 的 TA_CandleSettingType。
+type CandleSettingType int
+
+const (
+	CandleBodyLong CandleSettingType = iota
+	CandleBodyVeryLong
+	CandleBodyShort
+	CandleBodyDoji
+	CandleShadowLong
+	CandleShadowVeryLong
+	CandleShadowShort
+	CandleShadowVeryShort
+	CandleNear
+	CandleFar
+	CandleEqual
+	// CandleAllSettings 用于 RestoreCandleDefaultSettings，一次恢复全部设置。
+	CandleAllSettings
+)
+
+var candleSettingNames = [CandleAllSettings]string{
+	"BodyLong", "BodyVeryLong", "BodyShort", "BodyDoji", "ShadowLong", "ShadowVeryLong",
+	"ShadowShort", "ShadowVeryShort", "Near", "Far", "Equal",
+}
+
+func (t CandleSettingType) String() string {
+	if t < 0 || t >= CandleAllSettings {
+		return fmt.Sprintf("CandleSettingType(%d)", int(t))
+	}
+	return candleSettingNames[t]
+}
+
+// maxCandleAvgPeriod 限制求平均的根数，与周期参数的上限相同。
+const maxCandleAvgPeriod = 100000
+
+// CandleSetting 是一项K线形态设置：比较的阈值为前 AvgPeriod 根 RangeType 的平均值乘以 Factor，
+// AvgPeriod 为0时取当前这一根的值；RangeType 为影线时再除以2，即取单侧影线的平均。
+type CandleSetting struct {
+	RangeType CandleRangeType
+	AvgPeriod int
+	Factor    float64
+}
+
+// CandleSettings 是全部K线形态设置，以 CandleSettingType 为下标。
+type CandleSettings [CandleAllSettings]CandleSetting
+
+// defaultCandleSettings 是 TA-Lib 的默认设置（TA_RestoreCandleDefaultSettings）。
+var defaultCandleSettings = CandleSettings{
+	CandleBodyLong:        {CandleRangeRealBody, 10, 1.0},
+	CandleBodyVeryLong:    {CandleRangeRealBody, 10, 3.0},
+	CandleBodyShort:       {CandleRangeRealBody, 10, 1.0},
+	CandleBodyDoji:        {CandleRangeHighLow, 10, 0.1},
+	CandleShadowLong:      {CandleRangeRealBody, 0, 1.0},
+	CandleShadowVeryLong:  {CandleRangeRealBody, 0, 2.0},
+	CandleShadowShort:     {CandleRangeShadows, 10, 1.0},
+	CandleShadowVeryShort: {CandleRangeHighLow, 10, 0.1},
+	CandleNear:            {CandleRangeHighLow, 5, 0.2},
+	CandleFar:             {CandleRangeHighLow, 5, 0.6},
+	CandleEqual:           {CandleRangeHighLow, 5, 0.05},
+}
+
+// DefaultCandleSettings 返回 TA-Lib 的默认K线形态设置，修改其中几项后即可用于计算或 SetCandleSettings。
+func DefaultCandleSettings() CandleSettings {
+	return defaultCandleSettings
+}
+
+// Validate 检查各项设置：RangeType 须为已定义的取值，AvgPeriod 在 0..100000 之间，Factor 不小于0。
+//
+// @return error - 设置无效时返回 ErrBadParam，Param 为出错的项，如 "BodyLong.AvgPeriod"
+func (s CandleSettings) Validate() error {
+	for i, c := range s {
+		t := CandleSettingType(i).String()
+		if c.RangeType < CandleRangeRealBody || c.RangeType > CandleRangeShadows {
+			return badParam("TA_SetCandleSettings", t+".RangeType", c.RangeType)
+		}
+		if c.AvgPeriod < 0 || c.AvgPeriod > maxCandleAvgPeriod {
+			return badParam("TA_SetCandleSettings", t+".AvgPeriod", c.AvgPeriod)
+		}
+		if math.IsNaN(c.Factor) || c.Factor < 0 || c.Factor > taRealMax {
+			return badParam("TA_SetCandleSettings", t+".Factor", c.Factor)
+		}
+	}
+	return nil
+}
+
+// GetCandleSettings 返回当前全部K线形态设置的副本。
+func GetCandleSettings() CandleSettings {
+	defer readSettings()()
+	return settings.candles
+}
+
+// GetCandleSetting 返回一项K线形态设置，t 无效或为 CandleAllSettings 时返回零值。
+func GetCandleSetting(t CandleSettingType) CandleSetting {
+	if t < 0 || t >= CandleAllSettings {
+		return CandleSetting{}
+	}
+	defer readSettings()()
+	return settings.candles[t]
+}
+
+// SetCandleSetting 对应 TA_SetCandleSettings，修改一项K线形态设置。
+// 设置对之后开始的所有计算生效，可与计算并发调用。
+//
+// @param t       - 设置项
+// @param setting - 新的设置
+// @return error  - 参数无效时返回错误，此时全局设置不变
+func SetCandleSetting(t CandleSettingType, setting CandleSetting) error {
+	if t < 0 || t >= CandleAllSettings {
+		return badParam("TA_SetCandleSettings", "settingType", t)
+	}
+	settings.mu.Lock()
+	defer settings.mu.Unlock()
+	s := settings.candles
+	s[t] = setting
+	return setCandleSettings(s)
+}
+
+// SetCandleSettings 一次替换全部K线形态设置，并发的计算看到的要么全是旧设置，要么全是新设置。
+//
+// @param s      - 新的设置，通常由 DefaultCandleSettings 或 GetCandleSettings 修改而来
+// @return error - 设置无效时返回错误，此时全局设置不变
+func SetCandleSettings(s CandleSettings) error {
+	settings.mu.Lock()
+	defer settings.mu.Unlock()
+	return setCandleSettings(s)
+}
+
+// RestoreCandleDefaultSettings 对应 TA_RestoreCandleDefaultSettings，把设置项 t 恢复为默认值，
+// t 为 CandleAllSettings 时恢复全部设置。
+//
+// @param t      - 设置项
+// @return error - t 无效时返回错误
+func RestoreCandleDefaultSettings(t CandleSettingType) error {
+	if t < 0 || t > CandleAllSettings {
+		return badParam("TA_RestoreCandleDefaultSettings", "settingType", t)
+	}
+	settings.mu.Lock()
+	defer settings.mu.Unlock()
+	s := defaultCandleSettings
+	if t != CandleAllSettings {
+		s = settings.candles
+		s[t] = defaultCandleSettings[t]
+	}
+	return setCandleSettings(s)
+}
+
+// setCandleSettings 检查 s 后写入 TA-Lib 与 Go 侧的副本，调用方须持有写锁。
+func setCandleSettings(s CandleSettings) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	if code := taSetCandleSettings(&s); code != RetSuccess {
+		// TA-Lib 可能已写入一部分，恢复原有的设置
+		taSetCandleSettings(&settings.candles)
+		return &FuncError{Func: "TA_SetCandleSettings", Code: code}
+	}
+	settings.candles = s
+	return nil
+}
+
+// withCandleSettings 在K线形态设置不变的前提下执行 run。s 为 nil 时持有读锁，使用全局设置；
+// 否则持有写锁，临时换用 s，结束后恢复原有的设置。
+// TA-Lib 只有一份全局设置，因此指定设置的计算不能与其他计算并行，只能串行执行。
+func withCandleSettings(s *CandleSettings, run func() error) error {
+	if s == nil {
+		defer readSettings()()
+		return run()
+	}
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	settings.mu.Lock()
+	defer settings.mu.Unlock()
+	saved := settings.candles
+	defer func() {
+		taSetCandleSettings(&saved)
+		settings.candles = saved
+	}()
+	if code := taSetCandleSettings(s); code != RetSuccess {
+		return &FuncError{Func: "TA_SetCandleSettings", Code: code}
+	}
+	settings.candles = *s
+	return run()
+}
+
+// CandlePattern 以 s 为K线形态设置识别一个形态，不改变全局设置。参数与返回值见 CandlePattern，
+// 设置无效时返回 ErrBadParam。
+func (s CandleSettings) CandlePattern(name string, open, high, low, close []float64, params ...float64) ([]int, error) {
+	p, ok := cdlPatternByName[strings.ToUpper(name)]
+	if !ok {
+		return nil, &FuncError{Func: name, Code: RetFuncNotFound}
+	}
+	return cdlWith(&s, p.name, open, high, low, close, params...)
+}
+
+// CandlePatterns 以 s 为K线形态设置一次识别全部形态，不改变全局设置。返回值见 CandlePatterns。
+func (s CandleSettings) CandlePatterns(open, high, low, close []float64) ([][]string, error) {
+	return candlePatterns(&s, open, high, low, close)
+}
+
+// Lookback 返回以 s 为K线形态设置时形态 name 的回看期，参数见 Lookback。
+// 回看期取决于各项设置的 AvgPeriod，因此可能与全局设置下的不同。
+func (s CandleSettings) Lookback(name string, params ...float64) (int, error) {
+	p, ok := cdlPatternByName[strings.ToUpper(name)]
+	if !ok {
+		return 0, &FuncError{Func: name, Code: RetFuncNotFound}
+	}
+	if n := len(indicators[p.name].Params); len(params) > n {
+		return 0, fmt.Errorf("%s takes at most %d parameters, got %d: %w", name, n, len(params), ErrBadParam)
+	}
+	return cdlLookbackWith(&s, p.name, lookbackParams(params).real(0))
+}
@@ -0,0 +1,226 @@
+package go4ta
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// dojiBars 的最后一根实体为0.05，前10根的平均振幅为2，默认设置下是十字星（阈值0.2）。
+func dojiBars() *Bars {
+	return patternBars(10, Candle{Open: 100, High: 101, Low: 99, Close: 100.05})
+}
+
+func TestCandleSettings(t *testing.T) {
+	resetSettings(t)
+	b := dojiBars()
+	doji := func() int {
+		t.Helper()
+		got, err := CDLDOJI(b.Open, b.High, b.Low, b.Close)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return got[10]
+	}
+	if got := GetCandleSettings(); got != DefaultCandleSettings() {
+		t.Fatalf("GetCandleSettings = %v", got)
+	}
+
+	strict := CandleSetting{RangeType: CandleRangeHighLow, AvgPeriod: 10, Factor: 0.01}
+	if err := SetCandleSetting(CandleBodyDoji, strict); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetCandleSetting(CandleBodyDoji); got != strict {
+		t.Errorf("GetCandleSetting(BodyDoji) = %v", got)
+	}
+	if got := doji(); got != 0 {
+		t.Errorf("CDLDOJI with factor 0.01 = %d, want 0", got)
+	}
+	if err := RestoreCandleDefaultSettings(CandleBodyDoji); err != nil {
+		t.Fatal(err)
+	}
+	if got := doji(); got != 100 {
+		t.Errorf("CDLDOJI after restore = %d, want 100", got)
+	}
+
+	// 回看期随 AvgPeriod 变化
+	s := DefaultCandleSettings()
+	s[CandleBodyDoji].AvgPeriod = 5
+	if err := SetCandleSettings(s); err != nil {
+		t.Fatal(err)
+	}
+	if lookback, err := Lookback("CDLDOJI"); err != nil || lookback != 5 {
+		t.Errorf("Lookback(CDLDOJI) = %d, %v", lookback, err)
+	}
+	if err := RestoreCandleDefaultSettings(CandleAllSettings); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetCandleSettings(); got != DefaultCandleSettings() {
+		t.Errorf("GetCandleSettings after restore = %v", got)
+	}
+
+	// Initialize 同样恢复默认设置
+	SetCandleSetting(CandleNear, CandleSetting{CandleRangeRealBody, 3, 1})
+	if err := Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetCandleSetting(CandleNear); got != DefaultCandleSettings()[CandleNear] {
+		t.Errorf("GetCandleSetting(Near) after Initialize = %v", got)
+	}
+}
+
+func TestCandleSettingsPerCall(t *testing.T) {
+	resetSettings(t)
+	b := dojiBars()
+	s := DefaultCandleSettings()
+	s[CandleBodyDoji] = CandleSetting{RangeType: CandleRangeHighLow, AvgPeriod: 20, Factor: 0.01}
+
+	got, err := s.CandlePattern("cdldoji", b.Open, b.High, b.Low, b.Close)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 数据不足20根，全部位于回看期内
+	if want := make([]int, 11); !slices.Equal(got, want) {
+		t.Errorf("CandlePattern with settings = %v, want %v", got, want)
+	}
+	s[CandleBodyDoji].AvgPeriod = 10
+	if got, err := s.CandlePattern("CDLDOJI", b.Open, b.High, b.Low, b.Close); err != nil || got[10] != 0 {
+		t.Errorf("CandlePattern with factor 0.01 = %v, %v", got, err)
+	}
+	if all, err := s.CandlePatterns(b.Open, b.High, b.Low, b.Close); err != nil || slices.Contains(all[10], "CDLDOJI") {
+		t.Errorf("CandlePatterns with settings = %v, %v", all, err)
+	}
+	if lookback, err := s.Lookback("CDLMORNINGSTAR", 0.3); err != nil || lookback != 12 {
+		t.Errorf("Lookback(CDLMORNINGSTAR) = %d, %v", lookback, err)
+	}
+	s[CandleBodyShort].AvgPeriod = 15
+	if lookback, err := s.Lookback("CDLMORNINGSTAR"); err != nil || lookback != 17 {
+		t.Errorf("Lookback(CDLMORNINGSTAR) with BodyShort 15 = %d, %v", lookback, err)
+	}
+
+	// 全局设置不受影响
+	if got := GetCandleSettings(); got != DefaultCandleSettings() {
+		t.Errorf("GetCandleSettings = %v", got)
+	}
+	if got, err := CDLDOJI(b.Open, b.High, b.Low, b.Close); err != nil || got[10] != 100 {
+		t.Errorf("CDLDOJI = %v, %v", got, err)
+	}
+
+	if _, err := s.CandlePattern("CDLNONE", b.Open, b.High, b.Low, b.Close); !errors.Is(err, RetFuncNotFound) {
+		t.Errorf("CandlePattern(CDLNONE) = %v", err)
+	}
+	if _, err := s.Lookback("CDLDOJI", 0.3); !errors.Is(err, ErrBadParam) {
+		t.Errorf("Lookback(CDLDOJI, 0.3) = %v", err)
+	}
+}
+
+func TestCandleSettingsInvalid(t *testing.T) {
+	resetSettings(t)
+	bad := func(t CandleSettingType, c CandleSetting) CandleSettings {
+		s := DefaultCandleSettings()
+		s[t] = c
+		return s
+	}
+	for _, c := range []struct {
+		settings CandleSettings
+		param    string
+	}{
+		{bad(CandleBodyLong, CandleSetting{RangeType: 3, AvgPeriod: 10, Factor: 1}), "BodyLong.RangeType"},
+		{bad(CandleShadowShort, CandleSetting{RangeType: CandleRangeShadows, AvgPeriod: -1, Factor: 1}), "ShadowShort.AvgPeriod"},
+		{bad(CandleNear, CandleSetting{RangeType: CandleRangeHighLow, AvgPeriod: 5, Factor: -0.2}), "Near.Factor"},
+		{bad(CandleEqual, CandleSetting{RangeType: CandleRangeHighLow, AvgPeriod: 5, Factor: math.NaN()}), "Equal.Factor"},
+	} {
+		var fe *FuncError
+		if err := SetCandleSettings(c.settings); !errors.As(err, &fe) || fe.Param != c.param || !errors.Is(err, ErrBadParam) {
+			t.Errorf("SetCandleSettings with bad %s = %v", c.param, err)
+		}
+		if _, err := c.settings.CandlePatterns(testBars().Open, testBars().High, testBars().Low, testBars().Close); !errors.Is(err, ErrBadParam) {
+			t.Errorf("CandlePatterns with bad %s = %v", c.param, err)
+		}
+	}
+	for _, err := range []error{
+		SetCandleSetting(-1, DefaultCandleSettings()[0]),
+		SetCandleSetting(CandleAllSettings, DefaultCandleSettings()[0]),
+		SetCandleSetting(CandleFar, CandleSetting{RangeType: CandleRangeHighLow, AvgPeriod: 100001, Factor: 0.6}),
+		RestoreCandleDefaultSettings(CandleAllSettings + 1),
+	} {
+		if !errors.Is(err, ErrBadParam) {
+			t.Errorf("got %v, want ErrBadParam", err)
+		}
+	}
+	if got := GetCandleSettings(); got != DefaultCandleSettings() {
+		t.Errorf("GetCandleSettings after invalid settings = %v", got)
+	}
+	if got := GetCandleSetting(CandleAllSettings); got != (CandleSetting{}) {
+		t.Errorf("GetCandleSetting(CandleAllSettings) = %v", got)
+	}
+	if got := CandleSettingType(11).String(); got != "CandleSettingType(11)" {
+		t.Errorf("CandleSettingType(11).String() = %q", got)
+	}
+}
+
+// TestCandleSettingsConcurrent 在识别形态的同时修改全局设置并以其他设置单独计算，
+// 每次计算的结果须完整对应其中一种设置。
+func TestCandleSettingsConcurrent(t *testing.T) {
+	resetSettings(t)
+	b := testBars()
+	loose := DefaultCandleSettings()
+	loose[CandleBodyDoji].Factor = 0.3
+	loose[CandleShadowVeryShort].AvgPeriod = 3
+	strict := DefaultCandleSettings()
+	strict[CandleBodyDoji].Factor = 0.02
+	want := make(map[string]bool)
+	for _, s := range []CandleSettings{DefaultCandleSettings(), loose, strict} {
+		got, err := s.CandlePattern("CDLDRAGONFLYDOJI", b.Open, b.High, b.Low, b.Close)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want[fmt.Sprint(got)] = true
+	}
+	if len(want) != 3 {
+		t.Fatalf("settings give %d distinct results, want 3", len(want))
+	}
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for g := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				var got []int
+				var err error
+				if g%2 == 0 {
+					got, err = CDLDRAGONFLYDOJI(b.Open, b.High, b.Low, b.Close)
+				} else {
+					got, err = strict.CandlePattern("CDLDRAGONFLYDOJI", b.Open, b.High, b.Low, b.Close)
+				}
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if !want[fmt.Sprint(got)] {
+					t.Error("CDLDRAGONFLYDOJI computed with mixed settings")
+					return
+				}
+			}
+		}()
+	}
+	for i := range 200 {
+		if i%2 == 0 {
+			SetCandleSettings(loose)
+		} else {
+			RestoreCandleDefaultSettings(CandleAllSettings)
+		}
+	}
+	close(done)
+	wg.Wait()
+}
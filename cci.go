@@ -0,0 +1,43 @@
+package go4ta
+
+// CCI 计算顺势指标（Commodity Channel Index），典型价格偏离其均值的程度，以 0.015 倍平均绝对偏差为单位。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - CCI 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func CCI(high, low, close []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("CCI", "high, low, close", high, low, close)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("CCI", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("CCI", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taCCI(high, low, close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// CCILookback 返回 CCI 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func CCILookback(timePeriod int) (int, error) {
+	_, err := cciParams(timePeriod)
+	return lookbackResult("TA_CCI", taCCILookback(timePeriod), err)
+}
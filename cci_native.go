@@ -0,0 +1,73 @@
+package go4ta
+
+import "math"
+
+// nativeCCI 是 TA_CCI 的原生实现。
+func nativeCCI(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := cciParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	lookbackTotal := timePeriod - 1
+	startIdx := lookbackTotal
+	if startIdx > len(high)-1 {
+		return 0, nil, nil
+	}
+
+	// 与 TA-Lib 相同地在环形缓冲区中保存典型价格，并按缓冲区顺序求和，以得到相同的舍入
+	circBuffer := make([]float64, timePeriod)
+	circBufferIdx := 0
+	i := startIdx - lookbackTotal
+	for ; i < startIdx; i++ {
+		circBuffer[circBufferIdx] = (high[i] + low[i] + close[i]) / 3
+		circBufferIdx++
+	}
+
+	period := float64(timePeriod)
+	output := make([]float64, 0, len(high)-startIdx)
+	for ; i < len(high); i++ {
+		lastValue := (high[i] + low[i] + close[i]) / 3
+		circBuffer[circBufferIdx] = lastValue
+
+		theAverage := 0.0
+		for _, v := range circBuffer {
+			theAverage += v
+		}
+		theAverage /= period
+
+		tempReal2 := 0.0
+		for _, v := range circBuffer {
+			tempReal2 += math.Abs(v - theAverage)
+		}
+
+		tempReal := lastValue - theAverage
+		if tempReal != 0.0 && tempReal2 != 0.0 {
+			output = append(output, tempReal/(0.015*(tempReal2/period)))
+		} else {
+			output = append(output, 0.0)
+		}
+
+		circBufferIdx++
+		if circBufferIdx > timePeriod-1 {
+			circBufferIdx = 0
+		}
+	}
+	return startIdx, output, nil
+}
+
+// nativeCCILookback 对应 TA_CCI_Lookback，参数无效时返回 -1。
+func nativeCCILookback(timePeriod int) int {
+	timePeriod, err := cciParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// cciParams 按 TA_CCI 的规则处理参数。
+func cciParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_CCI"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,559 @@
+package go4ta
+
+import "strings"
+
+// K线形态识别，对应 TA-Lib 的 TA_CDL* 函数。形态以开盘价、最高价、最低价、收盘价判断，
+// 结果为整数序列：100 表示看涨（或形态本身不分方向），-100 表示看跌，0 表示没有形态；
+// 部分形态以 ±80 表示较弱的形态、以 ±200 表示得到确认的形态。
+// "长实体""短影线""接近"等都是与前若干根的平均范围比较，阈值见K线形态设置。
+
+// cdlPattern 是一个K线形态的说明。
+type cdlPattern struct {
+	name        string  // TA-Lib 函数名，如 "CDLENGULFING"
+	hint        string  // TA-Lib 函数说明（TA_FuncInfo.hint）
+	title       string  // 注册表中的简短说明
+	penetration float64 // penetration 参数的默认值，不带该参数的形态为0
+}
+
+// hasPenetration 表示形态是否带 penetration 参数，即第三根深入第一根实体的比例。
+func (p *cdlPattern) hasPenetration() bool {
+	return p.penetration != 0
+}
+
+// cdlPatterns 按 TA-Lib 函数名排列所有K线形态。
+var cdlPatterns = []cdlPattern{
+	{"CDL2CROWS", "Two Crows", "两只乌鸦", 0},
+	{"CDL3BLACKCROWS", "Three Black Crows", "三只乌鸦", 0},
+	{"CDL3INSIDE", "Three Inside Up/Down", "三内部上涨/下跌", 0},
+	{"CDL3LINESTRIKE", "Three-Line Strike ", "三线打击", 0},
+	{"CDL3OUTSIDE", "Three Outside Up/Down", "三外部上涨/下跌", 0},
+	{"CDL3STARSINSOUTH", "Three Stars In The South", "南方三星", 0},
+	{"CDL3WHITESOLDIERS", "Three Advancing White Soldiers", "三个白兵", 0},
+	{"CDLABANDONEDBABY", "Abandoned Baby", "弃婴", 0.3},
+	{"CDLADVANCEBLOCK", "Advance Block", "大敌当前", 0},
+	{"CDLBELTHOLD", "Belt-hold", "捉腰带线", 0},
+	{"CDLBREAKAWAY", "Breakaway", "脱离", 0},
+	{"CDLCLOSINGMARUBOZU", "Closing Marubozu", "收盘缺影线", 0},
+	{"CDLCONCEALBABYSWALL", "Concealing Baby Swallow", "藏婴吞没", 0},
+	{"CDLCOUNTERATTACK", "Counterattack", "反击线", 0},
+	{"CDLDARKCLOUDCOVER", "Dark Cloud Cover", "乌云压顶", 0.5},
+	{"CDLDOJI", "Doji", "十字星", 0},
+	{"CDLDOJISTAR", "Doji Star", "十字星（跳空）", 0},
+	{"CDLDRAGONFLYDOJI", "Dragonfly Doji", "蜻蜓十字", 0},
+	{"CDLENGULFING", "Engulfing Pattern", "吞没形态", 0},
+	{"CDLEVENINGDOJISTAR", "Evening Doji Star", "十字暮星", 0.3},
+	{"CDLEVENINGSTAR", "Evening Star", "暮星", 0.3},
+	{"CDLGAPSIDESIDEWHITE", "Up/Down-gap side-by-side white lines", "向上/向下跳空并列阳线", 0},
+	{"CDLGRAVESTONEDOJI", "Gravestone Doji", "墓碑十字", 0},
+	{"CDLHAMMER", "Hammer", "锤子线", 0},
+	{"CDLHANGINGMAN", "Hanging Man", "上吊线", 0},
+	{"CDLHARAMI", "Harami Pattern", "孕线", 0},
+	{"CDLHARAMICROSS", "Harami Cross Pattern", "十字孕线", 0},
+	{"CDLHIGHWAVE", "High-Wave Candle", "风高浪大线", 0},
+	{"CDLHIKKAKE", "Hikkake Pattern", "陷阱", 0},
+	{"CDLHIKKAKEMOD", "Modified Hikkake Pattern", "修正陷阱", 0},
+	{"CDLHOMINGPIGEON", "Homing Pigeon", "家鸽", 0},
+	{"CDLIDENTICAL3CROWS", "Identical Three Crows", "三胞胎乌鸦", 0},
+	{"CDLINNECK", "In-Neck Pattern", "颈内线", 0},
+	{"CDLINVERTEDHAMMER", "Inverted Hammer", "倒锤子线", 0},
+	{"CDLKICKING", "Kicking", "反冲形态", 0},
+	{"CDLKICKINGBYLENGTH", "Kicking - bull/bear determined by the longer marubozu", "反冲形态（由较长的光头光脚线定方向）", 0},
+	{"CDLLADDERBOTTOM", "Ladder Bottom", "梯底", 0},
+	{"CDLLONGLEGGEDDOJI", "Long Legged Doji", "长腿十字", 0},
+	{"CDLLONGLINE", "Long Line Candle", "长蜡烛", 0},
+	{"CDLMARUBOZU", "Marubozu", "光头光脚", 0},
+	{"CDLMATCHINGLOW", "Matching Low", "相同低价", 0},
+	{"CDLMATHOLD", "Mat Hold", "铺垫", 0.5},
+	{"CDLMORNINGDOJISTAR", "Morning Doji Star", "十字晨星", 0.3},
+	{"CDLMORNINGSTAR", "Morning Star", "晨星", 0.3},
+	{"CDLONNECK", "On-Neck Pattern", "颈上线", 0},
+	{"CDLPIERCING", "Piercing Pattern", "刺透形态", 0},
+	{"CDLRICKSHAWMAN", "Rickshaw Man", "黄包车夫", 0},
+	{"CDLRISEFALL3METHODS", "Rising/Falling Three Methods", "上升/下降三法", 0},
+	{"CDLSEPARATINGLINES", "Separating Lines", "分离线", 0},
+	{"CDLSHOOTINGSTAR", "Shooting Star", "射击之星", 0},
+	{"CDLSHORTLINE", "Short Line Candle", "短蜡烛", 0},
+	{"CDLSPINNINGTOP", "Spinning Top", "纺锤线", 0},
+	{"CDLSTALLEDPATTERN", "Stalled Pattern", "停顿形态", 0},
+	{"CDLSTICKSANDWICH", "Stick Sandwich", "条形三明治", 0},
+	{"CDLTAKURI", "Takuri (Dragonfly Doji with very long lower shadow)", "探水竿", 0},
+	{"CDLTASUKIGAP", "Tasuki Gap", "跳空并列阴阳线", 0},
+	{"CDLTHRUSTING", "Thrusting Pattern", "插入形态", 0},
+	{"CDLTRISTAR", "Tristar Pattern", "三星", 0},
+	{"CDLUNIQUE3RIVER", "Unique 3 River", "奇特三河床", 0},
+	{"CDLUPSIDEGAP2CROWS", "Upside Gap Two Crows", "向上跳空的两只乌鸦", 0},
+	{"CDLXSIDEGAP3METHODS", "Upside/Downside Gap Three Methods", "上升/下降跳空三法", 0},
+}
+
+// cdlPatternByName 以 TA-Lib 函数名为键索引 cdlPatterns。
+var cdlPatternByName = func() map[string]*cdlPattern {
+	m := make(map[string]*cdlPattern, len(cdlPatterns))
+	for i := range cdlPatterns {
+		m[cdlPatterns[i].name] = &cdlPatterns[i]
+	}
+	return m
+}()
+
+// 在注册表与按名称调用的原生函数表中加入各K线形态。
+func init() {
+	for i := range cdlPatterns {
+		p := &cdlPatterns[i]
+		ind := &Indicator{
+			Name: p.name, Func: p.name, Hint: p.title, Kind: KindPattern,
+			Inputs: ohlcInput, Params: []ParamSpec{}, Outputs: []string{"pattern"},
+			Lookback: cdlRecognizers[p.name].lookback.String(),
+			lookback: func(lp lookbackParams) (int, error) {
+				return cdlLookbackWith(nil, p.name, lp.real(0))
+			},
+		}
+		info := FuncInfo{Name: p.name, Group: groupPattern, Hint: p.hint, Candlestick: true,
+			Inputs: []FuncInput{inPriceOHLC}, Outputs: outInteger}
+		if p.hasPenetration() {
+			ind.Params = []ParamSpec{nonNegSpec("penetration", p.penetration)}
+			info.Params = []FuncParam{optInNonNeg("optInPenetration", p.penetration)}
+		}
+		indicators[p.name] = ind
+		nativeFuncs[p.name] = nativeFunc{info, func(in [][]float64, params []float64) (int, [][]float64, error) {
+			outBegIdx, output, err := nativeCDL(p.name, in[0], in[1], in[2], in[3], lookbackParams(params).real(0))
+			return outBegIdx, [][]float64{intsToFloats(output)}, err
+		}}
+	}
+}
+
+// CandlePatternNames 返回所有K线形态的名称（TA-Lib 函数名），按字母顺序排列。
+func CandlePatternNames() []string {
+	names := make([]string, len(cdlPatterns))
+	for i, p := range cdlPatterns {
+		names[i] = p.name
+	}
+	return names
+}
+
+// CandlePattern 按名称识别一个K线形态，与调用同名的导出函数相同。回看期可用 Lookback(name) 查询。
+//
+// @param name    - 形态名称，即 TA-Lib 函数名，不区分大小写，如 "CDLENGULFING"
+// @param open    - 开盘价序列
+// @param high    - 最高价序列
+// @param low     - 最低价序列
+// @param close   - 收盘价序列
+// @param params  - 带 penetration 参数的形态可给出该参数，省略时取默认值
+// @return []int  - 形态序列，与输入等长，回看期内为0，FillTrim 下只包含回看期之后的部分。
+// @return error  - 名称未知、输入数据无效或参数无效时返回错误。
+func CandlePattern(name string, open, high, low, close []float64, params ...float64) ([]int, error) {
+	p, ok := cdlPatternByName[strings.ToUpper(name)]
+	if !ok {
+		return nil, &FuncError{Func: name, Code: RetFuncNotFound}
+	}
+	return cdl(p.name, open, high, low, close, params...)
+}
+
+// CandlePatterns 一次识别全部K线形态，返回每根价格柱上出现的形态名称，用于标注或筛选。
+// 各形态使用默认参数，并在同一份K线形态设置下计算。结果不受 SetFillPolicy 影响。
+//
+// @param open        - 开盘价序列
+// @param high        - 最高价序列
+// @param low         - 最低价序列
+// @param close       - 收盘价序列
+// @return [][]string - 与输入等长，第 i 个元素是第 i 根上结果不为0的形态名称，按名称排列；没有形态时为 nil。
+// @return error      - 如果输入数据无效或计算失败，则返回错误。
+func CandlePatterns(open, high, low, close []float64) ([][]string, error) {
+	return candlePatterns(nil, open, high, low, close)
+}
+
+// candlePatterns 是 CandlePatterns 的实现，s 为 nil 时使用全局的K线形态设置。
+func candlePatterns(s *CandleSettings, open, high, low, close []float64) ([][]string, error) {
+	n, err := checkInputs("CandlePatterns", "open, high, low, close", open, high, low, close)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return [][]string{}, nil
+	}
+
+	outBegIdx := make([]int, len(cdlPatterns))
+	outputs := make([][]int, len(cdlPatterns))
+	err = withCandleSettings(s, func() error {
+		for k, p := range cdlPatterns {
+			var err error
+			if outBegIdx[k], outputs[k], err = taCDL(p.name, open, high, low, close, taRealDefault); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][]string, n)
+	for k, p := range cdlPatterns {
+		for j, v := range outputs[k] {
+			if v != 0 {
+				i := outBegIdx[k] + j
+				result[i] = append(result[i], p.name)
+			}
+		}
+	}
+	return result, nil
+}
+
+// cdl 是各K线形态导出函数的共同实现，name 为 TA-Lib 函数名，params 为空或只含 penetration。
+func cdl(name string, open, high, low, close []float64, params ...float64) ([]int, error) {
+	return cdlWith(nil, name, open, high, low, close, params...)
+}
+
+// cdlWith 以 s 为K线形态设置计算形态 name，s 为 nil 时使用全局设置。
+func cdlWith(s *CandleSettings, name string, open, high, low, close []float64, params ...float64) ([]int, error) {
+	n, err := checkInputs(name, "open, high, low, close", open, high, low, close)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []int{}, nil
+	}
+
+	if err := checkParams(name, params...); err != nil {
+		return nil, err
+	}
+
+	var outBegIdx int
+	var output []int
+	err = withCandleSettings(s, func() error {
+		var err error
+		outBegIdx, output, err = taCDL(name, open, high, low, close, lookbackParams(params).real(0))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return spreadInt(n, outBegIdx, output), nil
+}
+
+// cdlLookbackWith 返回以 s 为K线形态设置时形态 name 的回看期，s 为 nil 时使用全局设置。
+func cdlLookbackWith(s *CandleSettings, name string, penetration float64) (int, error) {
+	_, _, paramErr := cdlParams(name, penetration)
+	var lookback int
+	err := withCandleSettings(s, func() error {
+		lookback = taCDLLookback(name, penetration)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return lookbackResult("TA_"+name, lookback, paramErr)
+}
+
+// 以下各函数识别一个K线形态，参数与返回值见 CandlePattern。
+
+// CDL2CROWS 识别两只乌鸦（看跌）：长阳线之后是向上跳空的阴线，第三根阴线在第二根实体内开盘、收于第一根的实体内。
+func CDL2CROWS(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDL2CROWS", open, high, low, close)
+}
+
+// CDL3BLACKCROWS 识别三只乌鸦（看跌）：阳线之后三根收盘价依次降低、下影线极短的阴线，每根都在前一根的实体内开盘。
+func CDL3BLACKCROWS(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDL3BLACKCROWS", open, high, low, close)
+}
+
+// CDL3INSIDE 识别三内部上涨/下跌：孕线之后第三根朝与第一根相反的方向收于第一根开盘价之外，上涨为100、下跌为-100。
+func CDL3INSIDE(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDL3INSIDE", open, high, low, close)
+}
+
+// CDL3LINESTRIKE 识别三线打击：三根同向推进的同色K线之后，第四根反向并收于第一根的开盘价之外，方向取前三根的颜色。
+func CDL3LINESTRIKE(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDL3LINESTRIKE", open, high, low, close)
+}
+
+// CDL3OUTSIDE 识别三外部上涨/下跌：吞没形态之后第三根继续朝吞没的方向收盘。
+func CDL3OUTSIDE(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDL3OUTSIDE", open, high, low, close)
+}
+
+// CDL3STARSINSOUTH 识别南方三星（看涨）：三根逐步缩小的阴线，最后一根是位于第二根范围内的小光头光脚线。
+func CDL3STARSINSOUTH(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDL3STARSINSOUTH", open, high, low, close)
+}
+
+// CDL3WHITESOLDIERS 识别三个白兵（看涨）：三根收盘价依次升高、上影线极短的阳线，每根都在前一根的实体内或附近开盘。
+func CDL3WHITESOLDIERS(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDL3WHITESOLDIERS", open, high, low, close)
+}
+
+// CDLABANDONEDBABY 识别弃婴：长实体之后是前后都有跳空的十字星，第三根反向并深入第一根的实体。
+// penetration 为第三根深入第一根实体的比例，默认 0.3。
+func CDLABANDONEDBABY(open, high, low, close []float64, penetration float64) ([]int, error) {
+	return cdl("CDLABANDONEDBABY", open, high, low, close, penetration)
+}
+
+// CDLADVANCEBLOCK 识别大敌当前（看跌）：三根逐根走高的阳线，实体逐渐缩短或上影线变长，显示上涨受阻。
+func CDLADVANCEBLOCK(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLADVANCEBLOCK", open, high, low, close)
+}
+
+// CDLBELTHOLD 识别捉腰带线：长实体，阳线几乎没有下影线或阴线几乎没有上影线。
+func CDLBELTHOLD(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLBELTHOLD", open, high, low, close)
+}
+
+// CDLBREAKAWAY 识别脱离：长实体之后跳空并顺势推进三根，第五根反向收在跳空缺口内。
+func CDLBREAKAWAY(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLBREAKAWAY", open, high, low, close)
+}
+
+// CDLCLOSINGMARUBOZU 识别收盘缺影线：长实体，收盘价一侧几乎没有影线。
+func CDLCLOSINGMARUBOZU(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLCLOSINGMARUBOZU", open, high, low, close)
+}
+
+// CDLCONCEALBABYSWALL 识别藏婴吞没（看涨）：两根光头光脚阴线之后跳空低开、带上影线的阴线被第四根阴线完全包住。
+func CDLCONCEALBABYSWALL(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLCONCEALBABYSWALL", open, high, low, close)
+}
+
+// CDLCOUNTERATTACK 识别反击线：两根颜色相反的长实体，收盘价相同。
+func CDLCOUNTERATTACK(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLCOUNTERATTACK", open, high, low, close)
+}
+
+// CDLDARKCLOUDCOVER 识别乌云压顶（看跌）：长阳线之后高于其最高价开盘的阴线，收盘深入前一根的实体。
+// penetration 为第三根深入第一根实体的比例，默认 0.5。
+func CDLDARKCLOUDCOVER(open, high, low, close []float64, penetration float64) ([]int, error) {
+	return cdl("CDLDARKCLOUDCOVER", open, high, low, close, penetration)
+}
+
+// CDLDOJI 识别十字星：开盘价与收盘价几乎相同。
+func CDLDOJI(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLDOJI", open, high, low, close)
+}
+
+// CDLDOJISTAR 识别十字星（跳空）：长实体之后顺势跳空的十字星，方向与长实体相反。
+func CDLDOJISTAR(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLDOJISTAR", open, high, low, close)
+}
+
+// CDLDRAGONFLYDOJI 识别蜻蜓十字：几乎没有上影线、下影线较长的十字星。
+func CDLDRAGONFLYDOJI(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLDRAGONFLYDOJI", open, high, low, close)
+}
+
+// CDLENGULFING 识别吞没形态：实体与前一根颜色相反并将其包住，只有一端与前一根的实体端点相同时为 ±80。
+func CDLENGULFING(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLENGULFING", open, high, low, close)
+}
+
+// CDLEVENINGDOJISTAR 识别十字暮星（看跌）：长阳线之后向上跳空的十字星，第三根阴线深入第一根的实体。
+// penetration 为第三根深入第一根实体的比例，默认 0.3。
+func CDLEVENINGDOJISTAR(open, high, low, close []float64, penetration float64) ([]int, error) {
+	return cdl("CDLEVENINGDOJISTAR", open, high, low, close, penetration)
+}
+
+// CDLEVENINGSTAR 识别暮星（看跌）：长阳线之后向上跳空的小实体，第三根阴线深入第一根的实体。
+// penetration 为第三根深入第一根实体的比例，默认 0.3。
+func CDLEVENINGSTAR(open, high, low, close []float64, penetration float64) ([]int, error) {
+	return cdl("CDLEVENINGSTAR", open, high, low, close, penetration)
+}
+
+// CDLGAPSIDESIDEWHITE 识别向上/向下跳空并列阳线：跳空之后两根开盘价相同、实体相近的阳线，方向取跳空的方向。
+func CDLGAPSIDESIDEWHITE(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLGAPSIDESIDEWHITE", open, high, low, close)
+}
+
+// CDLGRAVESTONEDOJI 识别墓碑十字：几乎没有下影线、上影线较长的十字星。
+func CDLGRAVESTONEDOJI(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLGRAVESTONEDOJI", open, high, low, close)
+}
+
+// CDLHAMMER 识别锤子线（看涨）：小实体、长下影线、几乎没有上影线，实体位于前一根的低点附近。
+func CDLHAMMER(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLHAMMER", open, high, low, close)
+}
+
+// CDLHANGINGMAN 识别上吊线（看跌）：形状与锤子线相同，实体位于前一根的高点附近。
+func CDLHANGINGMAN(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLHANGINGMAN", open, high, low, close)
+}
+
+// CDLHARAMI 识别孕线：长实体之后是实体被其包含的短实体，方向与长实体相反，实体端点相同时为 ±80。
+func CDLHARAMI(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLHARAMI", open, high, low, close)
+}
+
+// CDLHARAMICROSS 识别十字孕线：长实体之后是实体被其包含的十字星，方向与长实体相反，实体端点相同时为 ±80。
+func CDLHARAMICROSS(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLHARAMICROSS", open, high, low, close)
+}
+
+// CDLHIGHWAVE 识别风高浪大线：小实体，上下影线都很长。
+func CDLHIGHWAVE(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLHIGHWAVE", open, high, low, close)
+}
+
+// CDLHIKKAKE 识别陷阱：内包线之后假突破，形态出现时为 ±100，之后3根内收盘突破内包线的高点或低点时为 ±200。
+func CDLHIKKAKE(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLHIKKAKE", open, high, low, close)
+}
+
+// CDLHIKKAKEMOD 识别修正陷阱：在陷阱形态之前多一根内包线，且第二根收于低点（看涨）或高点（看跌）附近，确认时为 ±200。
+func CDLHIKKAKEMOD(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLHIKKAKEMOD", open, high, low, close)
+}
+
+// CDLHOMINGPIGEON 识别家鸽（看涨）：长阴线之后是实体被其包含的短阴线。
+func CDLHOMINGPIGEON(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLHOMINGPIGEON", open, high, low, close)
+}
+
+// CDLIDENTICAL3CROWS 识别三胞胎乌鸦（看跌）：三根收盘价依次降低的阴线，每根都在前一根的收盘价开盘。
+func CDLIDENTICAL3CROWS(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLIDENTICAL3CROWS", open, high, low, close)
+}
+
+// CDLINNECK 识别颈内线（看跌）：长阴线之后低开的阳线收于前一根收盘价处或略高。
+func CDLINNECK(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLINNECK", open, high, low, close)
+}
+
+// CDLINVERTEDHAMMER 识别倒锤子线（看涨）：小实体、长上影线、几乎没有下影线，实体向下跳空。
+func CDLINVERTEDHAMMER(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLINVERTEDHAMMER", open, high, low, close)
+}
+
+// CDLKICKING 识别反冲形态：两根颜色相反、之间有跳空的光头光脚长实体，方向取第二根。
+func CDLKICKING(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLKICKING", open, high, low, close)
+}
+
+// CDLKICKINGBYLENGTH 识别反冲形态（由较长的光头光脚线定方向）：与 CDLKICKING 相同，方向取实体较长的一根。
+func CDLKICKINGBYLENGTH(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLKICKINGBYLENGTH", open, high, low, close)
+}
+
+// CDLLADDERBOTTOM 识别梯底（看涨）：三根逐根走低的阴线与一根带上影线的阴线之后，阳线高开并收于其最高价之上。
+func CDLLADDERBOTTOM(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLLADDERBOTTOM", open, high, low, close)
+}
+
+// CDLLONGLEGGEDDOJI 识别长腿十字：上影线或下影线较长的十字星。
+func CDLLONGLEGGEDDOJI(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLLONGLEGGEDDOJI", open, high, low, close)
+}
+
+// CDLLONGLINE 识别长蜡烛：长实体，上下影线都短。
+func CDLLONGLINE(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLLONGLINE", open, high, low, close)
+}
+
+// CDLMARUBOZU 识别光头光脚：长实体，几乎没有上下影线。
+func CDLMARUBOZU(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLMARUBOZU", open, high, low, close)
+}
+
+// CDLMATCHINGLOW 识别相同低价（看涨）：两根收盘价相同的阴线。
+func CDLMATCHINGLOW(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLMATCHINGLOW", open, high, low, close)
+}
+
+// CDLMATHOLD 识别铺垫（看涨）：长阳线之后三根小实体回调不超过 penetration，第五根阳线收于回调期间的最高价之上。
+// penetration 为第三根深入第一根实体的比例，默认 0.5。
+func CDLMATHOLD(open, high, low, close []float64, penetration float64) ([]int, error) {
+	return cdl("CDLMATHOLD", open, high, low, close, penetration)
+}
+
+// CDLMORNINGDOJISTAR 识别十字晨星（看涨）：长阴线之后向下跳空的十字星，第三根阳线深入第一根的实体。
+// penetration 为第三根深入第一根实体的比例，默认 0.3。
+func CDLMORNINGDOJISTAR(open, high, low, close []float64, penetration float64) ([]int, error) {
+	return cdl("CDLMORNINGDOJISTAR", open, high, low, close, penetration)
+}
+
+// CDLMORNINGSTAR 识别晨星（看涨）：长阴线之后向下跳空的小实体，第三根阳线深入第一根的实体。
+// penetration 为第三根深入第一根实体的比例，默认 0.3。
+func CDLMORNINGSTAR(open, high, low, close []float64, penetration float64) ([]int, error) {
+	return cdl("CDLMORNINGSTAR", open, high, low, close, penetration)
+}
+
+// CDLONNECK 识别颈上线（看跌）：长阴线之后低开的阳线收于前一根最低价处。
+func CDLONNECK(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLONNECK", open, high, low, close)
+}
+
+// CDLPIERCING 识别刺透形态（看涨）：长阴线之后低开的长阳线收于前一根实体的中点之上。
+func CDLPIERCING(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLPIERCING", open, high, low, close)
+}
+
+// CDLRICKSHAWMAN 识别黄包车夫：上下影线都长、实体位于中点附近的十字星。
+func CDLRICKSHAWMAN(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLRICKSHAWMAN", open, high, low, close)
+}
+
+// CDLRISEFALL3METHODS 识别上升/下降三法：长实体之后三根逆势的小实体，第五根同向长实体收于第一根收盘价之外。
+func CDLRISEFALL3METHODS(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLRISEFALL3METHODS", open, high, low, close)
+}
+
+// CDLSEPARATINGLINES 识别分离线：与前一根颜色相反、开盘价相同的长实体，开盘一侧几乎没有影线。
+func CDLSEPARATINGLINES(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLSEPARATINGLINES", open, high, low, close)
+}
+
+// CDLSHOOTINGSTAR 识别射击之星（看跌）：小实体、长上影线、几乎没有下影线，实体向上跳空。
+func CDLSHOOTINGSTAR(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLSHOOTINGSTAR", open, high, low, close)
+}
+
+// CDLSHORTLINE 识别短蜡烛：短实体，上下影线都短。
+func CDLSHORTLINE(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLSHORTLINE", open, high, low, close)
+}
+
+// CDLSPINNINGTOP 识别纺锤线：小实体，上下影线都比实体长。
+func CDLSPINNINGTOP(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLSPINNINGTOP", open, high, low, close)
+}
+
+// CDLSTALLEDPATTERN 识别停顿形态（看跌）：两根长阳线之后是开盘于前一根收盘价附近的小阳线。
+func CDLSTALLEDPATTERN(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLSTALLEDPATTERN", open, high, low, close)
+}
+
+// CDLSTICKSANDWICH 识别条形三明治（看涨）：阴、阳、阴三根，第三根与第一根收盘价相同。
+func CDLSTICKSANDWICH(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLSTICKSANDWICH", open, high, low, close)
+}
+
+// CDLTAKURI 识别探水竿：几乎没有上影线、下影线极长的十字星。
+func CDLTAKURI(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLTAKURI", open, high, low, close)
+}
+
+// CDLTASUKIGAP 识别跳空并列阴阳线：跳空之后两根颜色相反、实体相近，第二根收在缺口之内，方向取跳空的方向。
+func CDLTASUKIGAP(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLTASUKIGAP", open, high, low, close)
+}
+
+// CDLTHRUSTING 识别插入形态（看跌）：长阴线之后低开的阳线收于前一根收盘价之上、实体中点之下。
+func CDLTHRUSTING(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLTHRUSTING", open, high, low, close)
+}
+
+// CDLTRISTAR 识别三星：三根十字星，中间一根跳空。
+func CDLTRISTAR(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLTRISTAR", open, high, low, close)
+}
+
+// CDLUNIQUE3RIVER 识别奇特三河床（看涨）：长阴线之后是创出新低的阴线，第三根是高于其最低价开盘的小阳线。
+func CDLUNIQUE3RIVER(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLUNIQUE3RIVER", open, high, low, close)
+}
+
+// CDLUPSIDEGAP2CROWS 识别向上跳空的两只乌鸦（看跌）：长阳线之后向上跳空的小阴线被第三根阴线包住。
+func CDLUPSIDEGAP2CROWS(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLUPSIDEGAP2CROWS", open, high, low, close)
+}
+
+// CDLXSIDEGAP3METHODS 识别上升/下降跳空三法：两根同色且顺势跳空，第三根反色并回补缺口。
+func CDLXSIDEGAP3METHODS(open, high, low, close []float64) ([]int, error) {
+	return cdl("CDLXSIDEGAP3METHODS", open, high, low, close)
+}
@@ -0,0 +1,155 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+
+// 各 TA_CDL* 函数的参数相同，只有部分形态多一个 optInPenetration，按函数指针统一调用。
+typedef TA_RetCode (*cdlFunc)(int, int, const double *, const double *, const double *, const double *, int *, int *, int *);
+typedef TA_RetCode (*cdlPenFunc)(int, int, const double *, const double *, const double *, const double *, double, int *, int *, int *);
+typedef int (*cdlLookbackFunc)(void);
+typedef int (*cdlPenLookbackFunc)(double);
+
+static TA_RetCode callCDL(cdlFunc fn, cdlPenFunc penFn, int endIdx,
+	const double *open, const double *high, const double *low, const double *close, double penetration,
+	int *outBegIdx, int *outNBElement, int *output) {
+	if (penFn != NULL) {
+		return penFn(0, endIdx, open, high, low, close, penetration, outBegIdx, outNBElement, output);
+	}
+	return fn(0, endIdx, open, high, low, close, outBegIdx, outNBElement, output);
+}
+
+static int callCDLLookback(cdlLookbackFunc fn, cdlPenLookbackFunc penFn, double penetration) {
+	if (penFn != NULL) {
+		return penFn(penetration);
+	}
+	return fn();
+}
+*/
+import "C"
+import "unsafe"
+
+// cdlCFunc 是一个 TA_CDL* 函数及其回看期函数，带 penetration 参数的形态使用 pen 开头的字段。
+type cdlCFunc struct {
+	fn          C.cdlFunc
+	lookback    C.cdlLookbackFunc
+	penFn       C.cdlPenFunc
+	penLookback C.cdlPenLookbackFunc
+}
+
+// cdlCFuncs 以 TA-Lib 函数名为键。
+var cdlCFuncs = map[string]cdlCFunc{
+	"CDL2CROWS":           {fn: C.cdlFunc(C.TA_CDL2CROWS), lookback: C.cdlLookbackFunc(C.TA_CDL2CROWS_Lookback)},
+	"CDL3BLACKCROWS":      {fn: C.cdlFunc(C.TA_CDL3BLACKCROWS), lookback: C.cdlLookbackFunc(C.TA_CDL3BLACKCROWS_Lookback)},
+	"CDL3INSIDE":          {fn: C.cdlFunc(C.TA_CDL3INSIDE), lookback: C.cdlLookbackFunc(C.TA_CDL3INSIDE_Lookback)},
+	"CDL3LINESTRIKE":      {fn: C.cdlFunc(C.TA_CDL3LINESTRIKE), lookback: C.cdlLookbackFunc(C.TA_CDL3LINESTRIKE_Lookback)},
+	"CDL3OUTSIDE":         {fn: C.cdlFunc(C.TA_CDL3OUTSIDE), lookback: C.cdlLookbackFunc(C.TA_CDL3OUTSIDE_Lookback)},
+	"CDL3STARSINSOUTH":    {fn: C.cdlFunc(C.TA_CDL3STARSINSOUTH), lookback: C.cdlLookbackFunc(C.TA_CDL3STARSINSOUTH_Lookback)},
+	"CDL3WHITESOLDIERS":   {fn: C.cdlFunc(C.TA_CDL3WHITESOLDIERS), lookback: C.cdlLookbackFunc(C.TA_CDL3WHITESOLDIERS_Lookback)},
+	"CDLABANDONEDBABY":    {penFn: C.cdlPenFunc(C.TA_CDLABANDONEDBABY), penLookback: C.cdlPenLookbackFunc(C.TA_CDLABANDONEDBABY_Lookback)},
+	"CDLADVANCEBLOCK":     {fn: C.cdlFunc(C.TA_CDLADVANCEBLOCK), lookback: C.cdlLookbackFunc(C.TA_CDLADVANCEBLOCK_Lookback)},
+	"CDLBELTHOLD":         {fn: C.cdlFunc(C.TA_CDLBELTHOLD), lookback: C.cdlLookbackFunc(C.TA_CDLBELTHOLD_Lookback)},
+	"CDLBREAKAWAY":        {fn: C.cdlFunc(C.TA_CDLBREAKAWAY), lookback: C.cdlLookbackFunc(C.TA_CDLBREAKAWAY_Lookback)},
+	"CDLCLOSINGMARUBOZU":  {fn: C.cdlFunc(C.TA_CDLCLOSINGMARUBOZU), lookback: C.cdlLookbackFunc(C.TA_CDLCLOSINGMARUBOZU_Lookback)},
+	"CDLCONCEALBABYSWALL": {fn: C.cdlFunc(C.TA_CDLCONCEALBABYSWALL), lookback: C.cdlLookbackFunc(C.TA_CDLCONCEALBABYSWALL_Lookback)},
+	"CDLCOUNTERATTACK":    {fn: C.cdlFunc(C.TA_CDLCOUNTERATTACK), lookback: C.cdlLookbackFunc(C.TA_CDLCOUNTERATTACK_Lookback)},
+	"CDLDARKCLOUDCOVER":   {penFn: C.cdlPenFunc(C.TA_CDLDARKCLOUDCOVER), penLookback: C.cdlPenLookbackFunc(C.TA_CDLDARKCLOUDCOVER_Lookback)},
+	"CDLDOJI":             {fn: C.cdlFunc(C.TA_CDLDOJI), lookback: C.cdlLookbackFunc(C.TA_CDLDOJI_Lookback)},
+	"CDLDOJISTAR":         {fn: C.cdlFunc(C.TA_CDLDOJISTAR), lookback: C.cdlLookbackFunc(C.TA_CDLDOJISTAR_Lookback)},
+	"CDLDRAGONFLYDOJI":    {fn: C.cdlFunc(C.TA_CDLDRAGONFLYDOJI), lookback: C.cdlLookbackFunc(C.TA_CDLDRAGONFLYDOJI_Lookback)},
+	"CDLENGULFING":        {fn: C.cdlFunc(C.TA_CDLENGULFING), lookback: C.cdlLookbackFunc(C.TA_CDLENGULFING_Lookback)},
+	"CDLEVENINGDOJISTAR":  {penFn: C.cdlPenFunc(C.TA_CDLEVENINGDOJISTAR), penLookback: C.cdlPenLookbackFunc(C.TA_CDLEVENINGDOJISTAR_Lookback)},
+	"CDLEVENINGSTAR":      {penFn: C.cdlPenFunc(C.TA_CDLEVENINGSTAR), penLookback: C.cdlPenLookbackFunc(C.TA_CDLEVENINGSTAR_Lookback)},
+	"CDLGAPSIDESIDEWHITE": {fn: C.cdlFunc(C.TA_CDLGAPSIDESIDEWHITE), lookback: C.cdlLookbackFunc(C.TA_CDLGAPSIDESIDEWHITE_Lookback)},
+	"CDLGRAVESTONEDOJI":   {fn: C.cdlFunc(C.TA_CDLGRAVESTONEDOJI), lookback: C.cdlLookbackFunc(C.TA_CDLGRAVESTONEDOJI_Lookback)},
+	"CDLHAMMER":           {fn: C.cdlFunc(C.TA_CDLHAMMER), lookback: C.cdlLookbackFunc(C.TA_CDLHAMMER_Lookback)},
+	"CDLHANGINGMAN":       {fn: C.cdlFunc(C.TA_CDLHANGINGMAN), lookback: C.cdlLookbackFunc(C.TA_CDLHANGINGMAN_Lookback)},
+	"CDLHARAMI":           {fn: C.cdlFunc(C.TA_CDLHARAMI), lookback: C.cdlLookbackFunc(C.TA_CDLHARAMI_Lookback)},
+	"CDLHARAMICROSS":      {fn: C.cdlFunc(C.TA_CDLHARAMICROSS), lookback: C.cdlLookbackFunc(C.TA_CDLHARAMICROSS_Lookback)},
+	"CDLHIGHWAVE":         {fn: C.cdlFunc(C.TA_CDLHIGHWAVE), lookback: C.cdlLookbackFunc(C.TA_CDLHIGHWAVE_Lookback)},
+	"CDLHIKKAKE":          {fn: C.cdlFunc(C.TA_CDLHIKKAKE), lookback: C.cdlLookbackFunc(C.TA_CDLHIKKAKE_Lookback)},
+	"CDLHIKKAKEMOD":       {fn: C.cdlFunc(C.TA_CDLHIKKAKEMOD), lookback: C.cdlLookbackFunc(C.TA_CDLHIKKAKEMOD_Lookback)},
+	"CDLHOMINGPIGEON":     {fn: C.cdlFunc(C.TA_CDLHOMINGPIGEON), lookback: C.cdlLookbackFunc(C.TA_CDLHOMINGPIGEON_Lookback)},
+	"CDLIDENTICAL3CROWS":  {fn: C.cdlFunc(C.TA_CDLIDENTICAL3CROWS), lookback: C.cdlLookbackFunc(C.TA_CDLIDENTICAL3CROWS_Lookback)},
+	"CDLINNECK":           {fn: C.cdlFunc(C.TA_CDLINNECK), lookback: C.cdlLookbackFunc(C.TA_CDLINNECK_Lookback)},
+	"CDLINVERTEDHAMMER":   {fn: C.cdlFunc(C.TA_CDLINVERTEDHAMMER), lookback: C.cdlLookbackFunc(C.TA_CDLINVERTEDHAMMER_Lookback)},
+	"CDLKICKING":          {fn: C.cdlFunc(C.TA_CDLKICKING), lookback: C.cdlLookbackFunc(C.TA_CDLKICKING_Lookback)},
+	"CDLKICKINGBYLENGTH":  {fn: C.cdlFunc(C.TA_CDLKICKINGBYLENGTH), lookback: C.cdlLookbackFunc(C.TA_CDLKICKINGBYLENGTH_Lookback)},
+	"CDLLADDERBOTTOM":     {fn: C.cdlFunc(C.TA_CDLLADDERBOTTOM), lookback: C.cdlLookbackFunc(C.TA_CDLLADDERBOTTOM_Lookback)},
+	"CDLLONGLEGGEDDOJI":   {fn: C.cdlFunc(C.TA_CDLLONGLEGGEDDOJI), lookback: C.cdlLookbackFunc(C.TA_CDLLONGLEGGEDDOJI_Lookback)},
+	"CDLLONGLINE":         {fn: C.cdlFunc(C.TA_CDLLONGLINE), lookback: C.cdlLookbackFunc(C.TA_CDLLONGLINE_Lookback)},
+	"CDLMARUBOZU":         {fn: C.cdlFunc(C.TA_CDLMARUBOZU), lookback: C.cdlLookbackFunc(C.TA_CDLMARUBOZU_Lookback)},
+	"CDLMATCHINGLOW":      {fn: C.cdlFunc(C.TA_CDLMATCHINGLOW), lookback: C.cdlLookbackFunc(C.TA_CDLMATCHINGLOW_Lookback)},
+	"CDLMATHOLD":          {penFn: C.cdlPenFunc(C.TA_CDLMATHOLD), penLookback: C.cdlPenLookbackFunc(C.TA_CDLMATHOLD_Lookback)},
+	"CDLMORNINGDOJISTAR":  {penFn: C.cdlPenFunc(C.TA_CDLMORNINGDOJISTAR), penLookback: C.cdlPenLookbackFunc(C.TA_CDLMORNINGDOJISTAR_Lookback)},
+	"CDLMORNINGSTAR":      {penFn: C.cdlPenFunc(C.TA_CDLMORNINGSTAR), penLookback: C.cdlPenLookbackFunc(C.TA_CDLMORNINGSTAR_Lookback)},
+	"CDLONNECK":           {fn: C.cdlFunc(C.TA_CDLONNECK), lookback: C.cdlLookbackFunc(C.TA_CDLONNECK_Lookback)},
+	"CDLPIERCING":         {fn: C.cdlFunc(C.TA_CDLPIERCING), lookback: C.cdlLookbackFunc(C.TA_CDLPIERCING_Lookback)},
+	"CDLRICKSHAWMAN":      {fn: C.cdlFunc(C.TA_CDLRICKSHAWMAN), lookback: C.cdlLookbackFunc(C.TA_CDLRICKSHAWMAN_Lookback)},
+	"CDLRISEFALL3METHODS": {fn: C.cdlFunc(C.TA_CDLRISEFALL3METHODS), lookback: C.cdlLookbackFunc(C.TA_CDLRISEFALL3METHODS_Lookback)},
+	"CDLSEPARATINGLINES":  {fn: C.cdlFunc(C.TA_CDLSEPARATINGLINES), lookback: C.cdlLookbackFunc(C.TA_CDLSEPARATINGLINES_Lookback)},
+	"CDLSHOOTINGSTAR":     {fn: C.cdlFunc(C.TA_CDLSHOOTINGSTAR), lookback: C.cdlLookbackFunc(C.TA_CDLSHOOTINGSTAR_Lookback)},
+	"CDLSHORTLINE":        {fn: C.cdlFunc(C.TA_CDLSHORTLINE), lookback: C.cdlLookbackFunc(C.TA_CDLSHORTLINE_Lookback)},
+	"CDLSPINNINGTOP":      {fn: C.cdlFunc(C.TA_CDLSPINNINGTOP), lookback: C.cdlLookbackFunc(C.TA_CDLSPINNINGTOP_Lookback)},
+	"CDLSTALLEDPATTERN":   {fn: C.cdlFunc(C.TA_CDLSTALLEDPATTERN), lookback: C.cdlLookbackFunc(C.TA_CDLSTALLEDPATTERN_Lookback)},
+	"CDLSTICKSANDWICH":    {fn: C.cdlFunc(C.TA_CDLSTICKSANDWICH), lookback: C.cdlLookbackFunc(C.TA_CDLSTICKSANDWICH_Lookback)},
+	"CDLTAKURI":           {fn: C.cdlFunc(C.TA_CDLTAKURI), lookback: C.cdlLookbackFunc(C.TA_CDLTAKURI_Lookback)},
+	"CDLTASUKIGAP":        {fn: C.cdlFunc(C.TA_CDLTASUKIGAP), lookback: C.cdlLookbackFunc(C.TA_CDLTASUKIGAP_Lookback)},
+	"CDLTHRUSTING":        {fn: C.cdlFunc(C.TA_CDLTHRUSTING), lookback: C.cdlLookbackFunc(C.TA_CDLTHRUSTING_Lookback)},
+	"CDLTRISTAR":          {fn: C.cdlFunc(C.TA_CDLTRISTAR), lookback: C.cdlLookbackFunc(C.TA_CDLTRISTAR_Lookback)},
+	"CDLUNIQUE3RIVER":     {fn: C.cdlFunc(C.TA_CDLUNIQUE3RIVER), lookback: C.cdlLookbackFunc(C.TA_CDLUNIQUE3RIVER_Lookback)},
+	"CDLUPSIDEGAP2CROWS":  {fn: C.cdlFunc(C.TA_CDLUPSIDEGAP2CROWS), lookback: C.cdlLookbackFunc(C.TA_CDLUPSIDEGAP2CROWS_Lookback)},
+	"CDLXSIDEGAP3METHODS": {fn: C.cdlFunc(C.TA_CDLXSIDEGAP3METHODS), lookback: C.cdlLookbackFunc(C.TA_CDLXSIDEGAP3METHODS_Lookback)},
+}
+
+// taCDL 调用名为 name 的 TA_CDL* 函数。K线形态设置须在整个计算期间保持不变，
+// 调用方通过 withCandleSettings 持有锁。
+func taCDL(name string, open, high, low, close []float64, penetration float64) (int, []int, error) {
+	f, ok := cdlCFuncs[name]
+	if !ok {
+		return 0, nil, &FuncError{Func: name, Code: RetFuncNotFound}
+	}
+	cOpen := (*C.double)(unsafe.Pointer(&open[0]))
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.int, len(open))
+	cOutput := (*C.int)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.callCDL(
+		f.fn,
+		f.penFn,
+		C.int(len(open)-1),
+		cOpen,
+		cHigh,
+		cLow,
+		cClose,
+		C.double(penetration),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, paramErr := cdlParams(name, penetration)
+		return 0, nil, taErr("TA_"+name, RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromCInt(output, outNBElement), nil
+}
+
+// taCDLLookback 调用名为 name 的 TA_CDL*_Lookback，参数无效时返回 -1，调用方须持有锁。
+func taCDLLookback(name string, penetration float64) int {
+	f, ok := cdlCFuncs[name]
+	if !ok {
+		return -1
+	}
+	return int(C.callCDLLookback(f.lookback, f.penLookback, C.double(penetration)))
+}
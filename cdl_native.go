@@ -0,0 +1,1138 @@
+package go4ta
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// candles 是K线形态识别的输入与设置，各方法对应 TA-Lib 中 TA_REALBODY、TA_CANDLEGAPUP 等宏。
+type candles struct {
+	open, high, low, close []float64
+	settings               CandleSettings
+}
+
+// color 返回第 i 根的颜色：收盘价不低于开盘价为1（阳线），否则为-1（阴线）。
+func (c *candles) color(i int) int {
+	if c.close[i] >= c.open[i] {
+		return 1
+	}
+	return -1
+}
+
+func (c *candles) realBody(i int) float64 {
+	return math.Abs(c.close[i] - c.open[i])
+}
+
+func (c *candles) upperShadow(i int) float64 {
+	return c.high[i] - math.Max(c.close[i], c.open[i])
+}
+
+func (c *candles) lowerShadow(i int) float64 {
+	return math.Min(c.close[i], c.open[i]) - c.low[i]
+}
+
+func (c *candles) highLowRange(i int) float64 {
+	return c.high[i] - c.low[i]
+}
+
+// bodyTop 与 bodyBottom 返回实体的上沿与下沿。
+func (c *candles) bodyTop(i int) float64 {
+	return math.Max(c.open[i], c.close[i])
+}
+
+func (c *candles) bodyBottom(i int) float64 {
+	return math.Min(c.open[i], c.close[i])
+}
+
+// gapUp 表示第 i2 根与前面的第 i1 根之间有向上跳空（含影线）。
+func (c *candles) gapUp(i2, i1 int) bool {
+	return c.low[i2] > c.high[i1]
+}
+
+func (c *candles) gapDown(i2, i1 int) bool {
+	return c.high[i2] < c.low[i1]
+}
+
+// realBodyGapUp 表示第 i2 根的实体整体高于第 i1 根的实体。
+func (c *candles) realBodyGapUp(i2, i1 int) bool {
+	return c.bodyBottom(i2) > c.bodyTop(i1)
+}
+
+func (c *candles) realBodyGapDown(i2, i1 int) bool {
+	return c.bodyTop(i2) < c.bodyBottom(i1)
+}
+
+// rangeOf 对应 TA_CANDLERANGE，返回第 i 根在设置 t 下参与平均的量。
+func (c *candles) rangeOf(t CandleSettingType, i int) float64 {
+	switch c.settings[t].RangeType {
+	case CandleRangeRealBody:
+		return c.realBody(i)
+	case CandleRangeHighLow:
+		return c.highLowRange(i)
+	case CandleRangeShadows:
+		return c.upperShadow(i) + c.lowerShadow(i)
+	}
+	return 0
+}
+
+// average 对应 TA_CANDLEAVERAGE，total 是第 i 根之前 avgPeriod 根的 rangeOf 之和。
+func (c *candles) average(t CandleSettingType, total float64, i int) float64 {
+	s := c.settings[t]
+	v := c.rangeOf(t, i)
+	if s.AvgPeriod != 0 {
+		v = total / float64(s.AvgPeriod)
+	}
+	div := 1.0
+	if s.RangeType == CandleRangeShadows {
+		div = 2.0
+	}
+	return s.Factor * v / div
+}
+
+// cdlAverage 表示形态中某一根所用的一项平均：shift 为这一根在当前根之前的根数。
+type cdlAverage struct {
+	setting CandleSettingType
+	shift   int
+}
+
+// cdlLookback 描述形态的回看期：所用各项设置中最大的平均周期（不小于 minPeriod）加上 extra。
+type cdlLookback struct {
+	settings  []CandleSettingType
+	extra     int
+	minPeriod int
+}
+
+func (l cdlLookback) value(s *CandleSettings) int {
+	period := l.minPeriod
+	for _, t := range l.settings {
+		period = max(period, s[t].AvgPeriod)
+	}
+	return period + l.extra
+}
+
+// String 返回注册表中回看期的写法，C(X) 表示设置 X 的平均周期，如 "max(C(BodyLong), C(BodyShort))+2"。
+func (l cdlLookback) String() string {
+	terms := make([]string, 0, len(l.settings)+1)
+	if l.minPeriod > 0 {
+		terms = append(terms, fmt.Sprint(l.minPeriod))
+	}
+	for _, t := range l.settings {
+		terms = append(terms, "C("+t.String()+")")
+	}
+	var s string
+	switch len(terms) {
+	case 0:
+		return fmt.Sprint(l.extra)
+	case 1:
+		s = terms[0]
+	default:
+		s = "max(" + strings.Join(terms, ", ") + ")"
+	}
+	if l.extra > 0 {
+		s += fmt.Sprint("+", l.extra)
+	}
+	return s
+}
+
+// cdlRecognizer 是一个K线形态的原生实现。多数形态逐根独立判断：eval 收到第 i 根以及按 averages 顺序给出的各项平均值，
+// 返回 -100/0/100 等结果；需要在各根之间保存状态的形态（Hikkake）由 run 完成整个计算。
+type cdlRecognizer struct {
+	lookback cdlLookback
+	averages []cdlAverage
+	eval     func(c *candles, i int, avg []float64, penetration float64) int
+	run      func(c *candles, startIdx int) []int
+}
+
+// nativeCDL 是 TA_CDL* 的原生实现，name 为 TA-Lib 函数名，penetration 只用于带该参数的形态。
+func nativeCDL(name string, open, high, low, close []float64, penetration float64) (int, []int, error) {
+	p, penetration, err := cdlParams(name, penetration)
+	if err != nil {
+		return 0, nil, err
+	}
+	c := &candles{open: open, high: high, low: low, close: close, settings: candleSettings()}
+	r := cdlRecognizers[p.name]
+	startIdx := r.lookback.value(&c.settings)
+	if startIdx > len(close)-1 {
+		return 0, nil, nil
+	}
+	if r.run != nil {
+		return startIdx, r.run(c, startIdx), nil
+	}
+
+	// 与 TA-Lib 相同，各项平均由滑动的合计维护：第 i-shift 根之前 avgPeriod 根的范围之和
+	totals := make([]float64, len(r.averages))
+	for k, a := range r.averages {
+		at := startIdx - a.shift
+		for j := at - c.settings[a.setting].AvgPeriod; j < at; j++ {
+			totals[k] += c.rangeOf(a.setting, j)
+		}
+	}
+	avg := make([]float64, len(r.averages))
+	output := make([]int, 0, len(close)-startIdx)
+	for i := startIdx; i < len(close); i++ {
+		for k, a := range r.averages {
+			avg[k] = c.average(a.setting, totals[k], i-a.shift)
+		}
+		output = append(output, r.eval(c, i, avg, penetration))
+		for k, a := range r.averages {
+			at := i - a.shift
+			totals[k] += c.rangeOf(a.setting, at) - c.rangeOf(a.setting, at-c.settings[a.setting].AvgPeriod)
+		}
+	}
+	return startIdx, output, nil
+}
+
+// nativeCDLLookback 对应 TA_CDL*_Lookback，参数无效时返回 -1。
+func nativeCDLLookback(name string, penetration float64) int {
+	p, _, err := cdlParams(name, penetration)
+	if err != nil {
+		return -1
+	}
+	s := candleSettings()
+	return cdlRecognizers[p.name].lookback.value(&s)
+}
+
+// cdlParams 查找形态并按 TA-Lib 的规则处理 penetration 参数，不带该参数的形态忽略 penetration。
+func cdlParams(name string, penetration float64) (*cdlPattern, float64, error) {
+	p, ok := cdlPatternByName[name]
+	if !ok {
+		return nil, 0, &FuncError{Func: name, Code: RetFuncNotFound}
+	}
+	if !p.hasPenetration() {
+		return p, 0, nil
+	}
+	c := paramCheck{fn: "TA_" + p.name}
+	penetration = c.real("penetration", penetration, p.penetration, 0, taRealMax)
+	return p, penetration, c.err
+}
+
+// hikkake 对应 TA_CDLHIKKAKE 与 TA_CDLHIKKAKEMOD 的共同部分：inside 判断第 i 根是否完成形态，
+// 形态出现后3根之内收盘价突破第二根的高点（看涨）或低点（看跌）时输出加倍的确认信号。
+// 与 TA-Lib 相同，从 startIdx 之前3根开始查找形态，使开头几根也能输出确认。
+func hikkake(c *candles, startIdx int, inside func(i int) (int, bool), next func(i int)) []int {
+	patternIdx, patternResult := 0, 0
+	output := make([]int, 0, len(c.close)-startIdx)
+	for i := startIdx - 3; i < len(c.close); i++ {
+		out := 0
+		if dir, ok := inside(i); ok {
+			patternResult = 100 * dir
+			patternIdx = i
+			out = patternResult
+		} else if i <= patternIdx+3 &&
+			((patternResult > 0 && c.close[i] > c.high[patternIdx-1]) ||
+				(patternResult < 0 && c.close[i] < c.low[patternIdx-1])) {
+			out = patternResult + 100*sign(patternResult)
+			patternIdx = 0
+		}
+		if i >= startIdx {
+			output = append(output, out)
+		}
+		next(i)
+	}
+	return output
+}
+
+// sign 返回整数的符号。
+func sign(v int) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
+
+// cdlRecognizers 以 TA-Lib 函数名为键，各形态的判断条件与 TA-Lib 逐条对应。
+var cdlRecognizers = map[string]cdlRecognizer{
+	"CDL2CROWS": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyLong}, extra: 2},
+		averages: []cdlAverage{{CandleBodyLong, 2}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.color(i-2) == 1 && c.realBody(i-2) > avg[0] && // 第一根：长阳线
+				c.color(i-1) == -1 && c.realBodyGapUp(i-1, i-2) && // 第二根：向上跳空的阴线
+				c.color(i) == -1 && // 第三根：阴线，在第二根的实体内开盘，在第一根的实体内收盘
+				c.open[i] < c.open[i-1] && c.open[i] > c.close[i-1] &&
+				c.close[i] > c.open[i-2] && c.close[i] < c.close[i-2] {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDL3BLACKCROWS": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleShadowVeryShort}, extra: 3},
+		averages: []cdlAverage{{CandleShadowVeryShort, 2}, {CandleShadowVeryShort, 1}, {CandleShadowVeryShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.color(i-3) == 1 &&
+				// 三根下影线极短的阴线
+				c.color(i-2) == -1 && c.lowerShadow(i-2) < avg[0] &&
+				c.color(i-1) == -1 && c.lowerShadow(i-1) < avg[1] &&
+				c.color(i) == -1 && c.lowerShadow(i) < avg[2] &&
+				// 后两根都在前一根的实体内开盘
+				c.open[i-1] < c.open[i-2] && c.open[i-1] > c.close[i-2] &&
+				c.open[i] < c.open[i-1] && c.open[i] > c.close[i-1] &&
+				// 第一根阴线收于之前阳线的最高价之下，收盘价依次降低
+				c.high[i-3] > c.close[i-2] &&
+				c.close[i-2] > c.close[i-1] && c.close[i-1] > c.close[i] {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDL3INSIDE": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleBodyLong}, extra: 2},
+		averages: []cdlAverage{{CandleBodyLong, 2}, {CandleBodyShort, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i-2) > avg[0] && c.realBody(i-1) <= avg[1] &&
+				// 第二根的实体被第一根的实体包含
+				c.bodyTop(i-1) < c.bodyTop(i-2) && c.bodyBottom(i-1) > c.bodyBottom(i-2) &&
+				// 第三根与第一根颜色相反，且收于第一根的开盘价之外
+				((c.color(i-2) == 1 && c.color(i) == -1 && c.close[i] < c.open[i-2]) ||
+					(c.color(i-2) == -1 && c.color(i) == 1 && c.close[i] > c.open[i-2])) {
+				return -c.color(i-2) * 100
+			}
+			return 0
+		},
+	},
+	"CDL3LINESTRIKE": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleNear}, extra: 3},
+		averages: []cdlAverage{{CandleNear, 3}, {CandleNear, 2}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.color(i-3) == c.color(i-2) && c.color(i-2) == c.color(i-1) && c.color(i) == -c.color(i-1) &&
+				// 第二、三根在前一根的实体内或附近开盘
+				c.open[i-2] >= c.bodyBottom(i-3)-avg[0] && c.open[i-2] <= c.bodyTop(i-3)+avg[0] &&
+				c.open[i-1] >= c.bodyBottom(i-2)-avg[1] && c.open[i-1] <= c.bodyTop(i-2)+avg[1] &&
+				// 三根同向推进，第四根反向开盘并收于第一根的开盘价之外
+				((c.color(i-1) == 1 && c.close[i-1] > c.close[i-2] && c.close[i-2] > c.close[i-3] &&
+					c.open[i] > c.close[i-1] && c.close[i] < c.open[i-3]) ||
+					(c.color(i-1) == -1 && c.close[i-1] < c.close[i-2] && c.close[i-2] < c.close[i-3] &&
+						c.open[i] < c.close[i-1] && c.close[i] > c.open[i-3])) {
+				return c.color(i-1) * 100
+			}
+			return 0
+		},
+	},
+	"CDL3OUTSIDE": {
+		lookback: cdlLookback{extra: 3},
+		eval: func(c *candles, i int, _ []float64, _ float64) int {
+			// 前两根构成吞没形态，第三根继续朝吞没的方向收盘
+			if (c.color(i-1) == 1 && c.color(i-2) == -1 &&
+				c.close[i-1] > c.open[i-2] && c.open[i-1] < c.close[i-2] && c.close[i] > c.close[i-1]) ||
+				(c.color(i-1) == -1 && c.color(i-2) == 1 &&
+					c.open[i-1] > c.close[i-2] && c.close[i-1] < c.open[i-2] && c.close[i] < c.close[i-1]) {
+				return c.color(i-1) * 100
+			}
+			return 0
+		},
+	},
+	"CDL3STARSINSOUTH": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleShadowVeryShort, CandleShadowLong, CandleBodyLong, CandleBodyShort}, extra: 2},
+		averages: []cdlAverage{{CandleBodyLong, 2}, {CandleShadowLong, 2}, {CandleShadowVeryShort, 1}, {CandleShadowVeryShort, 0}, {CandleBodyShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.color(i-2) == -1 && c.color(i-1) == -1 && c.color(i) == -1 &&
+				// 第一根：长实体，长下影线
+				c.realBody(i-2) > avg[0] && c.lowerShadow(i-2) > avg[1] &&
+				// 第二根：实体更小，在第一根的范围内高开，低点低于第一根收盘价但不低于其最低价，有下影线
+				c.realBody(i-1) < c.realBody(i-2) &&
+				c.open[i-1] > c.close[i-2] && c.open[i-1] <= c.high[i-2] &&
+				c.low[i-1] < c.close[i-2] && c.low[i-1] >= c.low[i-2] &&
+				c.lowerShadow(i-1) > avg[2] &&
+				// 第三根：小实体的光头光脚线，在第二根的范围之内
+				c.realBody(i) < avg[4] && c.lowerShadow(i) < avg[3] && c.upperShadow(i) < avg[3] &&
+				c.low[i] > c.low[i-1] && c.high[i] < c.high[i-1] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDL3WHITESOLDIERS": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleShadowVeryShort, CandleBodyShort, CandleFar, CandleNear}, extra: 2},
+		averages: []cdlAverage{
+			{CandleShadowVeryShort, 2}, {CandleShadowVeryShort, 1}, {CandleShadowVeryShort, 0},
+			{CandleNear, 2}, {CandleNear, 1}, {CandleFar, 2}, {CandleFar, 1}, {CandleBodyShort, 0},
+		},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 三根上影线极短的阳线，收盘价依次升高
+			if c.color(i-2) == 1 && c.upperShadow(i-2) < avg[0] &&
+				c.color(i-1) == 1 && c.upperShadow(i-1) < avg[1] &&
+				c.color(i) == 1 && c.upperShadow(i) < avg[2] &&
+				c.close[i] > c.close[i-1] && c.close[i-1] > c.close[i-2] &&
+				// 后两根在前一根的实体内或附近开盘
+				c.open[i-1] > c.open[i-2] && c.open[i-1] <= c.close[i-2]+avg[3] &&
+				c.open[i] > c.open[i-1] && c.open[i] <= c.close[i-1]+avg[4] &&
+				// 实体没有明显缩短，第三根不是短实体
+				c.realBody(i-1) > c.realBody(i-2)-avg[5] &&
+				c.realBody(i) > c.realBody(i-1)-avg[6] &&
+				c.realBody(i) > avg[7] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLABANDONEDBABY": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji, CandleBodyLong, CandleBodyShort}, extra: 2},
+		averages: []cdlAverage{{CandleBodyLong, 2}, {CandleBodyDoji, 1}, {CandleBodyShort, 0}},
+		eval: func(c *candles, i int, avg []float64, penetration float64) int {
+			// 长实体、十字星、较长实体，十字星与前后两根之间都有跳空（含影线），第三根深入第一根的实体
+			if c.realBody(i-2) > avg[0] && c.realBody(i-1) <= avg[1] && c.realBody(i) > avg[2] &&
+				((c.color(i-2) == 1 && c.color(i) == -1 &&
+					c.close[i] < c.close[i-2]-c.realBody(i-2)*penetration &&
+					c.gapUp(i-1, i-2) && c.gapDown(i, i-1)) ||
+					(c.color(i-2) == -1 && c.color(i) == 1 &&
+						c.close[i] > c.close[i-2]+c.realBody(i-2)*penetration &&
+						c.gapDown(i-1, i-2) && c.gapUp(i, i-1))) {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLADVANCEBLOCK": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleShadowLong, CandleShadowShort, CandleFar, CandleNear, CandleBodyLong}, extra: 2},
+		averages: []cdlAverage{
+			{CandleShadowShort, 2}, {CandleShadowShort, 1}, {CandleShadowShort, 0}, {CandleShadowLong, 0},
+			{CandleNear, 2}, {CandleNear, 1}, {CandleFar, 2}, {CandleFar, 1}, {CandleBodyLong, 2},
+		},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			shadowShort2, shadowShort1, shadowShort0, shadowLong0 := avg[0], avg[1], avg[2], avg[3]
+			near2, near1, far2, far1, bodyLong2 := avg[4], avg[5], avg[6], avg[7], avg[8]
+			if c.color(i-2) == 1 && c.color(i-1) == 1 && c.color(i) == 1 &&
+				c.close[i] > c.close[i-1] && c.close[i-1] > c.close[i-2] &&
+				c.open[i-1] > c.open[i-2] && c.open[i-1] <= c.close[i-2]+near2 &&
+				c.open[i] > c.open[i-1] && c.open[i] <= c.close[i-1]+near1 &&
+				// 第一根：长实体，上影线短
+				c.realBody(i-2) > bodyLong2 && c.upperShadow(i-2) < shadowShort2 &&
+				// 上涨受阻：第二根实体明显缩短且第三根没有变长，或第三根实体明显缩短，
+				// 或实体逐根缩短且后两根之一有不短的上影线，或第三根实体缩短且上影线长
+				((c.realBody(i-1) < c.realBody(i-2)-far2 && c.realBody(i) < c.realBody(i-1)+near1) ||
+					c.realBody(i) < c.realBody(i-1)-far1 ||
+					(c.realBody(i) < c.realBody(i-1) && c.realBody(i-1) < c.realBody(i-2) &&
+						(c.upperShadow(i) > shadowShort0 || c.upperShadow(i-1) > shadowShort1)) ||
+					(c.realBody(i) < c.realBody(i-1) && c.upperShadow(i) > shadowLong0)) {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDLBELTHOLD": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyLong, CandleShadowVeryShort}},
+		averages: []cdlAverage{{CandleBodyLong, 0}, {CandleShadowVeryShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 长实体，阳线没有下影线或阴线没有上影线
+			if c.realBody(i) > avg[0] &&
+				((c.color(i) == 1 && c.lowerShadow(i) < avg[1]) || (c.color(i) == -1 && c.upperShadow(i) < avg[1])) {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLBREAKAWAY": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyLong}, extra: 4},
+		averages: []cdlAverage{{CandleBodyLong, 4}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 第一根长实体，第一、二、四根同色，第五根反色
+			if c.realBody(i-4) > avg[0] &&
+				c.color(i-4) == c.color(i-3) && c.color(i-3) == c.color(i-1) && c.color(i-1) == -c.color(i) &&
+				// 第二根跳空，第三、四根的高低点顺势推进，第五根收在跳空缺口内
+				((c.color(i-4) == -1 && c.realBodyGapDown(i-3, i-4) &&
+					c.high[i-2] < c.high[i-3] && c.low[i-2] < c.low[i-3] &&
+					c.high[i-1] < c.high[i-2] && c.low[i-1] < c.low[i-2] &&
+					c.close[i] > c.open[i-3] && c.close[i] < c.close[i-4]) ||
+					(c.color(i-4) == 1 && c.realBodyGapUp(i-3, i-4) &&
+						c.high[i-2] > c.high[i-3] && c.low[i-2] > c.low[i-3] &&
+						c.high[i-1] > c.high[i-2] && c.low[i-1] > c.low[i-2] &&
+						c.close[i] < c.open[i-3] && c.close[i] > c.close[i-4])) {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLCLOSINGMARUBOZU": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyLong, CandleShadowVeryShort}},
+		averages: []cdlAverage{{CandleBodyLong, 0}, {CandleShadowVeryShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 长实体，收盘价一侧没有影线
+			if c.realBody(i) > avg[0] &&
+				((c.color(i) == 1 && c.upperShadow(i) < avg[1]) || (c.color(i) == -1 && c.lowerShadow(i) < avg[1])) {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLCONCEALBABYSWALL": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleShadowVeryShort}, extra: 3},
+		averages: []cdlAverage{{CandleShadowVeryShort, 3}, {CandleShadowVeryShort, 2}, {CandleShadowVeryShort, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.color(i-3) == -1 && c.color(i-2) == -1 && c.color(i-1) == -1 && c.color(i) == -1 &&
+				// 前两根是光头光脚的阴线
+				c.lowerShadow(i-3) < avg[0] && c.upperShadow(i-3) < avg[0] &&
+				c.lowerShadow(i-2) < avg[1] && c.upperShadow(i-2) < avg[1] &&
+				// 第三根跳空低开，上影线伸入第二根的实体
+				c.realBodyGapDown(i-1, i-2) && c.upperShadow(i-1) > avg[2] && c.high[i-1] > c.close[i-2] &&
+				// 第四根连同影线完全包住第三根
+				c.high[i] > c.high[i-1] && c.low[i] < c.low[i-1] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLCOUNTERATTACK": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleEqual, CandleBodyLong}, extra: 1},
+		averages: []cdlAverage{{CandleEqual, 1}, {CandleBodyLong, 1}, {CandleBodyLong, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 两根颜色相反的长实体，收盘价相同
+			if c.color(i-1) == -c.color(i) && c.realBody(i-1) > avg[1] && c.realBody(i) > avg[2] &&
+				c.close[i] <= c.close[i-1]+avg[0] && c.close[i] >= c.close[i-1]-avg[0] {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLDARKCLOUDCOVER": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyLong}, extra: 1},
+		averages: []cdlAverage{{CandleBodyLong, 1}},
+		eval: func(c *candles, i int, avg []float64, penetration float64) int {
+			// 长阳线之后的阴线高于前一根最高价开盘，收盘深入前一根的实体
+			if c.color(i-1) == 1 && c.realBody(i-1) > avg[0] && c.color(i) == -1 &&
+				c.open[i] > c.high[i-1] && c.close[i] > c.open[i-1] &&
+				c.close[i] < c.close[i-1]-c.realBody(i-1)*penetration {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDLDOJI": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji}},
+		averages: []cdlAverage{{CandleBodyDoji, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i) <= avg[0] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLDOJISTAR": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji, CandleBodyLong}, extra: 1},
+		averages: []cdlAverage{{CandleBodyLong, 1}, {CandleBodyDoji, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 长实体之后顺势跳空的十字星
+			if c.realBody(i-1) > avg[0] && c.realBody(i) <= avg[1] &&
+				((c.color(i-1) == 1 && c.realBodyGapUp(i, i-1)) || (c.color(i-1) == -1 && c.realBodyGapDown(i, i-1))) {
+				return -c.color(i-1) * 100
+			}
+			return 0
+		},
+	},
+	"CDLDRAGONFLYDOJI": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji, CandleShadowVeryShort}},
+		averages: []cdlAverage{{CandleBodyDoji, 0}, {CandleShadowVeryShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i) <= avg[0] && c.upperShadow(i) < avg[1] && c.lowerShadow(i) > avg[1] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLENGULFING": {
+		lookback: cdlLookback{extra: 2},
+		eval: func(c *candles, i int, _ []float64, _ float64) int {
+			// 实体与前一根颜色相反并将其包住，只有一端与前一根的实体端点相同时为 ±80
+			if (c.color(i) == 1 && c.color(i-1) == -1 &&
+				((c.close[i] >= c.open[i-1] && c.open[i] < c.close[i-1]) ||
+					(c.close[i] > c.open[i-1] && c.open[i] <= c.close[i-1]))) ||
+				(c.color(i) == -1 && c.color(i-1) == 1 &&
+					((c.open[i] >= c.close[i-1] && c.close[i] < c.open[i-1]) ||
+						(c.open[i] > c.close[i-1] && c.close[i] <= c.open[i-1]))) {
+				if c.open[i] != c.close[i-1] && c.close[i] != c.open[i-1] {
+					return c.color(i) * 100
+				}
+				return c.color(i) * 80
+			}
+			return 0
+		},
+	},
+	"CDLEVENINGDOJISTAR": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji, CandleBodyLong, CandleBodyShort}, extra: 2},
+		averages: []cdlAverage{{CandleBodyLong, 2}, {CandleBodyDoji, 1}, {CandleBodyShort, 0}},
+		eval: func(c *candles, i int, avg []float64, penetration float64) int {
+			return starPattern(c, i, avg, penetration, -1)
+		},
+	},
+	"CDLEVENINGSTAR": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleBodyLong}, extra: 2},
+		averages: []cdlAverage{{CandleBodyLong, 2}, {CandleBodyShort, 1}, {CandleBodyShort, 0}},
+		eval: func(c *candles, i int, avg []float64, penetration float64) int {
+			return starPattern(c, i, avg, penetration, -1)
+		},
+	},
+	"CDLGAPSIDESIDEWHITE": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleNear, CandleEqual}, extra: 2},
+		averages: []cdlAverage{{CandleNear, 1}, {CandleEqual, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 后两根都与第一根之间有同向的实体跳空，是开盘价相同、实体相近的两根阳线
+			if ((c.realBodyGapUp(i-1, i-2) && c.realBodyGapUp(i, i-2)) ||
+				(c.realBodyGapDown(i-1, i-2) && c.realBodyGapDown(i, i-2))) &&
+				c.color(i-1) == 1 && c.color(i) == 1 &&
+				c.realBody(i) >= c.realBody(i-1)-avg[0] && c.realBody(i) <= c.realBody(i-1)+avg[0] &&
+				c.open[i] >= c.open[i-1]-avg[1] && c.open[i] <= c.open[i-1]+avg[1] {
+				if c.realBodyGapUp(i-1, i-2) {
+					return 100
+				}
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDLGRAVESTONEDOJI": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji, CandleShadowVeryShort}},
+		averages: []cdlAverage{{CandleBodyDoji, 0}, {CandleShadowVeryShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i) <= avg[0] && c.lowerShadow(i) < avg[1] && c.upperShadow(i) > avg[1] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLHAMMER": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleShadowLong, CandleShadowVeryShort, CandleNear}, extra: 1},
+		averages: []cdlAverage{{CandleBodyShort, 0}, {CandleShadowLong, 0}, {CandleShadowVeryShort, 0}, {CandleNear, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 小实体、长下影线、几乎没有上影线，实体位于前一根的低点附近
+			if c.realBody(i) < avg[0] && c.lowerShadow(i) > avg[1] && c.upperShadow(i) < avg[2] &&
+				c.bodyBottom(i) <= c.low[i-1]+avg[3] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLHANGINGMAN": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleShadowLong, CandleShadowVeryShort, CandleNear}, extra: 1},
+		averages: []cdlAverage{{CandleBodyShort, 0}, {CandleShadowLong, 0}, {CandleShadowVeryShort, 0}, {CandleNear, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 形状与锤子线相同，实体位于前一根的高点附近
+			if c.realBody(i) < avg[0] && c.lowerShadow(i) > avg[1] && c.upperShadow(i) < avg[2] &&
+				c.bodyBottom(i) >= c.high[i-1]-avg[3] {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDLHARAMI": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleBodyLong}, extra: 1},
+		averages: []cdlAverage{{CandleBodyLong, 1}, {CandleBodyShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i-1) > avg[0] && c.realBody(i) <= avg[1] {
+				return harami(c, i)
+			}
+			return 0
+		},
+	},
+	"CDLHARAMICROSS": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji, CandleBodyLong}, extra: 1},
+		averages: []cdlAverage{{CandleBodyLong, 1}, {CandleBodyDoji, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i-1) > avg[0] && c.realBody(i) <= avg[1] {
+				return harami(c, i)
+			}
+			return 0
+		},
+	},
+	"CDLHIGHWAVE": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleShadowVeryLong}},
+		averages: []cdlAverage{{CandleBodyShort, 0}, {CandleShadowVeryLong, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i) < avg[0] && c.upperShadow(i) > avg[1] && c.lowerShadow(i) > avg[1] {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLHIKKAKE": {
+		lookback: cdlLookback{extra: 5},
+		run: func(c *candles, startIdx int) []int {
+			// 第二根是第一根的内包线，第三根同时创出更低的高点与低点（看涨）或更高的高点与低点（看跌）
+			return hikkake(c, startIdx, func(i int) (int, bool) {
+				if c.high[i-1] < c.high[i-2] && c.low[i-1] > c.low[i-2] {
+					if c.high[i] < c.high[i-1] && c.low[i] < c.low[i-1] {
+						return 1, true
+					}
+					if c.high[i] > c.high[i-1] && c.low[i] > c.low[i-1] {
+						return -1, true
+					}
+				}
+				return 0, false
+			}, func(int) {})
+		},
+	},
+	"CDLHIKKAKEMOD": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleNear}, extra: 5, minPeriod: 1},
+		run: func(c *candles, startIdx int) []int {
+			// 在 Hikkake 之前多一根内包线，且第二根收于低点（看涨）或高点（看跌）附近
+			period := c.settings[CandleNear].AvgPeriod
+			total := 0.0
+			for j := startIdx - 5 - period; j < startIdx-5; j++ {
+				total += c.rangeOf(CandleNear, j)
+			}
+			return hikkake(c, startIdx, func(i int) (int, bool) {
+				near := c.average(CandleNear, total, i-2)
+				if c.high[i-2] < c.high[i-3] && c.low[i-2] > c.low[i-3] &&
+					c.high[i-1] < c.high[i-2] && c.low[i-1] > c.low[i-2] {
+					if c.high[i] < c.high[i-1] && c.low[i] < c.low[i-1] && c.close[i-2] <= c.low[i-2]+near {
+						return 1, true
+					}
+					if c.high[i] > c.high[i-1] && c.low[i] > c.low[i-1] && c.close[i-2] >= c.high[i-2]-near {
+						return -1, true
+					}
+				}
+				return 0, false
+			}, func(i int) {
+				total += c.rangeOf(CandleNear, i-2) - c.rangeOf(CandleNear, i-2-period)
+			})
+		},
+	},
+	"CDLHOMINGPIGEON": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleBodyLong}, extra: 1},
+		averages: []cdlAverage{{CandleBodyLong, 1}, {CandleBodyShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 长阴线之后是实体被其包住的短阴线
+			if c.color(i-1) == -1 && c.color(i) == -1 && c.realBody(i-1) > avg[0] && c.realBody(i) <= avg[1] &&
+				c.open[i] < c.open[i-1] && c.close[i] > c.close[i-1] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLIDENTICAL3CROWS": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleShadowVeryShort, CandleEqual}, extra: 2},
+		averages: []cdlAverage{
+			{CandleShadowVeryShort, 2}, {CandleShadowVeryShort, 1}, {CandleShadowVeryShort, 0},
+			{CandleEqual, 2}, {CandleEqual, 1},
+		},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 三根下影线极短、收盘价依次降低的阴线，每根都在前一根的收盘价开盘
+			if c.color(i-2) == -1 && c.lowerShadow(i-2) < avg[0] &&
+				c.color(i-1) == -1 && c.lowerShadow(i-1) < avg[1] &&
+				c.color(i) == -1 && c.lowerShadow(i) < avg[2] &&
+				c.close[i-2] > c.close[i-1] && c.close[i-1] > c.close[i] &&
+				c.open[i-1] <= c.close[i-2]+avg[3] && c.open[i-1] >= c.close[i-2]-avg[3] &&
+				c.open[i] <= c.close[i-1]+avg[4] && c.open[i] >= c.close[i-1]-avg[4] {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDLINNECK": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleEqual, CandleBodyLong}, extra: 1},
+		averages: []cdlAverage{{CandleEqual, 1}, {CandleBodyLong, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 长阴线之后低开的阳线收于前一根收盘价处或略高
+			if c.color(i-1) == -1 && c.realBody(i-1) > avg[1] && c.color(i) == 1 &&
+				c.open[i] < c.low[i-1] && c.close[i] <= c.close[i-1]+avg[0] && c.close[i] >= c.close[i-1] {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDLINVERTEDHAMMER": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleShadowLong, CandleShadowVeryShort}, extra: 1},
+		averages: []cdlAverage{{CandleBodyShort, 0}, {CandleShadowLong, 0}, {CandleShadowVeryShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 小实体、长上影线、几乎没有下影线，实体向下跳空
+			if c.realBody(i) < avg[0] && c.upperShadow(i) > avg[1] && c.lowerShadow(i) < avg[2] &&
+				c.realBodyGapDown(i, i-1) {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLKICKING": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleShadowVeryShort, CandleBodyLong}, extra: 1},
+		averages: kickingAverages,
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if kicking(c, i, avg) {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLKICKINGBYLENGTH": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleShadowVeryShort, CandleBodyLong}, extra: 1},
+		averages: kickingAverages,
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 方向取实体较长的一根
+			if kicking(c, i, avg) {
+				if c.realBody(i) > c.realBody(i-1) {
+					return c.color(i) * 100
+				}
+				return c.color(i-1) * 100
+			}
+			return 0
+		},
+	},
+	"CDLLADDERBOTTOM": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleShadowVeryShort}, extra: 4},
+		averages: []cdlAverage{{CandleShadowVeryShort, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 三根开盘价与收盘价依次降低的阴线，第四根是带上影线的阴线，第五根阳线高于其实体开盘、高于其最高价收盘
+			if c.color(i-4) == -1 && c.color(i-3) == -1 && c.color(i-2) == -1 &&
+				c.open[i-4] > c.open[i-3] && c.open[i-3] > c.open[i-2] &&
+				c.close[i-4] > c.close[i-3] && c.close[i-3] > c.close[i-2] &&
+				c.color(i-1) == -1 && c.upperShadow(i-1) > avg[0] &&
+				c.color(i) == 1 && c.open[i] > c.open[i-1] && c.close[i] > c.high[i-1] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLLONGLEGGEDDOJI": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji, CandleShadowLong}},
+		averages: []cdlAverage{{CandleBodyDoji, 0}, {CandleShadowLong, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i) <= avg[0] && (c.lowerShadow(i) > avg[1] || c.upperShadow(i) > avg[1]) {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLLONGLINE": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyLong, CandleShadowShort}},
+		averages: []cdlAverage{{CandleBodyLong, 0}, {CandleShadowShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i) > avg[0] && c.upperShadow(i) < avg[1] && c.lowerShadow(i) < avg[1] {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLMARUBOZU": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyLong, CandleShadowVeryShort}},
+		averages: []cdlAverage{{CandleBodyLong, 0}, {CandleShadowVeryShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i) > avg[0] && c.upperShadow(i) < avg[1] && c.lowerShadow(i) < avg[1] {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLMATCHINGLOW": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleEqual}, extra: 1},
+		averages: []cdlAverage{{CandleEqual, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 两根收盘价相同的阴线
+			if c.color(i-1) == -1 && c.color(i) == -1 &&
+				c.close[i] <= c.close[i-1]+avg[0] && c.close[i] >= c.close[i-1]-avg[0] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLMATHOLD": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleBodyLong}, extra: 4},
+		averages: []cdlAverage{{CandleBodyLong, 4}, {CandleBodyShort, 3}, {CandleBodyShort, 2}, {CandleBodyShort, 1}},
+		eval: func(c *candles, i int, avg []float64, penetration float64) int {
+			// 第一根长阳线，之后三根小实体：第二根为向上跳空的阴线，第三、四根逐根走低，
+			// 实体部分落在第一根的实体内但回落不超过 penetration；第五根阳线高开并收于这三根的最高价之上
+			if c.realBody(i-4) > avg[0] &&
+				c.realBody(i-3) < avg[1] && c.realBody(i-2) < avg[2] && c.realBody(i-1) < avg[3] &&
+				c.color(i-4) == 1 && c.color(i-3) == -1 && c.color(i) == 1 &&
+				c.realBodyGapUp(i-3, i-4) &&
+				c.bodyBottom(i-2) < c.close[i-4] && c.bodyBottom(i-1) < c.close[i-4] &&
+				c.bodyBottom(i-2) > c.close[i-4]-c.realBody(i-4)*penetration &&
+				c.bodyBottom(i-1) > c.close[i-4]-c.realBody(i-4)*penetration &&
+				c.bodyTop(i-2) < c.open[i-3] && c.bodyTop(i-1) < c.bodyTop(i-2) &&
+				c.open[i] > c.close[i-1] &&
+				c.close[i] > math.Max(math.Max(c.high[i-3], c.high[i-2]), c.high[i-1]) {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLMORNINGDOJISTAR": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji, CandleBodyLong, CandleBodyShort}, extra: 2},
+		averages: []cdlAverage{{CandleBodyLong, 2}, {CandleBodyDoji, 1}, {CandleBodyShort, 0}},
+		eval: func(c *candles, i int, avg []float64, penetration float64) int {
+			return starPattern(c, i, avg, penetration, 1)
+		},
+	},
+	"CDLMORNINGSTAR": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleBodyLong}, extra: 2},
+		averages: []cdlAverage{{CandleBodyLong, 2}, {CandleBodyShort, 1}, {CandleBodyShort, 0}},
+		eval: func(c *candles, i int, avg []float64, penetration float64) int {
+			return starPattern(c, i, avg, penetration, 1)
+		},
+	},
+	"CDLONNECK": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleEqual, CandleBodyLong}, extra: 1},
+		averages: []cdlAverage{{CandleEqual, 1}, {CandleBodyLong, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 长阴线之后低开的阳线收于前一根最低价处
+			if c.color(i-1) == -1 && c.realBody(i-1) > avg[1] && c.color(i) == 1 &&
+				c.open[i] < c.low[i-1] && c.close[i] <= c.low[i-1]+avg[0] && c.close[i] >= c.low[i-1]-avg[0] {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDLPIERCING": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyLong}, extra: 1},
+		averages: []cdlAverage{{CandleBodyLong, 1}, {CandleBodyLong, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 长阴线之后低开的长阳线收于前一根实体的中点之上
+			if c.color(i-1) == -1 && c.realBody(i-1) > avg[0] && c.color(i) == 1 && c.realBody(i) > avg[1] &&
+				c.open[i] < c.low[i-1] && c.close[i] < c.open[i-1] && c.close[i] > c.close[i-1]+c.realBody(i-1)*0.5 {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLRICKSHAWMAN": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji, CandleShadowLong, CandleNear}},
+		averages: []cdlAverage{{CandleBodyDoji, 0}, {CandleShadowLong, 0}, {CandleNear, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 上下影线都长的十字星，实体位于最高价与最低价的中点附近
+			mid := c.low[i] + c.highLowRange(i)/2
+			if c.realBody(i) <= avg[0] && c.lowerShadow(i) > avg[1] && c.upperShadow(i) > avg[1] &&
+				c.bodyBottom(i) <= mid+avg[2] && c.bodyTop(i) >= mid-avg[2] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLRISEFALL3METHODS": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleBodyLong}, extra: 4},
+		averages: []cdlAverage{{CandleBodyLong, 4}, {CandleBodyShort, 3}, {CandleBodyShort, 2}, {CandleBodyShort, 1}, {CandleBodyLong, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			dir := c.color(i - 4)
+			d := float64(dir)
+			// 首尾两根同色的长实体，中间三根反色的小实体都落在第一根的范围内并逆势推进，
+			// 第五根在第四根收盘价之外开盘并收于第一根的收盘价之外
+			if c.realBody(i-4) > avg[0] && c.realBody(i-3) < avg[1] && c.realBody(i-2) < avg[2] &&
+				c.realBody(i-1) < avg[3] && c.realBody(i) > avg[4] &&
+				dir == -c.color(i-3) && c.color(i-3) == c.color(i-2) && c.color(i-2) == c.color(i-1) && c.color(i-1) == -c.color(i) &&
+				c.bodyBottom(i-3) < c.high[i-4] && c.bodyTop(i-3) > c.low[i-4] &&
+				c.bodyBottom(i-2) < c.high[i-4] && c.bodyTop(i-2) > c.low[i-4] &&
+				c.bodyBottom(i-1) < c.high[i-4] && c.bodyTop(i-1) > c.low[i-4] &&
+				c.close[i-2]*d < c.close[i-3]*d && c.close[i-1]*d < c.close[i-2]*d &&
+				c.open[i]*d > c.close[i-1]*d && c.close[i]*d > c.close[i-4]*d {
+				return 100 * dir
+			}
+			return 0
+		},
+	},
+	"CDLSEPARATINGLINES": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleShadowVeryShort, CandleBodyLong, CandleEqual}, extra: 1},
+		averages: []cdlAverage{{CandleShadowVeryShort, 0}, {CandleBodyLong, 0}, {CandleEqual, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 与前一根颜色相反、开盘价相同的长实体，开盘一侧没有影线
+			if c.color(i-1) == -c.color(i) &&
+				c.open[i] <= c.open[i-1]+avg[2] && c.open[i] >= c.open[i-1]-avg[2] &&
+				c.realBody(i) > avg[1] &&
+				((c.color(i) == 1 && c.lowerShadow(i) < avg[0]) || (c.color(i) == -1 && c.upperShadow(i) < avg[0])) {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLSHOOTINGSTAR": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleShadowLong, CandleShadowVeryShort}, extra: 1},
+		averages: []cdlAverage{{CandleBodyShort, 0}, {CandleShadowLong, 0}, {CandleShadowVeryShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 小实体、长上影线、几乎没有下影线，实体向上跳空
+			if c.realBody(i) < avg[0] && c.upperShadow(i) > avg[1] && c.lowerShadow(i) < avg[2] &&
+				c.realBodyGapUp(i, i-1) {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDLSHORTLINE": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleShadowShort}},
+		averages: []cdlAverage{{CandleBodyShort, 0}, {CandleShadowShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i) < avg[0] && c.upperShadow(i) < avg[1] && c.lowerShadow(i) < avg[1] {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLSPINNINGTOP": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort}},
+		averages: []cdlAverage{{CandleBodyShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 小实体，上下影线都比实体长
+			if c.realBody(i) < avg[0] && c.upperShadow(i) > c.realBody(i) && c.lowerShadow(i) > c.realBody(i) {
+				return c.color(i) * 100
+			}
+			return 0
+		},
+	},
+	"CDLSTALLEDPATTERN": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyLong, CandleBodyShort, CandleShadowVeryShort, CandleNear}, extra: 2},
+		averages: []cdlAverage{
+			{CandleBodyLong, 2}, {CandleBodyLong, 1}, {CandleBodyShort, 0},
+			{CandleShadowVeryShort, 1}, {CandleNear, 2}, {CandleNear, 1},
+		},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 三根收盘价依次升高的阳线：前两根是长实体，第二根几乎没有上影线并在第一根实体内或附近开盘，
+			// 第三根是小实体，开盘于第二根收盘价附近
+			if c.color(i-2) == 1 && c.color(i-1) == 1 && c.color(i) == 1 &&
+				c.close[i] > c.close[i-1] && c.close[i-1] > c.close[i-2] &&
+				c.realBody(i-2) > avg[0] && c.realBody(i-1) > avg[1] &&
+				c.upperShadow(i-1) < avg[3] &&
+				c.open[i-1] > c.open[i-2] && c.open[i-1] <= c.close[i-2]+avg[4] &&
+				c.realBody(i) < avg[2] &&
+				c.open[i] >= c.close[i-1]-c.realBody(i)-avg[5] {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDLSTICKSANDWICH": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleEqual}, extra: 2},
+		averages: []cdlAverage{{CandleEqual, 2}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 阴、阳、阴三根，阳线的最低价高于第一根收盘价，第三根与第一根收盘价相同
+			if c.color(i-2) == -1 && c.color(i-1) == 1 && c.color(i) == -1 &&
+				c.low[i-1] > c.close[i-2] &&
+				c.close[i] <= c.close[i-2]+avg[0] && c.close[i] >= c.close[i-2]-avg[0] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLTAKURI": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji, CandleShadowVeryShort, CandleShadowVeryLong}},
+		averages: []cdlAverage{{CandleBodyDoji, 0}, {CandleShadowVeryShort, 0}, {CandleShadowVeryLong, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			if c.realBody(i) <= avg[0] && c.upperShadow(i) < avg[1] && c.lowerShadow(i) > avg[2] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLTASUKIGAP": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleNear}, extra: 2},
+		averages: []cdlAverage{{CandleNear, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 跳空之后两根颜色相反、实体相近，第三根在第二根实体内开盘并收在缺口之内
+			if (c.realBodyGapUp(i-1, i-2) && c.color(i-1) == 1 && c.color(i) == -1 &&
+				c.open[i] < c.close[i-1] && c.open[i] > c.open[i-1] &&
+				c.close[i] < c.open[i-1] && c.close[i] > c.bodyTop(i-2) &&
+				math.Abs(c.realBody(i-1)-c.realBody(i)) < avg[0]) ||
+				(c.realBodyGapDown(i-1, i-2) && c.color(i-1) == -1 && c.color(i) == 1 &&
+					c.open[i] < c.open[i-1] && c.open[i] > c.close[i-1] &&
+					c.close[i] > c.open[i-1] && c.close[i] < c.bodyBottom(i-2) &&
+					math.Abs(c.realBody(i-1)-c.realBody(i)) < avg[0]) {
+				return c.color(i-1) * 100
+			}
+			return 0
+		},
+	},
+	"CDLTHRUSTING": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleEqual, CandleBodyLong}, extra: 1},
+		averages: []cdlAverage{{CandleEqual, 1}, {CandleBodyLong, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 长阴线之后低开的阳线收于前一根收盘价之上、实体中点之下
+			if c.color(i-1) == -1 && c.realBody(i-1) > avg[1] && c.color(i) == 1 &&
+				c.open[i] < c.low[i-1] &&
+				c.close[i] > c.close[i-1]+avg[0] && c.close[i] <= c.close[i-1]+c.realBody(i-1)*0.5 {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDLTRISTAR": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyDoji}, extra: 2},
+		averages: []cdlAverage{{CandleBodyDoji, 2}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 三根十字星（与 TA-Lib 相同，都以第一根之前的平均判断），中间一根跳空，第三根回到其实体之内
+			if c.realBody(i-2) > avg[0] || c.realBody(i-1) > avg[0] || c.realBody(i) > avg[0] {
+				return 0
+			}
+			out := 0
+			if c.realBodyGapUp(i-1, i-2) && c.bodyTop(i) < c.bodyTop(i-1) {
+				out = -100
+			}
+			if c.realBodyGapDown(i-1, i-2) && c.bodyBottom(i) > c.bodyBottom(i-1) {
+				out = 100
+			}
+			return out
+		},
+	},
+	"CDLUNIQUE3RIVER": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleBodyLong}, extra: 2},
+		averages: []cdlAverage{{CandleBodyLong, 2}, {CandleBodyShort, 0}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 长阴线之后是实体在其内、创出新低的阴线，第三根是高于第二根最低价开盘的小阳线
+			if c.realBody(i-2) > avg[0] && c.color(i-2) == -1 &&
+				c.color(i-1) == -1 && c.close[i-1] > c.close[i-2] && c.open[i-1] <= c.open[i-2] && c.low[i-1] < c.low[i-2] &&
+				c.realBody(i) < avg[1] && c.color(i) == 1 && c.open[i] > c.low[i-1] {
+				return 100
+			}
+			return 0
+		},
+	},
+	"CDLUPSIDEGAP2CROWS": {
+		lookback: cdlLookback{settings: []CandleSettingType{CandleBodyShort, CandleBodyLong}, extra: 2},
+		averages: []cdlAverage{{CandleBodyLong, 2}, {CandleBodyShort, 1}},
+		eval: func(c *candles, i int, avg []float64, _ float64) int {
+			// 长阳线之后向上跳空的小阴线，第三根阴线将其包住但仍收于第一根收盘价之上
+			if c.color(i-2) == 1 && c.realBody(i-2) > avg[0] &&
+				c.color(i-1) == -1 && c.realBody(i-1) <= avg[1] && c.realBodyGapUp(i-1, i-2) &&
+				c.color(i) == -1 && c.open[i] > c.open[i-1] && c.close[i] < c.close[i-1] && c.close[i] > c.close[i-2] {
+				return -100
+			}
+			return 0
+		},
+	},
+	"CDLXSIDEGAP3METHODS": {
+		lookback: cdlLookback{extra: 2},
+		eval: func(c *candles, i int, _ []float64, _ float64) int {
+			// 两根同色且顺势跳空，第三根反色，在第二根实体内开盘、在第一根实体内收盘
+			if c.color(i-2) == c.color(i-1) && c.color(i-1) == -c.color(i) &&
+				c.open[i] < c.bodyTop(i-1) && c.open[i] > c.bodyBottom(i-1) &&
+				c.close[i] < c.bodyTop(i-2) && c.close[i] > c.bodyBottom(i-2) &&
+				((c.color(i-2) == 1 && c.realBodyGapUp(i-1, i-2)) || (c.color(i-2) == -1 && c.realBodyGapDown(i-1, i-2))) {
+				return c.color(i-2) * 100
+			}
+			return 0
+		},
+	},
+}
+
+// starPattern 判断晨星（dir 为1）与暮星（dir 为-1）及其十字星版本，avg 依次为第一根的长实体、
+// 第二根的短实体或十字星以及第三根的短实体阈值：长实体之后跳空的星线，第三根反向的实体深入第一根的实体。
+func starPattern(c *candles, i int, avg []float64, penetration float64, dir int) int {
+	if c.realBody(i-2) > avg[0] && c.color(i-2) == -dir && c.realBody(i-1) <= avg[1] &&
+		c.realBody(i) > avg[2] && c.color(i) == dir {
+		if dir == 1 && c.realBodyGapDown(i-1, i-2) && c.close[i] > c.close[i-2]+c.realBody(i-2)*penetration {
+			return 100
+		}
+		if dir == -1 && c.realBodyGapUp(i-1, i-2) && c.close[i] < c.close[i-2]-c.realBody(i-2)*penetration {
+			return -100
+		}
+	}
+	return 0
+}
+
+// harami 判断第 i 根的实体是否在前一根的实体之内：严格在内时为 ±100，端点相同时为 ±80，方向与前一根相反。
+func harami(c *candles, i int) int {
+	if c.bodyTop(i) < c.bodyTop(i-1) && c.bodyBottom(i) > c.bodyBottom(i-1) {
+		return -c.color(i-1) * 100
+	}
+	if c.bodyTop(i) <= c.bodyTop(i-1) && c.bodyBottom(i) >= c.bodyBottom(i-1) {
+		return -c.color(i-1) * 80
+	}
+	return 0
+}
+
+// kickingAverages 依次为前一根与当前根的极短影线、长实体阈值。
+var kickingAverages = []cdlAverage{{CandleShadowVeryShort, 1}, {CandleShadowVeryShort, 0}, {CandleBodyLong, 1}, {CandleBodyLong, 0}}
+
+// kicking 判断两根颜色相反的光头光脚长实体之间是否有跳空（阴线后向上、阳线后向下）。
+func kicking(c *candles, i int, avg []float64) bool {
+	return c.color(i-1) == -c.color(i) &&
+		c.realBody(i-1) > avg[2] && c.upperShadow(i-1) < avg[0] && c.lowerShadow(i-1) < avg[0] &&
+		c.realBody(i) > avg[3] && c.upperShadow(i) < avg[1] && c.lowerShadow(i) < avg[1] &&
+		((c.color(i-1) == -1 && c.gapUp(i, i-1)) || (c.color(i-1) == 1 && c.gapDown(i, i-1)))
+}
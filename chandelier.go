@@ -0,0 +1,75 @@
+package go4ta
+
+// ChandelierExit 计算吊灯止损（Chandelier Exit），按位置返回各输出，结果含义见 CalcChandelierExit。
+//
+// @param high, low, close - 价格序列
+// @param timePeriod       - 最高价、最低价与 ATR 的周期（如22）
+// @param multiplier       - ATR 倍数（如3）
+// @return long, short     - 多头与空头止损线，与输入等长
+// @return error           - 如果输入数据无效或计算失败，则返回错误。
+func ChandelierExit(high, low, close []float64, timePeriod int, multiplier float64) (long, short []float64, err error) {
+	r, err := CalcChandelierExit(high, low, close, timePeriod, multiplier)
+	return r.Long, r.Short, err
+}
+
+// CalcChandelierExit 计算吊灯止损：多头止损为最近 timePeriod 根的最高价减去 multiplier 倍的 ATR，
+// 空头止损为最低价加上 multiplier 倍的 ATR。ATR 由与 ATR 函数相同的计算得出。
+//
+// @param high, low, close - 价格序列
+// @param timePeriod       - 最高价、最低价与 ATR 的周期（如22）
+// @param multiplier       - ATR 倍数（如3）
+// @return ChandelierResult - 多头与空头止损线，与输入等长，ATR 有效之前按 SetFillPolicy 的设置填充，默认为0。
+// @return error           - 如果输入数据无效或计算失败，则返回错误。
+func CalcChandelierExit(high, low, close []float64, timePeriod int, multiplier float64) (ChandelierResult, error) {
+	n, err := checkInputs("ChandelierExit", "high, low, close", high, low, close)
+	if err != nil {
+		return ChandelierResult{}, err
+	}
+	if n == 0 {
+		return ChandelierResult{[]float64{}, []float64{}}, nil
+	}
+	if n < timePeriod {
+		return ChandelierResult{}, tooShort("ChandelierExit", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("CHANDELIEREXIT", float64(timePeriod), multiplier); err != nil {
+		return ChandelierResult{}, err
+	}
+
+	atrBegIdx, atr, err := taATR(high, low, close, timePeriod)
+	if err != nil {
+		return ChandelierResult{}, err
+	}
+	// ATR 的回看期不短于 timePeriod-1，最高价与最低价此时已经有效
+	highBegIdx, highest := rollingHighest(high, timePeriod)
+	_, lowest := rollingLowest(low, timePeriod)
+
+	var outLong, outShort []float64
+	if len(atr) > 0 {
+		offset := atrBegIdx - highBegIdx
+		outLong = make([]float64, len(atr))
+		outShort = make([]float64, len(atr))
+		for i, v := range atr {
+			outLong[i] = highest[offset+i] - multiplier*v
+			outShort[i] = lowest[offset+i] + multiplier*v
+		}
+	}
+
+	w := newWarmup(0)
+	return ChandelierResult{
+		Long:  w.spread(n, atrBegIdx, outLong),
+		Short: w.spread(n, atrBegIdx, outShort),
+	}, nil
+}
+
+// ChandelierExitLookback 返回 ChandelierExit 的回看期，与 ATR(timePeriod) 相同。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func ChandelierExitLookback(timePeriod int) (int, error) {
+	if timePeriod < 1 {
+		return 0, badParam("ChandelierExit", "timePeriod", timePeriod)
+	}
+	return ATRLookback(timePeriod)
+}
@@ -0,0 +1,75 @@
+package go4ta
+
+// CMF 计算佳庆资金流量（Chaikin Money Flow），即窗口内资金流量（与 AD 每根的增量相同）的合计除以成交量的合计，
+// 取值在 -1 到 1 之间。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param volume     - 成交量序列
+// @param timePeriod - 计算周期（如20）
+// @return []float64 - CMF 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0；窗口内成交量合计为0时为0。
+// @return error     - 如果输入数据无效或参数超出范围，则返回错误。
+func CMF(high, low, close, volume []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("CMF", "high, low, close, volume", high, low, close, volume)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("CMF", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("CMF", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	// 一字线的资金流量为0，与 AD 相同
+	flow := make([]float64, n)
+	for i := range flow {
+		if rng := high[i] - low[i]; rng > 0 {
+			flow[i] = ((close[i] - low[i]) - (high[i] - close[i])) / rng * volume[i]
+		}
+	}
+
+	// 与 VWMA 相同，按窗口内有成交量的根数判断成交量合计是否为0，不依赖带舍入误差的合计
+	begIdx := timePeriod - 1
+	output := make([]float64, n-begIdx)
+	var sumFlow, sumV float64
+	traded := 0
+	for i := range flow {
+		sumFlow += flow[i]
+		sumV += volume[i]
+		if volume[i] != 0 {
+			traded++
+		}
+		if i < begIdx {
+			continue
+		}
+		if traded > 0 && sumV > 0 {
+			output[i-begIdx] = sumFlow / sumV
+		}
+		trailing := i - begIdx
+		sumFlow -= flow[trailing]
+		sumV -= volume[trailing]
+		if volume[trailing] != 0 {
+			traded--
+		}
+	}
+
+	return newWarmup(0).spread(n, begIdx, output), nil
+}
+
+// CMFLookback 返回 CMF 的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func CMFLookback(timePeriod int) (int, error) {
+	if timePeriod < 1 || timePeriod > 100000 {
+		return 0, badParam("CMF", "timePeriod", timePeriod)
+	}
+	return timePeriod - 1, nil
+}
@@ -0,0 +1,38 @@
+package go4ta
+
+// CMO 计算钱德动量摆动指标（Chande Momentum Oscillator），取值在 -100 到 100 之间。
+// 与 TA-Lib 相同，涨跌幅按 Wilder 方式平滑，因此受 FuncUnstCMO 的不稳定期影响。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - CMO 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func CMO(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("CMO", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("CMO", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taCMO(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// CMOLookback 返回 CMO 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func CMOLookback(timePeriod int) (int, error) {
+	_, err := cmoParams(timePeriod)
+	return lookbackResult("TA_CMO", taCMOLookback(timePeriod), err)
+}
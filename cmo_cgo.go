@@ -0,0 +1,46 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taCMO 调用 TA_CMO。
+func taCMO(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_CMO(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := cmoParams(timePeriod)
+		return 0, nil, taErr("TA_CMO", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taCMOLookback 调用 TA_CMO_Lookback，参数无效时返回 -1。
+func taCMOLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_CMO_Lookback(C.int(timePeriod)))
+}
@@ -0,0 +1,29 @@
+package go4ta
+
+// nativeCMO 是 TA_CMO 的原生实现，与 RSI 共用涨跌幅的平滑，见 intGainLoss。
+func nativeCMO(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := cmoParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intGainLoss(close, timePeriod, FuncUnstCMO, func(gain, loss float64) float64 {
+		return 100.0 * ((gain - loss) / (gain + loss))
+	})
+	return outBegIdx, output, nil
+}
+
+// nativeCMOLookback 对应 TA_CMO_Lookback，参数无效时返回 -1。
+func nativeCMOLookback(timePeriod int) int {
+	timePeriod, err := cmoParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return gainLossLookback(timePeriod, FuncUnstCMO)
+}
+
+// cmoParams 按 TA_CMO 的规则处理参数。
+func cmoParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_CMO"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
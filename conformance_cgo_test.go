@@ -0,0 +1,521 @@
+//go:build cgo && !purego
+
+package go4ta
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestConformanceTALibVsNative 用随机序列比较 TA-Lib 与原生 Go 两套实现。
+func TestConformanceTALibVsNative(t *testing.T) {
+	for _, seed := range []int64{1, 2, 3} {
+		runConformance(t, seed, talibNativeCases())
+	}
+}
+
+func talibNativeCases() []conformanceCase {
+	var cases []conformanceCase
+	add := func(name string, params string, want, got conformanceImpl) {
+		cases = append(cases, conformanceCase{name, params, want, got})
+	}
+
+	for _, p := range []int{1, 2, 5, 30} {
+		for maType := 0; maType <= 8; maType++ {
+			add("MA", fmt.Sprint(p, ",", maType),
+				func(s *conformanceSeries) conformanceOutput { return out1(taMA(s.close, p, maType)) },
+				func(s *conformanceSeries) conformanceOutput { return out1(nativeMA(s.close, p, maType)) })
+		}
+	}
+	for _, p := range []int{2, 5, 30} {
+		add("DEMA", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taDEMA(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeDEMA(s.close, p)) })
+		add("TEMA", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taTEMA(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeTEMA(s.close, p)) })
+		add("TRIMA", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taTRIMA(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeTRIMA(s.close, p)) })
+		add("KAMA", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taKAMA(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeKAMA(s.close, p)) })
+		for _, v := range []float64{0, 0.7, 1} {
+			add("T3", fmt.Sprint(p, ",", v),
+				func(s *conformanceSeries) conformanceOutput { return out1(taT3(s.close, p, v)) },
+				func(s *conformanceSeries) conformanceOutput { return out1(nativeT3(s.close, p, v)) })
+		}
+	}
+	for _, p := range [][2]float64{{0.5, 0.05}, {0.9, 0.1}, {0.01, 0.99}} {
+		add("MAMA", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out2(taMAMA(s.close, p[0], p[1])) },
+			func(s *conformanceSeries) conformanceOutput { return out2(nativeMAMA(s.close, p[0], p[1])) })
+	}
+	for _, p := range []int{2, 14, 50} {
+		add("RSI", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taRSI(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeRSI(s.close, p)) })
+		add("ADX", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taADX(s.high, s.low, s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeADX(s.high, s.low, s.close, p)) })
+		add("LINEARREG", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taLINEARREG(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeLINEARREG(s.close, p)) })
+		add("LINEARREG_SLOPE", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taLINEARREGSLOPE(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeLINEARREGSLOPE(s.close, p)) })
+		add("LINEARREG_ANGLE", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taLINEARREGANGLE(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeLINEARREGANGLE(s.close, p)) })
+		add("LINEARREG_INTERCEPT", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taLINEARREGINTERCEPT(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeLINEARREGINTERCEPT(s.close, p)) })
+		add("TSF", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taTSF(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeTSF(s.close, p)) })
+		add("STDDEV", fmt.Sprint(p, ",1.5"),
+			func(s *conformanceSeries) conformanceOutput { return out1(taSTDDEV(s.close, p, 1.5)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeSTDDEV(s.close, p, 1.5)) })
+	}
+	for _, p := range []int{1, 2, 5, 30} {
+		add("VAR", fmt.Sprint(p, ",1.5"),
+			func(s *conformanceSeries) conformanceOutput { return out1(taVAR(s.close, p, 1.5)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeVAR(s.close, p, 1.5)) })
+		add("CORREL", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taCORREL(s.high, s.low, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeCORREL(s.high, s.low, p)) })
+		add("BETA", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taBETA(s.open, s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeBETA(s.open, s.close, p)) })
+	}
+	for _, p := range []int{2, 14, 30} {
+		add("SUM", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taSUM(s.volume, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeSUM(s.volume, p)) })
+		add("MAX", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taMAX(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeMAX(s.close, p)) })
+		add("MIN", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taMIN(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeMIN(s.close, p)) })
+		add("MINMAX", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out2(taMINMAX(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out2(nativeMINMAX(s.close, p)) })
+		add("MAXINDEX", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return outInt(taMAXINDEX(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return outInt(nativeMAXINDEX(s.close, p)) })
+		add("MININDEX", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return outInt(taMININDEX(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return outInt(nativeMININDEX(s.close, p)) })
+		add("MINMAXINDEX", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput {
+				begIdx, minIdx, maxIdx, err := taMINMAXINDEX(s.close, p)
+				return out2(begIdx, intsToFloats(minIdx), intsToFloats(maxIdx), err)
+			},
+			func(s *conformanceSeries) conformanceOutput {
+				begIdx, minIdx, maxIdx, err := nativeMINMAXINDEX(s.close, p)
+				return out2(begIdx, intsToFloats(minIdx), intsToFloats(maxIdx), err)
+			})
+		add("MIDPOINT", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taMIDPOINT(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeMIDPOINT(s.close, p)) })
+		add("MIDPRICE", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taMIDPRICE(s.high, s.low, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeMIDPRICE(s.high, s.low, p)) })
+	}
+	for _, p := range []int{1, 2, 14} {
+		add("PLUS_DM", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taPLUSDM(s.high, s.low, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativePLUSDM(s.high, s.low, p)) })
+		add("MINUS_DM", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taMINUSDM(s.high, s.low, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeMINUSDM(s.high, s.low, p)) })
+		add("PLUS_DI", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taPLUSDI(s.high, s.low, s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativePLUSDI(s.high, s.low, s.close, p)) })
+		add("MINUS_DI", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taMINUSDI(s.high, s.low, s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeMINUSDI(s.high, s.low, s.close, p)) })
+	}
+	// dmiPart 取出 DMI 的第 i 个输出，依次为 ADX、ADXR、+DI、-DI
+	dmiPart := func(dmi func(high, low, close []float64, timePeriod int) (dmiOutput, error), p, i int) conformanceImpl {
+		return func(s *conformanceSeries) conformanceOutput {
+			out, err := dmi(s.high, s.low, s.close, p)
+			begIdx := []int{out.ADXBegIdx, out.ADXRBegIdx, out.PlusDIBegIdx, out.MinusDIBegIdx}[i]
+			return out1(begIdx, [][]float64{out.ADX, out.ADXR, out.PlusDI, out.MinusDI}[i], err)
+		}
+	}
+	for _, p := range []int{2, 14, 50} {
+		add("DX", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taDX(s.high, s.low, s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeDX(s.high, s.low, s.close, p)) })
+		add("ADXR", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taADXR(s.high, s.low, s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeADXR(s.high, s.low, s.close, p)) })
+		for i, name := range []string{"DMI.ADX", "DMI.ADXR", "DMI.PLUS_DI", "DMI.MINUS_DI"} {
+			add(name, fmt.Sprint(p), dmiPart(taDMI, p, i), dmiPart(nativeDMI, p, i))
+		}
+	}
+	for _, p := range []int{1, 2, 14} {
+		add("NATR", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taNATR(s.high, s.low, s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeNATR(s.high, s.low, s.close, p)) })
+	}
+	add("TRANGE", "",
+		func(s *conformanceSeries) conformanceOutput { return out1(taTRANGE(s.high, s.low, s.close)) },
+		func(s *conformanceSeries) conformanceOutput { return out1(nativeTRANGE(s.high, s.low, s.close)) })
+	for _, p := range []int{1, 2, 14} {
+		add("ATR", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taATR(s.high, s.low, s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeATR(s.high, s.low, s.close, p)) })
+	}
+	for _, p := range [][3]int{{12, 26, 9}, {26, 12, 9}, {2, 3, 1}, {5, 35, 5}} {
+		add("MACD", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out3(taMACD(s.close, p[0], p[1], p[2])) },
+			func(s *conformanceSeries) conformanceOutput { return out3(nativeMACD(s.close, p[0], p[1], p[2])) })
+	}
+	for _, ps := range [][2]float64{{0.02, 0.2}, {0.05, 0.1}, {0.3, 0.2}, {0, 0}} {
+		add("SAR", fmt.Sprint(ps),
+			func(s *conformanceSeries) conformanceOutput { return out1(taSAR(s.high, s.low, ps[0], ps[1])) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeSAR(s.high, s.low, ps[0], ps[1])) })
+	}
+	for _, ps := range [][8]float64{
+		{0, 0, 0.02, 0.02, 0.2, 0.02, 0.02, 0.2},
+		{100, 0.01, 0.01, 0.03, 0.25, 0.04, 0.02, 0.1},
+		{-100, 0.05, 0.3, 0.3, 0.2, 0, 0.5, 0.4},
+	} {
+		add("SAREXT", fmt.Sprint(ps),
+			func(s *conformanceSeries) conformanceOutput {
+				return out1(taSAREXT(s.high, s.low, ps[0], ps[1], ps[2], ps[3], ps[4], ps[5], ps[6], ps[7]))
+			},
+			func(s *conformanceSeries) conformanceOutput {
+				return out1(nativeSAREXT(s.high, s.low, ps[0], ps[1], ps[2], ps[3], ps[4], ps[5], ps[6], ps[7]))
+			})
+	}
+	for maType := 0; maType <= 8; maType++ {
+		add("BBANDS", fmt.Sprint("20,2,1.5,", maType),
+			func(s *conformanceSeries) conformanceOutput { return out3(taBBands(s.close, 20, 2, 1.5, maType)) },
+			func(s *conformanceSeries) conformanceOutput { return out3(nativeBBands(s.close, 20, 2, 1.5, maType)) })
+		add("APO", fmt.Sprint("12,26,", maType),
+			func(s *conformanceSeries) conformanceOutput { return out1(taAPO(s.close, 12, 26, maType)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeAPO(s.close, 12, 26, maType)) })
+		add("PPO", fmt.Sprint("26,12,", maType),
+			func(s *conformanceSeries) conformanceOutput { return out1(taPPO(s.close, 26, 12, maType)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativePPO(s.close, 26, 12, maType)) })
+		add("STOCH", fmt.Sprint("5,3,3,", maType, ",", maType),
+			func(s *conformanceSeries) conformanceOutput {
+				return out2(taSTOCH(s.high, s.low, s.close, 5, 3, 3, maType, maType))
+			},
+			func(s *conformanceSeries) conformanceOutput {
+				return out2(nativeSTOCH(s.high, s.low, s.close, 5, 3, 3, maType, maType))
+			})
+		add("STOCHRSI", fmt.Sprint("14,5,3,", maType),
+			func(s *conformanceSeries) conformanceOutput { return out2(taSTOCHRSI(s.close, 14, 5, 3, maType)) },
+			func(s *conformanceSeries) conformanceOutput { return out2(nativeSTOCHRSI(s.close, 14, 5, 3, maType)) })
+	}
+	add("OBV", "",
+		func(s *conformanceSeries) conformanceOutput { return out1(taOBV(s.close, s.volume)) },
+		func(s *conformanceSeries) conformanceOutput { return out1(nativeOBV(s.close, s.volume)) })
+	add("AD", "",
+		func(s *conformanceSeries) conformanceOutput { return out1(taAD(s.high, s.low, s.close, s.volume)) },
+		func(s *conformanceSeries) conformanceOutput { return out1(nativeAD(s.high, s.low, s.close, s.volume)) })
+	for _, ps := range [][2]int{{3, 10}, {10, 3}, {2, 2}, {5, 30}} {
+		add("ADOSC", fmt.Sprint(ps),
+			func(s *conformanceSeries) conformanceOutput {
+				return out1(taADOSC(s.high, s.low, s.close, s.volume, ps[0], ps[1]))
+			},
+			func(s *conformanceSeries) conformanceOutput {
+				return out1(nativeADOSC(s.high, s.low, s.close, s.volume, ps[0], ps[1]))
+			})
+	}
+
+	for _, p := range []int{1, 2, 10, 30} {
+		for _, f := range []struct {
+			name       string
+			ta, native func([]float64, int) (int, []float64, error)
+		}{
+			{"MOM", taMOM, nativeMOM},
+			{"ROC", taROC, nativeROC},
+			{"ROCP", taROCP, nativeROCP},
+			{"ROCR", taROCR, nativeROCR},
+			{"ROCR100", taROCR100, nativeROCR100},
+			{"TRIX", taTRIX, nativeTRIX},
+		} {
+			add(f.name, fmt.Sprint(p),
+				func(s *conformanceSeries) conformanceOutput { return out1(f.ta(s.close, p)) },
+				func(s *conformanceSeries) conformanceOutput { return out1(f.native(s.close, p)) })
+		}
+	}
+	for _, p := range []int{2, 14, 50} {
+		add("CMO", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taCMO(s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeCMO(s.close, p)) })
+		add("CCI", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taCCI(s.high, s.low, s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeCCI(s.high, s.low, s.close, p)) })
+		add("WILLR", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taWILLR(s.high, s.low, s.close, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeWILLR(s.high, s.low, s.close, p)) })
+		add("MFI", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taMFI(s.high, s.low, s.close, s.volume, p)) },
+			func(s *conformanceSeries) conformanceOutput {
+				return out1(nativeMFI(s.high, s.low, s.close, s.volume, p))
+			})
+	}
+	for _, p := range []int{2, 14, 25} {
+		add("AROON", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out2(taAROON(s.high, s.low, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out2(nativeAROON(s.high, s.low, p)) })
+		add("AROONOSC", fmt.Sprint(p),
+			func(s *conformanceSeries) conformanceOutput { return out1(taAROONOSC(s.high, s.low, p)) },
+			func(s *conformanceSeries) conformanceOutput { return out1(nativeAROONOSC(s.high, s.low, p)) })
+	}
+	for _, ps := range [][3]int{{7, 14, 28}, {28, 7, 14}, {1, 1, 1}, {5, 5, 30}} {
+		add("ULTOSC", fmt.Sprint(ps),
+			func(s *conformanceSeries) conformanceOutput {
+				return out1(taULTOSC(s.high, s.low, s.close, ps[0], ps[1], ps[2]))
+			},
+			func(s *conformanceSeries) conformanceOutput {
+				return out1(nativeULTOSC(s.high, s.low, s.close, ps[0], ps[1], ps[2]))
+			})
+	}
+	add("BOP", "",
+		func(s *conformanceSeries) conformanceOutput { return out1(taBOP(s.open, s.high, s.low, s.close)) },
+		func(s *conformanceSeries) conformanceOutput { return out1(nativeBOP(s.open, s.high, s.low, s.close)) })
+	add("HT_DCPERIOD", "",
+		func(s *conformanceSeries) conformanceOutput { return out1(taHTDCPERIOD(s.close)) },
+		func(s *conformanceSeries) conformanceOutput { return out1(nativeHTDCPERIOD(s.close)) })
+	add("HT_DCPHASE", "",
+		func(s *conformanceSeries) conformanceOutput { return out1(taHTDCPHASE(s.close)) },
+		func(s *conformanceSeries) conformanceOutput { return out1(nativeHTDCPHASE(s.close)) })
+	add("HT_PHASOR", "",
+		func(s *conformanceSeries) conformanceOutput { return out2(taHTPHASOR(s.close)) },
+		func(s *conformanceSeries) conformanceOutput { return out2(nativeHTPHASOR(s.close)) })
+	add("HT_SINE", "",
+		func(s *conformanceSeries) conformanceOutput { return out2(taHTSINE(s.close)) },
+		func(s *conformanceSeries) conformanceOutput { return out2(nativeHTSINE(s.close)) })
+	add("HT_TRENDLINE", "",
+		func(s *conformanceSeries) conformanceOutput { return out1(taHTTRENDLINE(s.close)) },
+		func(s *conformanceSeries) conformanceOutput { return out1(nativeHTTRENDLINE(s.close)) })
+	add("HT_TRENDMODE", "",
+		func(s *conformanceSeries) conformanceOutput { return outInt(taHTTRENDMODE(s.close)) },
+		func(s *conformanceSeries) conformanceOutput { return outInt(nativeHTTRENDMODE(s.close)) })
+	// MAVP 的周期取自成交量，包含超出 minPeriod..maxPeriod 的值与小数
+	mavpPeriods := func(s *conformanceSeries) []float64 {
+		periods := make([]float64, len(s.volume))
+		for i, v := range s.volume {
+			periods[i] = math.Mod(v, 40) - 2.5
+		}
+		return periods
+	}
+	for _, ps := range [][2]int{{2, 30}, {5, 20}, {20, 5}} {
+		for maType := 0; maType <= 8; maType++ {
+			add("MAVP", fmt.Sprint(ps, maType),
+				func(s *conformanceSeries) conformanceOutput {
+					return out1(taMAVP(s.close, mavpPeriods(s), ps[0], ps[1], maType))
+				},
+				func(s *conformanceSeries) conformanceOutput {
+					return out1(nativeMAVP(s.close, mavpPeriods(s), ps[0], ps[1], maType))
+				})
+		}
+	}
+	for _, p := range cdlPatterns {
+		penetrations := []float64{taRealDefault}
+		if p.hasPenetration() {
+			penetrations = append(penetrations, 0, 0.6)
+		}
+		for _, v := range penetrations {
+			add(p.name, fmt.Sprint(v),
+				func(s *conformanceSeries) conformanceOutput {
+					return outInt(taCDL(p.name, s.open, s.high, s.low, s.close, v))
+				},
+				func(s *conformanceSeries) conformanceOutput {
+					return outInt(nativeCDL(p.name, s.open, s.high, s.low, s.close, v))
+				})
+		}
+	}
+
+	// SuperTrend 不是 TA-Lib 函数，这里比较分别基于两套 ATR 计算出的结果
+	superTrend := func(atr func([]float64, []float64, []float64, int) (int, []float64, error)) conformanceImpl {
+		return func(s *conformanceSeries) conformanceOutput {
+			outBegIdx, output, err := atr(s.high, s.low, s.close, 7)
+			if err != nil {
+				return conformanceOutput{err: err}
+			}
+			st, dir, lower, upper, _ := superTrendFromATR(s.high, s.low, s.close, outBegIdx, output, 7, 3)
+			return conformanceOutput{0, [][]float64{st, dir, lower, upper}, nil}
+		}
+	}
+	add("SuperTrend", "7,3", superTrend(taATR), superTrend(nativeATR))
+
+	return cases
+}
+
+// TestConformanceLookback 比较 TA-Lib 与原生 Go 的回看期，包括无效参数时返回的 -1。
+func TestConformanceLookback(t *testing.T) {
+	check := func(name string, params any, want, got int) {
+		t.Helper()
+		if want != got {
+			t.Errorf("%s %v: TA-Lib lookback %d, native %d", name, params, want, got)
+		}
+	}
+	periods := []int{taIntegerDefault, -1, 0, 1, 2, 14, 30, 100000, 100001}
+	for _, p := range periods {
+		check("RSI", p, taRSILookback(p), nativeRSILookback(p))
+		check("ATR", p, taATRLookback(p), nativeATRLookback(p))
+		check("NATR", p, taNATRLookback(p), nativeNATRLookback(p))
+		check("ADX", p, taADXLookback(p), nativeADXLookback(p))
+		check("LINEARREG", p, taLINEARREGLookback(p), nativeLINEARREGLookback(p))
+		check("LINEARREG_SLOPE", p, taLINEARREGSLOPELookback(p), nativeLINEARREGSLOPELookback(p))
+		check("LINEARREG_ANGLE", p, taLINEARREGANGLELookback(p), nativeLINEARREGANGLELookback(p))
+		check("LINEARREG_INTERCEPT", p, taLINEARREGINTERCEPTLookback(p), nativeLINEARREGINTERCEPTLookback(p))
+		check("TSF", p, taTSFLookback(p), nativeTSFLookback(p))
+		check("STDDEV", p, taSTDDEVLookback(p, 1), nativeSTDDEVLookback(p, 1))
+		check("VAR", p, taVARLookback(p, 1), nativeVARLookback(p, 1))
+		check("CORREL", p, taCORRELLookback(p), nativeCORRELLookback(p))
+		check("BETA", p, taBETALookback(p), nativeBETALookback(p))
+		check("SUM", p, taSUMLookback(p), nativeSUMLookback(p))
+		check("MAX", p, taMAXLookback(p), nativeMAXLookback(p))
+		check("MIN", p, taMINLookback(p), nativeMINLookback(p))
+		check("MINMAX", p, taMINMAXLookback(p), nativeMINMAXLookback(p))
+		check("MAXINDEX", p, taMAXINDEXLookback(p), nativeMAXINDEXLookback(p))
+		check("MININDEX", p, taMININDEXLookback(p), nativeMININDEXLookback(p))
+		check("MINMAXINDEX", p, taMINMAXINDEXLookback(p), nativeMINMAXINDEXLookback(p))
+		check("MIDPOINT", p, taMIDPOINTLookback(p), nativeMIDPOINTLookback(p))
+		check("MIDPRICE", p, taMIDPRICELookback(p), nativeMIDPRICELookback(p))
+		check("MOM", p, taMOMLookback(p), nativeMOMLookback(p))
+		check("ROC", p, taROCLookback(p), nativeROCLookback(p))
+		check("ROCP", p, taROCPLookback(p), nativeROCPLookback(p))
+		check("ROCR", p, taROCRLookback(p), nativeROCRLookback(p))
+		check("ROCR100", p, taROCR100Lookback(p), nativeROCR100Lookback(p))
+		check("CMO", p, taCMOLookback(p), nativeCMOLookback(p))
+		check("TRIX", p, taTRIXLookback(p), nativeTRIXLookback(p))
+		check("CCI", p, taCCILookback(p), nativeCCILookback(p))
+		check("WILLR", p, taWILLRLookback(p), nativeWILLRLookback(p))
+		check("MFI", p, taMFILookback(p), nativeMFILookback(p))
+		check("ULTOSC", [3]int{7, p, 28}, taULTOSCLookback(7, p, 28), nativeULTOSCLookback(7, p, 28))
+		check("ADOSC", [2]int{3, p}, taADOSCLookback(3, p), nativeADOSCLookback(3, p))
+		check("AROON", p, taAROONLookback(p), nativeAROONLookback(p))
+		check("AROONOSC", p, taAROONOSCLookback(p), nativeAROONOSCLookback(p))
+		for maType := -1; maType <= 9; maType++ {
+			check("MA", [2]int{p, maType}, taMALookback(p, maType), nativeMALookback(p, maType))
+			check("APO", [3]int{p, 26, maType}, taAPOLookback(p, 26, maType), nativeAPOLookback(p, 26, maType))
+			check("PPO", [3]int{26, p, maType}, taPPOLookback(26, p, maType), nativePPOLookback(26, p, maType))
+			check("STOCHRSI", [4]int{14, p, 3, maType}, taSTOCHRSILookback(14, p, 3, maType), nativeSTOCHRSILookback(14, p, 3, maType))
+			check("STOCH", [5]int{p, 3, 3, maType, 0}, taSTOCHLookback(p, 3, 3, maType, 0), nativeSTOCHLookback(p, 3, 3, maType, 0))
+			// MAMA 的回看期固定为 32，周期大于 33 时原生实现以标准差的回看期为准，见 nativeBBANDSLookback
+			if maType != 7 || p <= 33 {
+				check("BBANDS", [2]int{p, maType}, taBBandsLookback(p, 2, 2, maType), nativeBBANDSLookback(p, 2, 2, maType))
+			}
+		}
+		for _, q := range []int{1, 9, 26} {
+			check("MACD", [3]int{p, q, 9}, taMACDLookback(p, q, 9), nativeMACDLookback(p, q, 9))
+		}
+	}
+	check("OBV", nil, taOBVLookback(), nativeOBVLookback())
+	check("AD", nil, taADLookback(), nativeADLookback())
+	check("BOP", nil, taBOPLookback(), nativeBOPLookback())
+	check("TRANGE", nil, taTRANGELookback(), nativeTRANGELookback())
+	check("HT_DCPERIOD", nil, taHTDCPERIODLookback(), nativeHTDCPERIODLookback())
+	check("HT_DCPHASE", nil, taHTDCPHASELookback(), nativeHTDCPHASELookback())
+	check("HT_PHASOR", nil, taHTPHASORLookback(), nativeHTPHASORLookback())
+	check("HT_SINE", nil, taHTSINELookback(), nativeHTSINELookback())
+	check("HT_TRENDLINE", nil, taHTTRENDLINELookback(), nativeHTTRENDLINELookback())
+	check("HT_TRENDMODE", nil, taHTTRENDMODELookback(), nativeHTTRENDMODELookback())
+	for _, ps := range [][2]int{{2, 30}, {1, 30}, {2, 1}, {30, 2}} {
+		for maType := -1; maType <= 9; maType++ {
+			check("MAVP", [3]int{ps[0], ps[1], maType}, taMAVPLookback(ps[0], ps[1], maType), nativeMAVPLookback(ps[0], ps[1], maType))
+		}
+	}
+	for _, v := range []float64{taRealDefault, -1, 0, 0.02, taRealMax} {
+		check("SAR", v, taSARLookback(v, 0.2), nativeSARLookback(v, 0.2))
+		check("SAREXT", v, taSAREXTLookback(-v, v, 0.02, 0.02, 0.2, 0.02, v, 0.2), nativeSAREXTLookback(-v, v, 0.02, 0.02, 0.2, 0.02, v, 0.2))
+	}
+	for _, p := range cdlPatterns {
+		for _, v := range []float64{taRealDefault, -1, 0.5} {
+			check(p.name, v, taCDLLookback(p.name, v), nativeCDLLookback(p.name, v))
+		}
+	}
+}
+
+// TestConformanceSettings 在设置了不稳定期或 MetaStock 兼容模式后重新比较两套实现。
+func TestConformanceSettings(t *testing.T) {
+	for _, setting := range []struct {
+		name  string
+		apply func() error
+	}{
+		{"unstable", func() error { return SetUnstablePeriod(FuncUnstAll, 7) }},
+		{"metastock", func() error { return SetCompatibility(CompatibilityMetastock) }},
+		{"both", func() error {
+			if err := SetUnstablePeriod(FuncUnstAll, 3); err != nil {
+				return err
+			}
+			return SetCompatibility(CompatibilityMetastock)
+		}},
+		{"candles", func() error {
+			s := DefaultCandleSettings()
+			s[CandleBodyLong] = CandleSetting{RangeType: CandleRangeHighLow, AvgPeriod: 20, Factor: 0.5}
+			s[CandleBodyDoji].Factor = 0.05
+			s[CandleShadowLong].AvgPeriod = 5
+			s[CandleShadowVeryShort] = CandleSetting{RangeType: CandleRangeShadows, AvgPeriod: 3, Factor: 0.2}
+			s[CandleNear].Factor = 0.5
+			s[CandleEqual].AvgPeriod = 0
+			return SetCandleSettings(s)
+		}},
+	} {
+		t.Run(setting.name, func(t *testing.T) {
+			t.Cleanup(func() { Initialize() })
+			if err := setting.apply(); err != nil {
+				t.Fatal(err)
+			}
+			runConformance(t, 1, talibNativeCases())
+			for _, p := range []int{1, 2, 14} {
+				if want, got := taRSILookback(p), nativeRSILookback(p); want != got {
+					t.Errorf("RSI %d: TA-Lib lookback %d, native %d", p, want, got)
+				}
+				for maType := 0; maType <= 8; maType++ {
+					if want, got := taMALookback(p, maType), nativeMALookback(p, maType); want != got {
+						t.Errorf("MA %d,%d: TA-Lib lookback %d, native %d", p, maType, want, got)
+					}
+				}
+			}
+			for _, p := range cdlPatterns {
+				if want, got := taCDLLookback(p.name, taRealDefault), nativeCDLLookback(p.name, taRealDefault); want != got {
+					t.Errorf("%s: TA-Lib lookback %d, native %d", p.name, want, got)
+				}
+			}
+		})
+	}
+}
+
+// TestConformanceAbstract 检查原生函数表与 TA-Lib 抽象接口给出的描述一致，并比较两者按名称调用的结果。
+func TestConformanceAbstract(t *testing.T) {
+	var cases []conformanceCase
+	for _, name := range nativeFuncNames() {
+		info, err := taFuncInfo(name)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		native, _ := nativeFuncInfo(name)
+		if !reflect.DeepEqual(info, native) {
+			t.Errorf("%s: TA-Lib info %+v, native %+v", name, info, native)
+			continue
+		}
+		call := func(call func(*FuncInfo, [][]float64, []float64) (int, [][]float64, error)) conformanceImpl {
+			return func(s *conformanceSeries) conformanceOutput {
+				inputs := map[string][]float64{"open": s.open, "high": s.high, "low": s.low, "close": s.close, "volume": s.volume,
+					"real0": s.close, "real1": s.open, "periods": s.low}
+				columns, _, _ := info.resolveInputs(name, inputs)
+				params, _ := info.resolveParams(name, nil)
+				begIdx, outputs, err := call(info, columns, params)
+				return conformanceOutput{begIdx, outputs, err}
+			}
+		}
+		cases = append(cases, conformanceCase{"Call " + name, "", call(taCall), call(nativeCall)})
+	}
+	for _, seed := range []int64{1, 2} {
+		runConformance(t, seed, cases)
+	}
+}
@@ -0,0 +1,206 @@
+package go4ta
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// 一致性测试工具：用随机生成的 OHLCV 序列驱动两套实现（例如 TA-Lib 与原生 Go），
+// 逐点比较输出，报告第一个出现偏差的下标、参数组合以及最大绝对/相对误差。
+
+// conformanceTolerance 是两套实现之间允许的误差，按 max(1, |want|) 缩放。
+const conformanceTolerance = 1e-9
+
+// conformanceSeries 是一组用于比较的 OHLCV 序列。
+type conformanceSeries struct {
+	name                           string
+	open, high, low, close, volume []float64
+}
+
+// conformanceOutput 是一次计算的结果：第一个有效值的下标以及各条紧凑排列的输出。
+type conformanceOutput struct {
+	begIdx  int
+	outputs [][]float64
+	err     error
+}
+
+// conformanceImpl 以某一种实现计算指标。
+type conformanceImpl func(s *conformanceSeries) conformanceOutput
+
+// conformanceCase 描述一个指标在某组参数下的两套实现。
+type conformanceCase struct {
+	name   string
+	params string
+	want   conformanceImpl
+	got    conformanceImpl
+}
+
+func out1(begIdx int, a []float64, err error) conformanceOutput {
+	return conformanceOutput{begIdx, [][]float64{a}, err}
+}
+
+func out2(begIdx int, a, b []float64, err error) conformanceOutput {
+	return conformanceOutput{begIdx, [][]float64{a, b}, err}
+}
+
+func out3(begIdx int, a, b, c []float64, err error) conformanceOutput {
+	return conformanceOutput{begIdx, [][]float64{a, b, c}, err}
+}
+
+// outInt 将K线形态的整型输出转换为 conformanceOutput。
+func outInt(begIdx int, a []int, err error) conformanceOutput {
+	return out1(begIdx, intsToFloats(a), err)
+}
+
+// conformanceSeriesSet 生成覆盖趋势、横盘、跳空、极小与极大数量级的随机序列。
+func conformanceSeriesSet(seed int64) []*conformanceSeries {
+	rng := rand.New(rand.NewSource(seed))
+	var set []*conformanceSeries
+	for _, n := range []int{1, 2, 7, 40, 300} {
+		set = append(set,
+			randomWalk(rng, fmt.Sprintf("trending/n=%d", n), n, 100, 0.002, 0.01, 0),
+			flatSeries(fmt.Sprintf("flat/n=%d", n), n, 50),
+			randomWalk(rng, fmt.Sprintf("gappy/n=%d", n), n, 100, 0, 0.01, 0.1),
+			randomWalk(rng, fmt.Sprintf("tiny/n=%d", n), n, 1e-6, 0, 0.02, 0),
+			randomWalk(rng, fmt.Sprintf("huge/n=%d", n), n, 1e9, -0.001, 0.02, 0),
+		)
+	}
+	return set
+}
+
+// randomWalk 生成几何随机游走，drift 为每根价格柱的漂移，gapProb 为出现 ±20% 跳空的概率。
+func randomWalk(rng *rand.Rand, name string, n int, start, drift, vol, gapProb float64) *conformanceSeries {
+	s := &conformanceSeries{name: name}
+	price := start
+	for i := 0; i < n; i++ {
+		open := price
+		if rng.Float64() < gapProb {
+			open *= 1 + 0.2*(2*rng.Float64()-1)
+		}
+		closeP := open * (1 + drift + vol*rng.NormFloat64())
+		high := math.Max(open, closeP) * (1 + vol*rng.Float64())
+		low := math.Min(open, closeP) * (1 - vol*rng.Float64())
+		s.open = append(s.open, open)
+		s.high = append(s.high, high)
+		s.low = append(s.low, low)
+		s.close = append(s.close, closeP)
+		s.volume = append(s.volume, math.Floor(start*1000*rng.Float64()))
+		price = closeP
+	}
+	return s
+}
+
+// flatSeries 生成价格完全不变的序列，用于覆盖除零等边界分支。
+func flatSeries(name string, n int, price float64) *conformanceSeries {
+	s := &conformanceSeries{name: name}
+	for i := 0; i < n; i++ {
+		s.open = append(s.open, price)
+		s.high = append(s.high, price)
+		s.low = append(s.low, price)
+		s.close = append(s.close, price)
+		s.volume = append(s.volume, 1000)
+	}
+	return s
+}
+
+// divergence 记录一条输出上两套实现的差异。
+type divergence struct {
+	firstIdx  int // 第一个超出容差的下标，-1 表示没有
+	got, want float64
+	maxAbs    float64
+	maxRel    float64
+}
+
+// compareSeries 逐点比较两条输出，两边同为 NaN 视为相等。
+func compareSeries(got, want []float64, tol float64) divergence {
+	d := divergence{firstIdx: -1}
+	for i := range want {
+		g, w := got[i], want[i]
+		if math.IsNaN(g) && math.IsNaN(w) {
+			continue
+		}
+		abs := math.Abs(g - w)
+		if math.IsNaN(g) != math.IsNaN(w) {
+			abs = math.Inf(1)
+		}
+		rel := abs / math.Max(math.Abs(w), math.SmallestNonzeroFloat64)
+		d.maxAbs = math.Max(d.maxAbs, abs)
+		d.maxRel = math.Max(d.maxRel, rel)
+		if d.firstIdx < 0 && abs > tol*math.Max(1, math.Abs(w)) {
+			d.firstIdx = i
+			d.got, d.want = g, w
+		}
+	}
+	return d
+}
+
+// runConformance 在所有随机序列上比较每个用例的两套实现。
+func runConformance(t *testing.T, seed int64, cases []conformanceCase) {
+	t.Helper()
+	for _, s := range conformanceSeriesSet(seed) {
+		for _, c := range cases {
+			if msg := checkConformance(s, c); msg != "" {
+				t.Errorf("%s(%s) on %s (seed=%d): %s", c.name, c.params, s.name, seed, msg)
+			}
+		}
+	}
+}
+
+// checkConformance 比较单个用例，返回空字符串表示一致，否则返回差异描述。
+func checkConformance(s *conformanceSeries, c conformanceCase) string {
+	want := c.want(s)
+	got := c.got(s)
+	if (want.err == nil) != (got.err == nil) {
+		return fmt.Sprintf("error mismatch: want %v, got %v", want.err, got.err)
+	}
+	if want.err != nil {
+		return ""
+	}
+	if want.begIdx != got.begIdx {
+		return fmt.Sprintf("begIdx mismatch: want %d, got %d", want.begIdx, got.begIdx)
+	}
+
+	var msgs []string
+	for k := range want.outputs {
+		if len(want.outputs[k]) != len(got.outputs[k]) {
+			msgs = append(msgs, fmt.Sprintf("output #%d length mismatch: want %d, got %d", k, len(want.outputs[k]), len(got.outputs[k])))
+			continue
+		}
+		d := compareSeries(got.outputs[k], want.outputs[k], conformanceTolerance)
+		if d.firstIdx >= 0 {
+			msgs = append(msgs, fmt.Sprintf("output #%d first diverges at index %d (want %.17g, got %.17g), max abs err %.3g, max rel err %.3g",
+				k, want.begIdx+d.firstIdx, d.want, d.got, d.maxAbs, d.maxRel))
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func TestConformanceHarness(t *testing.T) {
+	// 两套相同的实现不应报告差异
+	same := func(s *conformanceSeries) conformanceOutput { return out1(nativeMA(s.close, 5, 0)) }
+	for _, s := range conformanceSeriesSet(1) {
+		if msg := checkConformance(s, conformanceCase{"MA", "5,SMA", same, same}); msg != "" {
+			t.Errorf("相同实现在 %s 上报告了差异: %s", s.name, msg)
+		}
+	}
+
+	// 人为制造偏差，检查报告的下标与误差
+	want := []float64{1, 2, math.NaN(), 4, 5}
+	got := []float64{1, 2, math.NaN(), 4.5, 5.001}
+	d := compareSeries(got, want, conformanceTolerance)
+	if d.firstIdx != 3 {
+		t.Errorf("第一个偏差下标 期望3, 实际%d", d.firstIdx)
+	}
+	if math.Abs(d.maxAbs-0.5) > 1e-12 || math.Abs(d.maxRel-0.125) > 1e-12 {
+		t.Errorf("最大误差 期望abs=0.5 rel=0.125, 实际abs=%g rel=%g", d.maxAbs, d.maxRel)
+	}
+
+	shifted := func(s *conformanceSeries) conformanceOutput { return out1(nativeMA(s.close, 6, 0)) }
+	s := conformanceSeriesSet(1)[len(conformanceSeriesSet(1))-1]
+	if msg := checkConformance(s, conformanceCase{"MA", "5/6", same, shifted}); !strings.Contains(msg, "begIdx mismatch") {
+		t.Errorf("期望报告 begIdx 不一致, 实际: %q", msg)
+	}
+}
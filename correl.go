@@ -0,0 +1,43 @@
+package go4ta
+
+// CORREL 计算两条序列在滑动窗口内的皮尔逊相关系数（Pearson's Correlation Coefficient），取值在 -1 到 1 之间，
+// 常用于配对交易中检验两个品种的价格是否同步。窗口内任一序列不变时结果为0。
+//
+// @param real0      - 第一条序列，如品种A的收盘价
+// @param real1      - 第二条序列，如品种B的收盘价，与 real0 等长
+// @param timePeriod - 计算周期（如30）
+// @return []float64 - 相关系数序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func CORREL(real0, real1 []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("CORREL", "real0, real1", real0, real1)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("CORREL", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("CORREL", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taCORREL(real0, real1, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// CORRELLookback 返回 CORREL 在给定参数下的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func CORRELLookback(timePeriod int) (int, error) {
+	_, err := correlParams(timePeriod)
+	return lookbackResult("TA_CORREL", taCORRELLookback(timePeriod), err)
+}
@@ -0,0 +1,48 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taCORREL 调用 TA_CORREL。
+func taCORREL(real0, real1 []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	cReal0 := (*C.double)(unsafe.Pointer(&real0[0]))
+	cReal1 := (*C.double)(unsafe.Pointer(&real1[0]))
+	output := make([]C.double, len(real0))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_CORREL(
+		0,
+		C.int(len(real0)-1),
+		cReal0,
+		cReal1,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := correlParams(timePeriod)
+		return 0, nil, taErr("TA_CORREL", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taCORRELLookback 调用 TA_CORREL_Lookback，参数无效时返回 -1。
+func taCORRELLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_CORREL_Lookback(C.int(timePeriod)))
+}
@@ -0,0 +1,82 @@
+package go4ta
+
+import "math"
+
+// nativeCORREL 是 TA_CORREL 的原生实现。
+func nativeCORREL(real0, real1 []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := correlParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intCORREL(real0, real1, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeCORRELLookback 对应 TA_CORREL_Lookback，参数无效时返回 -1。
+func nativeCORRELLookback(timePeriod int) int {
+	timePeriod, err := correlParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// correlParams 按 TA_CORREL 的规则处理参数。
+func correlParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_CORREL"}
+	timePeriod = c.integer("timePeriod", timePeriod, 30, 1, 100000)
+	return timePeriod, c.err
+}
+
+// intCORREL 对应 TA_CORREL：滑动窗口内维护 x、y、x²、y²、xy 的和，窗口方差不为正时输出0。
+func intCORREL(in0, in1 []float64, timePeriod int) (int, []float64) {
+	startIdx := timePeriod - 1
+	if startIdx > len(in0)-1 {
+		return 0, nil
+	}
+
+	output := make([]float64, 0, len(in0)-startIdx)
+	period := float64(timePeriod)
+	sumXY, sumX, sumY, sumX2, sumY2 := 0.0, 0.0, 0.0, 0.0, 0.0
+	today, trailingIdx := 0, 0
+	for ; today <= startIdx; today++ {
+		x, y := in0[today], in1[today]
+		sumX += x
+		sumX2 += x * x
+		sumXY += x * y
+		sumY += y
+		sumY2 += y * y
+	}
+
+	emit := func() {
+		tempReal := (sumX2 - ((sumX * sumX) / period)) * (sumY2 - ((sumY * sumY) / period))
+		if !isZeroOrNeg(tempReal) {
+			output = append(output, (sumXY-((sumX*sumY)/period))/math.Sqrt(tempReal))
+		} else {
+			output = append(output, 0.0)
+		}
+	}
+
+	trailingX, trailingY := in0[trailingIdx], in1[trailingIdx]
+	trailingIdx++
+	emit()
+	for ; today < len(in0); today++ {
+		sumX -= trailingX
+		sumX2 -= trailingX * trailingX
+		sumXY -= trailingX * trailingY
+		sumY -= trailingY
+		sumY2 -= trailingY * trailingY
+
+		x, y := in0[today], in1[today]
+		sumX += x
+		sumX2 += x * x
+		sumXY += x * y
+		sumY += y
+		sumY2 += y * y
+
+		trailingX, trailingY = in0[trailingIdx], in1[trailingIdx]
+		trailingIdx++
+		emit()
+	}
+	return startIdx, output
+}
@@ -0,0 +1,37 @@
+package go4ta
+
+// DEMA 计算双指数移动平均线（DEMA），与 MA(close, timePeriod, MATypeDEMA) 相同，但周期须不小于2。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如30）
+// @return []float64 - DEMA结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func DEMA(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("DEMA", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("DEMA", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taDEMA(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// DEMALookback 返回 DEMA 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func DEMALookback(timePeriod int) (int, error) {
+	_, err := demaParams(timePeriod)
+	return lookbackResult("TA_DEMA", taDEMALookback(timePeriod), err)
+}
@@ -0,0 +1,27 @@
+package go4ta
+
+// nativeDEMA 是 TA_DEMA 的原生实现，计算见 ma_native.go 中的 intDEMA。
+func nativeDEMA(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := demaParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intDEMA(close, 0, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeDEMALookback 对应 TA_DEMA_Lookback，参数无效时返回 -1。
+func nativeDEMALookback(timePeriod int) int {
+	timePeriod, err := demaParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return emaLookback(timePeriod) * 2
+}
+
+// demaParams 按 TA_DEMA 的规则处理参数。
+func demaParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_DEMA"}
+	timePeriod = c.integer("timePeriod", timePeriod, 30, 2, 100000)
+	return timePeriod, c.err
+}
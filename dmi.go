@@ -0,0 +1,70 @@
+package go4ta
+
+// DMI 一次计算趋向指标系统的全部输出，按位置返回，结果含义见 CalcDMI。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return adx, adxr, plusDI, minusDI - 四个结果序列，填充方式见 CalcDMI
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func DMI(high, low, close []float64, timePeriod int) (adx, adxr, plusDI, minusDI []float64, err error) {
+	r, err := CalcDMI(high, low, close, timePeriod)
+	return r.ADX, r.ADXR, r.PlusDI, r.MinusDI, err
+}
+
+// CalcDMI 一次计算趋向指标系统（DMI）的 ADX、ADXR、+DI 与 -DI，结果分别与 ADX、ADXR、PlusDI、MinusDI 相同。
+// 纯 Go 构建时只遍历一次输入；各输出的回看期不同，+DI 与 -DI 最短，ADXR 最长，
+// FillTrim 下四条输出都从 ADXR 的第一个有效值开始。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期，不小于2
+// @return DMIResult - 四个等长的结果序列，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func CalcDMI(high, low, close []float64, timePeriod int) (DMIResult, error) {
+	n, err := checkInputs("DMI", "high, low, close", high, low, close)
+	if err != nil {
+		return DMIResult{}, err
+	}
+	if n == 0 {
+		return DMIResult{[]float64{}, []float64{}, []float64{}, []float64{}}, nil
+	}
+	if n < timePeriod {
+		return DMIResult{}, tooShort("DMI", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("DMI", float64(timePeriod)); err != nil {
+		return DMIResult{}, err
+	}
+
+	out, err := taDMI(high, low, close, timePeriod)
+	if err != nil {
+		return DMIResult{}, err
+	}
+
+	// FillTrim 下各输出都从回看期最长的 ADXR 开始，四条输出等长
+	alignIdx := out.ADXRBegIdx
+	if len(out.ADXR) == 0 {
+		alignIdx = n
+	}
+	w := newWarmup(0)
+	return DMIResult{
+		ADX:     w.spreadAligned(n, out.ADXBegIdx, alignIdx, out.ADX),
+		ADXR:    w.spreadAligned(n, out.ADXRBegIdx, alignIdx, out.ADXR),
+		PlusDI:  w.spreadAligned(n, out.PlusDIBegIdx, alignIdx, out.PlusDI),
+		MinusDI: w.spreadAligned(n, out.MinusDIBegIdx, alignIdx, out.MinusDI),
+	}, nil
+}
+
+// DMILookback 返回 DMI 中 ADXR 的回看期，即所有输出都有效之前的价格柱个数；
+// 其余输出的回看期见 ADXLookback、PlusDILookback、MinusDILookback。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func DMILookback(timePeriod int) (int, error) {
+	_, err := dmiParams(timePeriod)
+	return lookbackResult("DMI", taDMILookback(timePeriod), err)
+}
@@ -0,0 +1,63 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taDMI 依次调用 TA_ADX、TA_ADXR、TA_PLUS_DI 与 TA_MINUS_DI，整个过程只读取一次全局设置。
+func taDMI(high, low, close []float64, timePeriod int) (dmiOutput, error) {
+	defer readSettings()()
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	endIdx := C.int(len(high) - 1)
+	period := C.int(timePeriod)
+
+	var err error
+	// call 调用其中一个函数，出错后不再调用后续函数
+	call := func(fn string, ta func(outBegIdx, outNBElement *C.int, out *C.double) C.TA_RetCode) (int, []float64) {
+		if err != nil {
+			return 0, nil
+		}
+		output := make([]C.double, len(high))
+		outBegIdx := C.int(0)
+		outNBElement := C.int(0)
+		if retCode := ta(&outBegIdx, &outNBElement, &output[0]); retCode != C.TA_SUCCESS {
+			_, paramErr := dmiParams(timePeriod)
+			err = taErr(fn, RetCode(retCode), paramErr)
+			return 0, nil
+		}
+		return int(outBegIdx), fromC(output, outNBElement)
+	}
+
+	var out dmiOutput
+	out.ADXBegIdx, out.ADX = call("TA_ADX", func(b, n *C.int, o *C.double) C.TA_RetCode {
+		return C.TA_ADX(0, endIdx, cHigh, cLow, cClose, period, b, n, o)
+	})
+	out.ADXRBegIdx, out.ADXR = call("TA_ADXR", func(b, n *C.int, o *C.double) C.TA_RetCode {
+		return C.TA_ADXR(0, endIdx, cHigh, cLow, cClose, period, b, n, o)
+	})
+	out.PlusDIBegIdx, out.PlusDI = call("TA_PLUS_DI", func(b, n *C.int, o *C.double) C.TA_RetCode {
+		return C.TA_PLUS_DI(0, endIdx, cHigh, cLow, cClose, period, b, n, o)
+	})
+	out.MinusDIBegIdx, out.MinusDI = call("TA_MINUS_DI", func(b, n *C.int, o *C.double) C.TA_RetCode {
+		return C.TA_MINUS_DI(0, endIdx, cHigh, cLow, cClose, period, b, n, o)
+	})
+	if err != nil {
+		return dmiOutput{}, err
+	}
+	return out, nil
+}
+
+// taDMILookback 调用 TA_ADXR_Lookback，即 DMI 中回看期最长的输出的回看期，参数无效时返回 -1。
+func taDMILookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_ADXR_Lookback(C.int(timePeriod)))
+}
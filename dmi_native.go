@@ -0,0 +1,60 @@
+package go4ta
+
+// dmiOutput 是 taDMI 的结果，各输出从各自第一个有效值的下标起紧凑排列，没有有效值时下标为0。
+type dmiOutput struct {
+	ADXBegIdx, ADXRBegIdx, PlusDIBegIdx, MinusDIBegIdx int
+	ADX, ADXR, PlusDI, MinusDI                         []float64
+}
+
+// nativeDMI 一次遍历同时计算 TA_ADX、TA_ADXR、TA_PLUS_DI 与 TA_MINUS_DI，结果与分别计算相同。
+func nativeDMI(high, low, close []float64, timePeriod int) (dmiOutput, error) {
+	timePeriod, err := dmiParams(timePeriod)
+	if err != nil {
+		return dmiOutput{}, err
+	}
+
+	var out dmiOutput
+	plusDIBegIdx, minusDIBegIdx, adxBegIdx := plusDILookback(timePeriod), minusDILookback(timePeriod), adxLookback(timePeriod)
+	s := newADXState(timePeriod)
+	for today := range high {
+		adx, ok := s.update(high[today], low[today], close[today])
+		plusDI, minusDI := s.DM.di()
+		if today >= plusDIBegIdx {
+			out.PlusDI = append(out.PlusDI, plusDI)
+		}
+		if today >= minusDIBegIdx {
+			out.MinusDI = append(out.MinusDI, minusDI)
+		}
+		if ok && today >= adxBegIdx {
+			out.ADX = append(out.ADX, adx)
+		}
+	}
+
+	if out.PlusDI != nil {
+		out.PlusDIBegIdx = plusDIBegIdx
+	}
+	if out.MinusDI != nil {
+		out.MinusDIBegIdx = minusDIBegIdx
+	}
+	if out.ADX != nil {
+		out.ADXBegIdx = adxBegIdx
+	}
+	out.ADXRBegIdx, out.ADXR = intADXR(out.ADXBegIdx, out.ADX, timePeriod)
+	return out, nil
+}
+
+// nativeDMILookback 返回 DMI 中回看期最长的输出 ADXR 的回看期，参数无效时返回 -1。
+func nativeDMILookback(timePeriod int) int {
+	timePeriod, err := dmiParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return adxrLookback(timePeriod)
+}
+
+// dmiParams 处理 DMI 的参数，取值范围与 TA_ADX、TA_ADXR 相同。
+func dmiParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "DMI"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,147 @@
+package go4ta
+
+import (
+	"encoding/csv"
+	"errors"
+	"math"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestDI(t *testing.T) {
+	file, err := os.Open("test_data/adx.csv")
+	if err != nil {
+		t.Fatalf("Failed to open test data file: %v", err)
+	}
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to read CSV data: %v", err)
+	}
+
+	// 跳过表头；+DI、-DI 在第4、5列（下标4、5），前若干行为空
+	records = records[1:]
+	var high, low, closeP []float64
+	for _, record := range records {
+		h, _ := strconv.ParseFloat(record[0], 64)
+		l, _ := strconv.ParseFloat(record[1], 64)
+		c, _ := strconv.ParseFloat(record[2], 64)
+		high = append(high, h)
+		low = append(low, l)
+		closeP = append(closeP, c)
+	}
+
+	plusDI, err := PlusDI(high, low, closeP, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minusDI, err := MinusDI(high, low, closeP, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 样本数据保留两位小数，且各步中间结果也经过舍入
+	const tolerance = 0.03
+	for i, record := range records {
+		for col, got := range map[int]float64{4: plusDI[i], 5: minusDI[i]} {
+			if record[col] == "" {
+				continue
+			}
+			want, _ := strconv.ParseFloat(record[col], 64)
+			if math.Abs(got-want) > tolerance {
+				t.Errorf("column %d [%d] expected ~%f, got %f", col, i, want, got)
+			}
+		}
+	}
+}
+
+func TestDMI(t *testing.T) {
+	b := testBars()
+	r, err := CalcDMI(b.High, b.Low, b.Close, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 一次计算的结果与分别计算相同
+	for _, c := range []struct {
+		name string
+		got  []float64
+		f    func(high, low, close []float64, timePeriod int) ([]float64, error)
+	}{
+		{"ADX", r.ADX, ADX},
+		{"ADXR", r.ADXR, ADXR},
+		{"PlusDI", r.PlusDI, PlusDI},
+		{"MinusDI", r.MinusDI, MinusDI},
+	} {
+		want, err := c.f(b.High, b.Low, b.Close, 14)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !equalFloats(c.got, want) {
+			t.Errorf("CalcDMI %s differs from %s", c.name, c.name)
+		}
+	}
+
+	// ADXR 是当前 ADX 与 timePeriod-1 根之前 ADX 的平均
+	lookback, err := DMILookback(14)
+	if err != nil || lookback != 40 {
+		t.Fatalf("DMILookback(14) = %d, %v, want 40", lookback, err)
+	}
+	if adxr, _ := ADXRLookback(14); adxr != lookback {
+		t.Errorf("ADXRLookback(14) = %d, want %d", adxr, lookback)
+	}
+	for i := lookback; i < b.Len(); i++ {
+		if want := (r.ADX[i] + r.ADX[i-13]) / 2; math.Abs(r.ADXR[i]-want) > 1e-9 {
+			t.Errorf("ADXR[%d] = %f, want %f", i, r.ADXR[i], want)
+		}
+	}
+
+	// DX 经 Wilder 平滑即为 ADX，两者都在 [0, 100] 内，+DM 与 -DM 非负
+	dx, err := DX(b.High, b.Low, b.Close, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plusDM, err := PlusDM(b.High, b.Low, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minusDM, err := MinusDM(b.High, b.Low, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range dx {
+		if dx[i] < 0 || dx[i] > 100 || plusDM[i] < 0 || minusDM[i] < 0 {
+			t.Errorf("[%d] DX %f, +DM %f, -DM %f out of range", i, dx[i], plusDM[i], minusDM[i])
+		}
+	}
+
+	// 周期为1时 +DM 即单根的正向变动
+	dm1, err := PlusDM(b.High, b.Low, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < b.Len(); i++ {
+		up, down := b.High[i]-b.High[i-1], b.Low[i-1]-b.Low[i]
+		want := 0.0
+		if up > 0 && up > down {
+			want = up
+		}
+		if math.Abs(dm1[i]-want) > 1e-9 {
+			t.Errorf("PlusDM(1)[%d] = %f, want %f", i, dm1[i], want)
+		}
+	}
+
+	var fe *FuncError
+	if _, err := DX(b.High, b.Low, b.Close, 1); !errors.As(err, &fe) || fe.Func != "TA_DX" || fe.Param != "timePeriod" {
+		t.Errorf("DX(1) = %v", err)
+	}
+	if _, err := CalcDMI(b.High, b.Low, b.Close, 1); !errors.As(err, &fe) || fe.Func != "DMI" {
+		t.Errorf("CalcDMI(1) = %v", err)
+	}
+	if _, err := CalcDMI(b.High[:3], b.Low[:3], b.Close[:3], 14); err == nil {
+		t.Error("CalcDMI accepted input shorter than timePeriod")
+	}
+	if r, err := CalcDMI(nil, nil, nil, 14); err != nil || r.Len() != 0 {
+		t.Errorf("CalcDMI(empty) = %v, %v", r, err)
+	}
+}
@@ -0,0 +1,61 @@
+package go4ta
+
+// Donchian 计算唐奇安通道（Donchian Channels），按位置返回各输出，结果含义见 CalcDonchian。
+//
+// @param high, low  - 价格序列
+// @param timePeriod - 计算周期（如20）
+// @return upper, middle, lower - 三个与输入等长的结果序列
+// @return error     - 如果输入数据无效，则返回错误。
+func Donchian(high, low []float64, timePeriod int) (upper, middle, lower []float64, err error) {
+	r, err := CalcDonchian(high, low, timePeriod)
+	return r.Upper, r.Middle, r.Lower, err
+}
+
+// CalcDonchian 计算唐奇安通道：上轨为最近 timePeriod 根（含当根）的最高价，下轨为最低价，中轨为两者的平均。
+//
+// @param high, low      - 价格序列
+// @param timePeriod     - 计算周期（如20）
+// @return DonchianResult - 上、中、下轨，与输入等长，前 timePeriod-1 根按 SetFillPolicy 的设置填充，默认为0。
+// @return error         - 如果输入数据无效，则返回错误。
+func CalcDonchian(high, low []float64, timePeriod int) (DonchianResult, error) {
+	n, err := checkInputs("Donchian", "high, low", high, low)
+	if err != nil {
+		return DonchianResult{}, err
+	}
+	if n == 0 {
+		return DonchianResult{[]float64{}, []float64{}, []float64{}}, nil
+	}
+	if n < timePeriod {
+		return DonchianResult{}, tooShort("Donchian", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("DONCHIAN", float64(timePeriod)); err != nil {
+		return DonchianResult{}, err
+	}
+
+	begIdx, outUpper := rollingHighest(high, timePeriod)
+	_, outLower := rollingLowest(low, timePeriod)
+	outMiddle := make([]float64, len(outUpper))
+	for i := range outUpper {
+		outMiddle[i] = (outUpper[i] + outLower[i]) / 2
+	}
+
+	w := newWarmup(0)
+	return DonchianResult{
+		Upper:  w.spread(n, begIdx, outUpper),
+		Middle: w.spread(n, begIdx, outMiddle),
+		Lower:  w.spread(n, begIdx, outLower),
+	}, nil
+}
+
+// DonchianLookback 返回 Donchian 的回看期 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func DonchianLookback(timePeriod int) (int, error) {
+	if timePeriod < 1 || timePeriod > 100000 {
+		return 0, badParam("Donchian", "timePeriod", timePeriod)
+	}
+	return timePeriod - 1, nil
+}
@@ -0,0 +1,44 @@
+package go4ta
+
+// DX 计算动向指数（DX），对应 TA-Lib 的 DX。
+// DX = 100 * |+DI - -DI| / (+DI + -DI)，+DI 与 -DI 之和为零时沿用前一个值。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - DX结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func DX(high, low, close []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("DX", "high, low, close", high, low, close)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("DX", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("DX", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taDX(high, low, close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// DXLookback 返回 DX 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func DXLookback(timePeriod int) (int, error) {
+	_, err := dxParams(timePeriod)
+	return lookbackResult("TA_DX", taDXLookback(timePeriod), err)
+}
@@ -0,0 +1,54 @@
+package go4ta
+
+// nativeDX 是 TA_DX 的原生实现。
+func nativeDX(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := dxParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intDX(high, low, close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+func dxLookback(timePeriod int) int {
+	return timePeriod + unstablePeriod(FuncUnstDX)
+}
+
+func intDX(high, low, close []float64, timePeriod int) (int, []float64) {
+	startIdx := dxLookback(timePeriod)
+	if startIdx > len(high)-1 {
+		return 0, nil
+	}
+
+	s := newDMState(timePeriod)
+	output := make([]float64, 0, len(high)-startIdx)
+	for today := range high {
+		s.update(high[today], low[today], close[today])
+		if today < startIdx {
+			continue
+		}
+		// 无法计算时第一个输出为0，之后沿用前一个输出
+		dx, ok := s.dx()
+		if !ok && len(output) > 0 {
+			dx = output[len(output)-1]
+		}
+		output = append(output, dx)
+	}
+	return startIdx, output
+}
+
+// nativeDXLookback 对应 TA_DX_Lookback，参数无效时返回 -1。
+func nativeDXLookback(timePeriod int) int {
+	timePeriod, err := dxParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return dxLookback(timePeriod)
+}
+
+// dxParams 按 TA_DX 的规则处理参数。
+func dxParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_DX"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
@@ -1,5 +1,10 @@
 package go4ta
 
 func EMA(close []float64, timePeriod int) ([]float64, error) {
-	return MA(close, timePeriod, 1)
+	return MA(close, timePeriod, MATypeEMA)
+}
+
+// EMALookback 返回 EMA 的回看期，等同于 MALookback(timePeriod, MATypeEMA)。
+func EMALookback(timePeriod int) (int, error) {
+	return MALookback(timePeriod, MATypeEMA)
 }
@@ -0,0 +1,57 @@
+package go4ta
+
+// EMV 计算简易波动指标（Ease of Movement）：每根中点价 (high+low)/2 的变动乘以振幅再除以成交量，
+// 乘以 divisor 调整量级后取 timePeriod 根的简单平均。价格上涨所需的成交量越少，数值越大。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param volume     - 成交量序列
+// @param timePeriod - 平均周期（如14），为1时即每根的原始值
+// @param divisor    - 成交量的缩放系数（如10000），按成交量的量级选取
+// @return []float64 - EMV 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0；成交量为0的一根取0。
+// @return error     - 如果输入数据无效或计算失败，则返回错误。
+func EMV(high, low, volume []float64, timePeriod int, divisor float64) ([]float64, error) {
+	n, err := checkInputs("EMV", "high, low, volume", high, low, volume)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("EMV", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("EMV", float64(timePeriod), divisor); err != nil {
+		return nil, err
+	}
+	if n < 2 {
+		return newWarmup(0).spread(n, 0, nil), nil
+	}
+
+	emv := make([]float64, n-1)
+	for i := range emv {
+		if v := volume[i+1]; v > 0 {
+			moved := (high[i+1]+low[i+1])/2 - (high[i]+low[i])/2
+			emv[i] = divisor * moved * (high[i+1] - low[i+1]) / v
+		}
+	}
+	smaBegIdx, output, err := taMA(emv, timePeriod, int(MATypeSMA))
+	if err != nil {
+		return nil, err
+	}
+
+	return newWarmup(0).spread(n, smaBegIdx+1, output), nil
+}
+
+// EMVLookback 返回 EMV 的回看期，即 timePeriod。
+//
+// @param timePeriod - 平均周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func EMVLookback(timePeriod int) (int, error) {
+	if timePeriod < 1 || timePeriod > 100000 {
+		return 0, badParam("EMV", "timePeriod", timePeriod)
+	}
+	return timePeriod, nil
+}
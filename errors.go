@@ -0,0 +1,210 @@
+package go4ta
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RetCode 对应 TA-Lib 的 TA_RetCode（ta_defs.h），原生实现沿用同样的编码。
+// RetCode 本身也实现了 error，可以直接用于 errors.Is，例如 errors.Is(err, RetBadParam)。
+type RetCode int
+
+const (
+	RetSuccess                RetCode = 0
+	RetLibNotInitialize       RetCode = 1
+	RetBadParam               RetCode = 2
+	RetAllocErr               RetCode = 3
+	RetGroupNotFound          RetCode = 4
+	RetFuncNotFound           RetCode = 5
+	RetInvalidHandle          RetCode = 6
+	RetInvalidParamHolder     RetCode = 7
+	RetInvalidParamHolderType RetCode = 8
+	RetInvalidParamFunction   RetCode = 9
+	RetInputNotAllInitialize  RetCode = 10
+	RetOutputNotAllInitialize RetCode = 11
+	RetOutOfRangeStartIndex   RetCode = 12
+	RetOutOfRangeEndIndex     RetCode = 13
+	RetInvalidListType        RetCode = 14
+	RetBadObject              RetCode = 15
+	RetNotSupported           RetCode = 16
+	RetInternalError          RetCode = 5000
+	RetUnknownErr             RetCode = 0xFFFF
+)
+
+var retCodeNames = map[RetCode]string{
+	RetSuccess:                "TA_SUCCESS",
+	RetLibNotInitialize:       "TA_LIB_NOT_INITIALIZE",
+	RetBadParam:               "TA_BAD_PARAM",
+	RetAllocErr:               "TA_ALLOC_ERR",
+	RetGroupNotFound:          "TA_GROUP_NOT_FOUND",
+	RetFuncNotFound:           "TA_FUNC_NOT_FOUND",
+	RetInvalidHandle:          "TA_INVALID_HANDLE",
+	RetInvalidParamHolder:     "TA_INVALID_PARAM_HOLDER",
+	RetInvalidParamHolderType: "TA_INVALID_PARAM_HOLDER_TYPE",
+	RetInvalidParamFunction:   "TA_INVALID_PARAM_FUNCTION",
+	RetInputNotAllInitialize:  "TA_INPUT_NOT_ALL_INITIALIZE",
+	RetOutputNotAllInitialize: "TA_OUTPUT_NOT_ALL_INITIALIZE",
+	RetOutOfRangeStartIndex:   "TA_OUT_OF_RANGE_START_INDEX",
+	RetOutOfRangeEndIndex:     "TA_OUT_OF_RANGE_END_INDEX",
+	RetInvalidListType:        "TA_INVALID_LIST_TYPE",
+	RetBadObject:              "TA_BAD_OBJECT",
+	RetNotSupported:           "TA_NOT_SUPPORTED",
+	RetInternalError:          "TA_INTERNAL_ERROR",
+	RetUnknownErr:             "TA_UNKNOWN_ERR",
+}
+
+// String 返回 TA-Lib 中的符号名，如 TA_BAD_PARAM。
+func (c RetCode) String() string {
+	if name, ok := retCodeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("TA_RetCode(%d)", int(c))
+}
+
+func (c RetCode) Error() string {
+	return c.String()
+}
+
+// 按错误类别划分的哨兵错误，可用 errors.Is 判断。
+var (
+	// ErrLibNotInitialized 表示 TA-Lib 尚未初始化（TA_LIB_NOT_INITIALIZE）。
+	ErrLibNotInitialized = errors.New("TA-Lib not initialized")
+	// ErrBadParam 表示参数超出允许范围（TA_BAD_PARAM）。
+	ErrBadParam = errors.New("bad parameter")
+	// ErrAlloc 表示内存分配失败（TA_ALLOC_ERR）。
+	ErrAlloc = errors.New("allocation failed")
+	// ErrOutOfRange 表示起止下标越界（TA_OUT_OF_RANGE_START_INDEX / TA_OUT_OF_RANGE_END_INDEX）。
+	ErrOutOfRange = errors.New("index out of range")
+	// ErrInternal 表示 TA-Lib 内部错误（TA_INTERNAL_ERROR）。
+	ErrInternal = errors.New("internal error")
+	// ErrLengthMismatch 表示多条输入序列的长度不一致。
+	ErrLengthMismatch = errors.New("input lengths differ")
+	// ErrInputTooShort 表示输入序列短于参数要求的长度。
+	ErrInputTooShort = errors.New("input too short")
+)
+
+// category 返回返回码所属的哨兵错误，没有对应类别时返回 nil。
+func (c RetCode) category() error {
+	switch c {
+	case RetLibNotInitialize:
+		return ErrLibNotInitialized
+	case RetBadParam:
+		return ErrBadParam
+	case RetAllocErr:
+		return ErrAlloc
+	case RetOutOfRangeStartIndex, RetOutOfRangeEndIndex:
+		return ErrOutOfRange
+	case RetInternalError:
+		return ErrInternal
+	}
+	return nil
+}
+
+// FuncError 是 TA-Lib 函数（或对应的原生实现）返回的错误。
+// errors.Is 既可以匹配 RetCode，也可以匹配 ErrBadParam 等哨兵错误。
+type FuncError struct {
+	Func  string  // 函数名，如 TA_RSI
+	Code  RetCode // 返回码
+	Param string  // 无效参数的名称，仅 TA_BAD_PARAM 且能确定具体参数时非空
+	Value any     // 无效参数的取值
+}
+
+func (e *FuncError) Error() string {
+	var b strings.Builder
+	if e.Func != "" {
+		b.WriteString(e.Func)
+		b.WriteString(": ")
+	}
+	b.WriteString(e.Code.String())
+	if e.Param != "" {
+		fmt.Fprintf(&b, " (%s=%v)", e.Param, e.Value)
+	}
+	return b.String()
+}
+
+// Is 使 errors.Is(err, RetBadParam) 这类按返回码的判断成立。
+func (e *FuncError) Is(target error) bool {
+	code, ok := target.(RetCode)
+	return ok && code == e.Code
+}
+
+// Unwrap 返回返回码所属的哨兵错误。
+func (e *FuncError) Unwrap() error {
+	return e.Code.category()
+}
+
+// taErr 将 TA-Lib 函数 fn 的返回码包装为错误。返回码为 TA_BAD_PARAM 时，
+// paramErr 是按同样规则在 Go 侧检查参数的结果，用于指出具体是哪个参数。
+func taErr(fn string, code RetCode, paramErr error) error {
+	var fe *FuncError
+	if code == RetBadParam && errors.As(paramErr, &fe) {
+		return fe
+	}
+	return &FuncError{Func: fn, Code: code}
+}
+
+// badParam 返回参数 name 无效的错误。
+func badParam(fn, name string, value any) error {
+	return &FuncError{Func: fn, Code: RetBadParam, Param: name, Value: value}
+}
+
+// LengthMismatchError 表示多条输入序列的长度不一致，可用 errors.Is(err, ErrLengthMismatch) 判断。
+type LengthMismatchError struct {
+	Func    string   // 函数名
+	Inputs  []string // 输入序列的名称
+	Lengths []int    // 各输入序列的长度
+}
+
+func (e *LengthMismatchError) Error() string {
+	return fmt.Sprintf("input slices (%s) must have the same length", strings.Join(e.Inputs, ", "))
+}
+
+func (e *LengthMismatchError) Unwrap() error {
+	return ErrLengthMismatch
+}
+
+// InputTooShortError 表示输入序列短于参数要求的长度，可用 errors.Is(err, ErrInputTooShort) 判断。
+type InputTooShortError struct {
+	Func     string // 函数名
+	Length   int    // 输入序列的长度
+	Param    string // 要求该长度的参数名，多个周期共同决定时为空
+	Required int    // 参数要求的最小长度
+}
+
+func (e *InputTooShortError) Error() string {
+	if e.Param == "" {
+		return fmt.Sprintf("input data length (%d) is too small for the given periods", e.Length)
+	}
+	return fmt.Sprintf("input data length (%d) is too small for the given %s (%d)", e.Length, e.Param, e.Required)
+}
+
+func (e *InputTooShortError) Unwrap() error {
+	return ErrInputTooShort
+}
+
+// lengthMismatch 返回输入序列长度不一致的错误，names 为以逗号分隔的序列名称。
+func lengthMismatch(fn, names string, lengths ...int) error {
+	return &LengthMismatchError{Func: fn, Inputs: strings.Split(names, ", "), Lengths: lengths}
+}
+
+// tooShort 返回输入序列长度不足的错误。
+func tooShort(fn string, length int, param string, required int) error {
+	return &InputTooShortError{Func: fn, Length: length, Param: param, Required: required}
+}
+
+// checkInputs 是多条输入序列的统一校验入口：长度不一致时返回 LengthMismatchError，
+// 否则返回共同的长度。空序列不视为错误，由调用方返回空结果。
+func checkInputs(fn, names string, inputs ...[]float64) (int, error) {
+	n := len(inputs[0])
+	for _, in := range inputs[1:] {
+		if len(in) != n {
+			lengths := make([]int, len(inputs))
+			for i, in := range inputs {
+				lengths[i] = len(in)
+			}
+			return 0, lengthMismatch(fn, names, lengths...)
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,105 @@
+package go4ta
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFuncErrorBadParam(t *testing.T) {
+	close := []float64{1, 2, 3, 4, 5, 6}
+	high := []float64{2, 3, 4, 5, 6, 7}
+	low := []float64{0, 1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name  string
+		err   error
+		fn    string
+		param string
+		value any
+	}{
+		{"RSI", func() error { _, err := RSI(close, 1); return err }(), "TA_RSI", "timePeriod", 1},
+		{"MA", func() error { _, err := MA(close, 3, 9); return err }(), "TA_MA", "maType", 9},
+		{"STOCH", func() error {
+			_, _, err := STOCH(high, low, close, 5, 3, 3, 0, -1)
+			return err
+		}(), "TA_STOCH", "maTypeD", -1},
+		{"BBands", func() error { _, _, _, err := BBands(close, 5, 4e37, 2, 0); return err }(), "TA_BBANDS", "nbDevUp", 4e37},
+		{"RSILookback", func() error { _, err := RSILookback(100001); return err }(), "TA_RSI", "timePeriod", 100001},
+		{"SuperTrendLookback", func() error { _, err := SuperTrendLookback(0); return err }(), "SuperTrend", "period", 0},
+		{"NewMACDStream", func() error { _, err := NewMACDStream(12, 26, 0); return err }(), "NewMACDStream", "signalPeriod", 0},
+	}
+	for _, tt := range tests {
+		if !errors.Is(tt.err, ErrBadParam) || !errors.Is(tt.err, RetBadParam) {
+			t.Errorf("%s: %v should match ErrBadParam and RetBadParam", tt.name, tt.err)
+		}
+		if errors.Is(tt.err, RetAllocErr) || errors.Is(tt.err, ErrInputTooShort) {
+			t.Errorf("%s: %v matches an unrelated error", tt.name, tt.err)
+		}
+		var fe *FuncError
+		if !errors.As(tt.err, &fe) {
+			t.Fatalf("%s: %T is not a *FuncError", tt.name, tt.err)
+		}
+		if fe.Func != tt.fn || fe.Code != RetBadParam || fe.Param != tt.param || fe.Value != tt.value {
+			t.Errorf("%s: got %+v, want %s/%s=%v", tt.name, *fe, tt.fn, tt.param, tt.value)
+		}
+	}
+
+	_, err := RSI(close, 1)
+	if got, want := err.Error(), "TA_RSI: TA_BAD_PARAM (timePeriod=1)"; got != want {
+		t.Errorf("message %q, want %q", got, want)
+	}
+}
+
+func TestFuncErrorRetCode(t *testing.T) {
+	err := taErr("TA_MA", RetOutOfRangeStartIndex, nil)
+	if !errors.Is(err, ErrOutOfRange) || !errors.Is(err, RetOutOfRangeStartIndex) || errors.Is(err, RetOutOfRangeEndIndex) {
+		t.Errorf("%v does not match its return code", err)
+	}
+	if got, want := err.Error(), "TA_MA: TA_OUT_OF_RANGE_START_INDEX"; got != want {
+		t.Errorf("message %q, want %q", got, want)
+	}
+	if !errors.Is(taErr("TA_MA", RetLibNotInitialize, nil), ErrLibNotInitialized) {
+		t.Error("TA_LIB_NOT_INITIALIZE should match ErrLibNotInitialized")
+	}
+	// 参数检查没有发现问题时保留原始返回码
+	if err := taErr("TA_MA", RetBadParam, nil); !errors.Is(err, ErrBadParam) || err.(*FuncError).Param != "" {
+		t.Errorf("unexpected %v", err)
+	}
+	if got := RetCode(42).String(); got != "TA_RetCode(42)" {
+		t.Errorf("unknown code printed as %q", got)
+	}
+	if _, err := Lookback("KDJ"); !errors.Is(err, RetFuncNotFound) {
+		t.Errorf("unknown indicator: %v", err)
+	}
+}
+
+func TestInputErrors(t *testing.T) {
+	_, err := ATR([]float64{1, 2, 3}, []float64{1, 2}, []float64{1, 2, 3}, 2)
+	var lm *LengthMismatchError
+	if !errors.Is(err, ErrLengthMismatch) || !errors.As(err, &lm) {
+		t.Fatalf("ATR length mismatch: %v", err)
+	}
+	if lm.Func != "ATR" || len(lm.Inputs) != 3 || lm.Inputs[1] != "low" || lm.Lengths[1] != 2 {
+		t.Errorf("got %+v", *lm)
+	}
+	if got, want := err.Error(), "input slices (high, low, close) must have the same length"; got != want {
+		t.Errorf("message %q, want %q", got, want)
+	}
+
+	_, err = MA([]float64{1, 2, 3}, 5, 0)
+	var ts *InputTooShortError
+	if !errors.Is(err, ErrInputTooShort) || !errors.As(err, &ts) {
+		t.Fatalf("MA too short: %v", err)
+	}
+	if ts.Func != "MA" || ts.Length != 3 || ts.Param != "timePeriod" || ts.Required != 5 {
+		t.Errorf("got %+v", *ts)
+	}
+
+	_, _, _, err = MACD([]float64{1, 2, 3}, 12, 26, 9)
+	if !errors.As(err, &ts) || ts.Param != "" || ts.Required != 26 {
+		t.Errorf("MACD too short: %v", err)
+	}
+	if got, want := err.Error(), "input data length (3) is too small for the given periods"; got != want {
+		t.Errorf("message %q, want %q", got, want)
+	}
+}
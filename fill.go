@@ -0,0 +1,149 @@
+package go4ta
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+)
+
+// FillPolicy 决定结果序列中回看期（预热期）部分的表示方式，对包内所有批量指标函数生效。
+// 流式指标不受影响，预热期由 Ready 表示。
+type FillPolicy int32
+
+const (
+	// FillDefault 保持各函数原有的填充方式：TA-Lib 指标填 0，SuperTrend 的主线和上下轨填 NaN、方向填 0。
+	FillDefault FillPolicy = iota
+	// FillZero 以 0 填充回看期。
+	FillZero
+	// FillNaN 以 NaN 填充回看期，便于与真实的 0 值区分。
+	FillNaN
+	// FillTrim 去掉回看期，结果只包含有效值，第一个元素对应输入序列的下标见 BeginIndex。
+	FillTrim
+)
+
+func (p FillPolicy) String() string {
+	switch p {
+	case FillDefault:
+		return "default"
+	case FillZero:
+		return "zero"
+	case FillNaN:
+		return "nan"
+	case FillTrim:
+		return "trim"
+	}
+	return fmt.Sprintf("FillPolicy(%d)", int32(p))
+}
+
+var fillPolicy atomic.Int32
+
+// SetFillPolicy 设置全包的回看期填充方式，可在任意 goroutine 中调用，
+// 正在进行的计算使用开始时读取到的设置。
+//
+// @param policy - 填充方式
+// @return error - policy 不是已定义的取值时返回错误
+func SetFillPolicy(policy FillPolicy) error {
+	if policy < FillDefault || policy > FillTrim {
+		return badParam("SetFillPolicy", "policy", policy)
+	}
+	fillPolicy.Store(int32(policy))
+	return nil
+}
+
+// GetFillPolicy 返回当前的回看期填充方式。
+func GetFillPolicy() FillPolicy {
+	return FillPolicy(fillPolicy.Load())
+}
+
+// BeginIndex 返回结果序列第一个元素在输入序列中的下标。
+// 除 FillTrim 外结果与输入等长，下标为 0；FillTrim 下即为第一个有效值的下标。
+//
+// @param inputLen - 输入序列长度
+// @param result   - 指标函数返回的结果序列
+// @return int     - 第一个元素对应的输入下标
+func BeginIndex(inputLen int, result []float64) int {
+	return inputLen - len(result)
+}
+
+// warmup 是一次计算使用的填充方式，在计算开始时读取一次，保证同一次调用的多条输出一致。
+type warmup struct {
+	policy FillPolicy
+	value  float64 // FillDefault 与 FillZero/FillNaN 下的填充值
+}
+
+// newWarmup 读取当前的填充方式，legacy 是该指标在 FillDefault 下原有的填充值。
+func newWarmup(legacy float64) warmup {
+	w := warmup{policy: GetFillPolicy(), value: legacy}
+	switch w.policy {
+	case FillZero:
+		w.value = 0
+	case FillNaN:
+		w.value = math.NaN()
+	}
+	return w
+}
+
+// spread 将从 begIdx 开始紧凑排列的计算结果展开为长度为 n 的序列，未计算部分为填充值；
+// FillTrim 下直接返回有效部分。
+func (w warmup) spread(n, begIdx int, output []float64) []float64 {
+	if w.policy == FillTrim {
+		if output == nil {
+			return []float64{}
+		}
+		return output
+	}
+	result := make([]float64, n)
+	end := begIdx + copy(result[begIdx:], output)
+	if w.value != 0 {
+		fillValue(result[:begIdx], w.value)
+		fillValue(result[end:], w.value)
+	}
+	return result
+}
+
+// spreadAligned 用于回看期不同的多条输出：FillTrim 下统一从 alignIdx 开始截取，
+// 使各输出等长、同一下标对应同一根价格柱；其余方式下与 spread 相同。
+// alignIdx 为回看期最长的输出的起始下标，不小于 begIdx；该输出为空时传入 n。
+func (w warmup) spreadAligned(n, begIdx, alignIdx int, output []float64) []float64 {
+	if w.policy == FillTrim {
+		return w.spread(n, alignIdx, output[min(alignIdx-begIdx, len(output)):])
+	}
+	return w.spread(n, begIdx, output)
+}
+
+// refill 处理已按原有方式填充好的等长序列，前 begIdx 个元素属于回看期。
+// FillDefault 下原样返回。
+func (w warmup) refill(values []float64, begIdx int) []float64 {
+	switch w.policy {
+	case FillDefault:
+		return values
+	case FillTrim:
+		return values[begIdx:]
+	}
+	fillValue(values[:begIdx], w.value)
+	return values
+}
+
+func fillValue(s []float64, v float64) {
+	for i := range s {
+		s[i] = v
+	}
+}
+
+// spread 按当前的填充方式展开单条输出，TA-Lib 指标原有的填充值为 0。
+func spread(n, begIdx int, output []float64) []float64 {
+	return newWarmup(0).spread(n, begIdx, output)
+}
+
+// spreadInt 展开整型输出（K线形态）。整数无法表示 NaN，除 FillTrim 外回看期一律填0。
+func spreadInt(n, begIdx int, output []int) []int {
+	if GetFillPolicy() == FillTrim {
+		if output == nil {
+			return []int{}
+		}
+		return output
+	}
+	result := make([]int, n)
+	copy(result[begIdx:], output)
+	return result
+}
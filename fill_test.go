@@ -0,0 +1,174 @@
+package go4ta
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// withFillPolicy 在给定填充方式下运行 f，结束后恢复默认设置。
+func withFillPolicy(t *testing.T, policy FillPolicy, f func()) {
+	t.Helper()
+	if err := SetFillPolicy(policy); err != nil {
+		t.Fatal(err)
+	}
+	defer SetFillPolicy(FillDefault)
+	f()
+}
+
+func TestFillPolicy(t *testing.T) {
+	s := randomWalk(rand.New(rand.NewSource(1)), "trending", 100, 100, 0.002, 0.01, 0)
+	n := len(s.close)
+
+	// 各指标在默认设置下的结果及其回看期
+	type output struct {
+		name     string
+		lookback int
+		legacy   float64
+		calc     func() []float64
+	}
+	macdLookback, _ := MACDLookback(12, 26, 9)
+	rsiLookback, _ := RSILookback(14)
+	signalLookback, _ := PPOWithSignalLookback(12, 26, 9, 1)
+	superTrendLookback, _ := SuperTrendLookback(7)
+	outputs := []output{
+		{"RSI", rsiLookback, 0, func() []float64 { r, _ := RSI(s.close, 14); return r }},
+		{"MACD.hist", macdLookback, 0, func() []float64 { _, _, h, _ := MACD(s.close, 12, 26, 9); return h }},
+		{"PPOWithSignal.signal", signalLookback, 0, func() []float64 {
+			_, sig, _, _ := PPOWithSignal(s.close, 12, 26, 9, 1)
+			return sig
+		}},
+		{"SuperTrend", superTrendLookback, math.NaN(), func() []float64 {
+			st, _, _, _, _ := SuperTrend(s.high, s.low, s.close, 7, 3)
+			return st
+		}},
+		{"SuperTrend.direction", superTrendLookback, 0, func() []float64 {
+			_, dir, _, _, _ := SuperTrend(s.high, s.low, s.close, 7, 3)
+			return dir
+		}},
+	}
+
+	for _, o := range outputs {
+		legacy := o.calc()
+		if len(legacy) != n {
+			t.Fatalf("%s: length %d, want %d", o.name, len(legacy), n)
+		}
+		for _, policy := range []FillPolicy{FillZero, FillNaN, FillTrim} {
+			withFillPolicy(t, policy, func() {
+				got := o.calc()
+				if begIdx := BeginIndex(n, got); policy == FillTrim && begIdx != o.lookback {
+					t.Errorf("%s/%v: begin index %d, want %d", o.name, policy, begIdx, o.lookback)
+				}
+				for i := range n {
+					j := i - BeginIndex(n, got)
+					if j < 0 {
+						continue
+					}
+					want := legacy[i]
+					if i < o.lookback {
+						want = map[FillPolicy]float64{FillZero: 0, FillNaN: math.NaN()}[policy]
+					}
+					if !sameFloat(got[j], want) {
+						t.Errorf("%s/%v[%d] = %v, want %v", o.name, policy, i, got[j], want)
+						break
+					}
+				}
+			})
+		}
+		for i := range o.lookback {
+			if !sameFloat(legacy[i], o.legacy) {
+				t.Errorf("%s[%d] = %v, want %v by default", o.name, i, legacy[i], o.legacy)
+				break
+			}
+		}
+	}
+}
+
+// TestPPOWithSignalZeroPPO 检查 PPO 恰好为 0 时信号线仍从真实的起始下标开始。
+func TestPPOWithSignalZeroPPO(t *testing.T) {
+	close := make([]float64, 60)
+	for i := range close {
+		close[i] = 10
+	}
+	ppoLookback, _ := PPOLookback(12, 26, 1)
+	signalLookback, _ := PPOWithSignalLookback(12, 26, 9, 1)
+	withFillPolicy(t, FillNaN, func() {
+		ppo, signal, hist, err := PPOWithSignal(close, 12, 26, 9, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range close {
+			wantPPO, wantSignal := 0.0, 0.0
+			if i < ppoLookback {
+				wantPPO = math.NaN()
+			}
+			if i < signalLookback {
+				wantSignal = math.NaN()
+			}
+			if !sameFloat(ppo[i], wantPPO) || !sameFloat(signal[i], wantSignal) || !sameFloat(hist[i], wantSignal) {
+				t.Fatalf("[%d] ppo=%v signal=%v hist=%v, want %v, %v, %v", i, ppo[i], signal[i], hist[i], wantPPO, wantSignal, wantSignal)
+			}
+		}
+	})
+}
+
+// TestFillTrimAligned 检查回看期不同的多条输出在 FillTrim 下从同一根价格柱开始。
+func TestFillTrimAligned(t *testing.T) {
+	s := randomWalk(rand.New(rand.NewSource(1)), "trending", 120, 100, 0.002, 0.01, 0)
+	n := len(s.close)
+	b := &Bars{Open: s.close, High: s.high, Low: s.low, Close: s.close, Volume: s.close}
+
+	ppo, _ := CalcPPOWithSignal(s.close, 12, 26, 9, 1)
+	ppoLookback, _ := PPOWithSignalLookback(12, 26, 9, 1)
+	dmi, _ := CalcDMI(s.high, s.low, s.close, 14)
+	dmiLookback, _ := DMILookback(14)
+	withFillPolicy(t, FillTrim, func() {
+		trimmed, err := CalcPPOWithSignal(s.close, 12, 26, 9, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l := trimmed.Len(); l != n-ppoLookback || len(trimmed.Signal) != l || len(trimmed.Hist) != l {
+			t.Fatalf("PPO lengths %d/%d/%d, want %d", l, len(trimmed.Signal), len(trimmed.Hist), n-ppoLookback)
+		}
+		if trimmed.Last() != ppo.Last() || trimmed.At(0) != ppo.At(ppoLookback) {
+			t.Errorf("PPO with FillTrim: Last %+v, At(0) %+v", trimmed.Last(), trimmed.At(0))
+		}
+
+		for _, calc := range []func() (DMIResult, error){
+			func() (DMIResult, error) { return CalcDMI(s.high, s.low, s.close, 14) },
+			func() (DMIResult, error) { return b.DMI(14) },
+		} {
+			trimmed, err := calc()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if l := trimmed.Len(); l != n-dmiLookback || len(trimmed.ADXR) != l || len(trimmed.PlusDI) != l || len(trimmed.MinusDI) != l {
+				t.Fatalf("DMI lengths %d/%d/%d/%d, want %d", l, len(trimmed.ADXR), len(trimmed.PlusDI), len(trimmed.MinusDI), n-dmiLookback)
+			}
+			if trimmed.Last() != dmi.Last() || trimmed.At(0) != dmi.At(dmiLookback) {
+				t.Errorf("DMI with FillTrim: Last %+v, At(0) %+v", trimmed.Last(), trimmed.At(0))
+			}
+		}
+
+		// ADXR 或信号线尚无有效值时全部为空
+		if short, err := CalcDMI(s.high[:30], s.low[:30], s.close[:30], 14); err != nil || short.Len() != 0 || len(short.PlusDI) != 0 {
+			t.Errorf("CalcDMI(30 bars) with FillTrim = %+v, %v", short, err)
+		}
+		if short, err := CalcPPOWithSignal(s.close[:30], 12, 26, 9, 1); err != nil || short.Len() != 0 || len(short.Signal) != 0 {
+			t.Errorf("CalcPPOWithSignal(30 bars) with FillTrim = %+v, %v", short, err)
+		}
+	})
+}
+
+func TestSetFillPolicyInvalid(t *testing.T) {
+	if err := SetFillPolicy(FillTrim + 1); err == nil {
+		t.Error("unknown fill policy should fail")
+	}
+	if got := GetFillPolicy(); got != FillDefault {
+		t.Errorf("policy changed to %v", got)
+	}
+}
+
+func sameFloat(a, b float64) bool {
+	return a == b || (math.IsNaN(a) && math.IsNaN(b))
+}
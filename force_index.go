@@ -0,0 +1,52 @@
+package go4ta
+
+// ForceIndex 计算埃尔德强力指数（Elder Force Index），即每根收盘价的变动乘以成交量后的 EMA。
+//
+// @param close      - 收盘价序列
+// @param volume     - 成交量序列
+// @param timePeriod - EMA 周期（如13），为1时即每根的原始强力值
+// @return []float64 - 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或计算失败，则返回错误。
+func ForceIndex(close, volume []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("ForceIndex", "close, volume", close, volume)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("ForceIndex", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("FORCEINDEX", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+	if n < 2 {
+		return newWarmup(0).spread(n, 0, nil), nil
+	}
+
+	force := make([]float64, n-1)
+	for i := range force {
+		force[i] = (close[i+1] - close[i]) * volume[i+1]
+	}
+	emaBegIdx, output, err := taMA(force, timePeriod, int(MATypeEMA))
+	if err != nil {
+		return nil, err
+	}
+
+	return newWarmup(0).spread(n, emaBegIdx+1, output), nil
+}
+
+// ForceIndexLookback 返回 ForceIndex 的回看期，即 EMA(timePeriod) 的回看期加1。
+//
+// @param timePeriod - EMA 周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func ForceIndexLookback(timePeriod int) (int, error) {
+	lookback, err := MALookback(timePeriod, MATypeEMA)
+	if err != nil {
+		return 0, err
+	}
+	return lookback + 1, nil
+}
@@ -0,0 +1,57 @@
+package go4ta
+
+import (
+	"encoding/csv"
+	"math"
+	"os"
+	"strconv"
+	"testing"
+)
+
+// readGolden 读取 test_data 下的参考数据，按表头返回各列，空白单元格（未计算部分）为 NaN。
+func readGolden(t *testing.T, name string) map[string][]float64 {
+	t.Helper()
+	file, err := os.Open("test_data/" + name)
+	if err != nil {
+		t.Fatalf("无法打开测试数据文件: %v", err)
+	}
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatalf("无法读取CSV数据: %v", err)
+	}
+
+	columns := make(map[string][]float64, len(records[0]))
+	for j, header := range records[0] {
+		column := make([]float64, len(records)-1)
+		for i, record := range records[1:] {
+			column[i] = math.NaN()
+			if record[j] != "" {
+				if column[i], err = strconv.ParseFloat(record[j], 64); err != nil {
+					t.Fatalf("%s 第%d行 %s: %v", name, i+2, header, err)
+				}
+			}
+		}
+		columns[header] = column
+	}
+	return columns
+}
+
+// checkGolden 比较结果与参考列：参考值为空的位置结果应为默认填充值0，其余位置的相对误差不超过 1e-6。
+func checkGolden(t *testing.T, name string, got, want []float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: 期望长度%d，实际%d", name, len(want), len(got))
+	}
+	for i, w := range want {
+		if math.IsNaN(w) {
+			if got[i] != 0 {
+				t.Errorf("%s[%d] 期望0，实际%f", name, i, got[i])
+			}
+			continue
+		}
+		if math.Abs(got[i]-w) > 1e-6*max(1, math.Abs(w)) {
+			t.Errorf("%s[%d] 期望%f，实际%f", name, i, w, got[i])
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package go4ta
+
+// Ehlers 的希尔伯特变换族，对应 TA-Lib 的 TA_HT_* 函数。各函数由希尔伯特变换测量价格的主导周期，
+// 可用于让 RSI、STOCH 等指标的周期随市场周期变化；回看期较长（32或63根）且受各自的不稳定期影响。
+
+// HTDCPeriod 计算希尔伯特变换的主导周期（Dominant Cycle Period），单位为价格柱数，取值约在 6..50 之间。
+//
+// @param close      - 收盘价序列
+// @return []float64 - 主导周期序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func HTDCPeriod(close []float64) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+
+	outBegIdx, output, err := taHTDCPERIOD(close)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// HTDCPeriodLookback 返回 HTDCPeriod 的回看期，即 32 加上 FuncUnstHTDCPeriod 的不稳定期。
+func HTDCPeriodLookback() int {
+	return taHTDCPERIODLookback()
+}
+
+// HTDCPhase 计算主导周期的相位（Dominant Cycle Phase），单位为度，取值在 -45..315 之间。
+// 相位以每根约 360/周期 的速度前进时市场处于周期模式，停滞或倒退时处于趋势模式。
+//
+// @param close      - 收盘价序列
+// @return []float64 - 相位序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func HTDCPhase(close []float64) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+
+	outBegIdx, output, err := taHTDCPHASE(close)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// HTDCPhaseLookback 返回 HTDCPhase 的回看期，即 63 加上 FuncUnstHTDCPhase 的不稳定期。
+func HTDCPhaseLookback() int {
+	return taHTDCPHASELookback()
+}
+
+// HTPhasor 计算希尔伯特变换的相量分量，按位置返回各输出，结果含义见 CalcHTPhasor。
+//
+// @param close               - 收盘价序列
+// @return inPhase, quadrature - 两个与输入等长的结果序列
+// @return error              - 如果输入数据无效或 C 库调用失败，则返回错误。
+func HTPhasor(close []float64) (inPhase, quadrature []float64, err error) {
+	r, err := CalcHTPhasor(close)
+	return r.InPhase, r.Quadrature, err
+}
+
+// CalcHTPhasor 计算希尔伯特变换的相量分量（Phasor Components）：去趋势后价格的同相分量与正交分量，
+// 两者的反正切即为瞬时相位。
+//
+// @param close             - 收盘价序列
+// @return HTPhasorResult   - 同相分量与正交分量，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error            - 如果输入数据无效或 C 库调用失败，则返回错误。
+func CalcHTPhasor(close []float64) (HTPhasorResult, error) {
+	if len(close) == 0 {
+		return HTPhasorResult{[]float64{}, []float64{}}, nil
+	}
+
+	outBegIdx, outInPhase, outQuadrature, err := taHTPHASOR(close)
+	if err != nil {
+		return HTPhasorResult{}, err
+	}
+
+	w := newWarmup(0)
+	return HTPhasorResult{
+		InPhase:    w.spread(len(close), outBegIdx, outInPhase),
+		Quadrature: w.spread(len(close), outBegIdx, outQuadrature),
+	}, nil
+}
+
+// HTPhasorLookback 返回 HTPhasor 的回看期，即 32 加上 FuncUnstHTPhasor 的不稳定期。
+func HTPhasorLookback() int {
+	return taHTPHASORLookback()
+}
+
+// HTSine 计算希尔伯特变换的正弦线，按位置返回各输出，结果含义见 CalcHTSine。
+//
+// @param close           - 收盘价序列
+// @return sine, leadSine - 两个与输入等长的结果序列
+// @return error          - 如果输入数据无效或 C 库调用失败，则返回错误。
+func HTSine(close []float64) (sine, leadSine []float64, err error) {
+	r, err := CalcHTSine(close)
+	return r.Sine, r.LeadSine, err
+}
+
+// CalcHTSine 计算希尔伯特变换的正弦线（SineWave）：主导周期相位的正弦与超前45度的正弦。
+// 周期模式下两线交叉提示周期的转折，趋势模式下两线大致平行、不宜据此交易。
+//
+// @param close          - 收盘价序列
+// @return HTSineResult  - 正弦线与超前正弦线，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error         - 如果输入数据无效或 C 库调用失败，则返回错误。
+func CalcHTSine(close []float64) (HTSineResult, error) {
+	if len(close) == 0 {
+		return HTSineResult{[]float64{}, []float64{}}, nil
+	}
+
+	outBegIdx, outSine, outLeadSine, err := taHTSINE(close)
+	if err != nil {
+		return HTSineResult{}, err
+	}
+
+	w := newWarmup(0)
+	return HTSineResult{
+		Sine:     w.spread(len(close), outBegIdx, outSine),
+		LeadSine: w.spread(len(close), outBegIdx, outLeadSine),
+	}, nil
+}
+
+// HTSineLookback 返回 HTSine 的回看期，即 63 加上 FuncUnstHTSine 的不稳定期。
+func HTSineLookback() int {
+	return taHTSINELookback()
+}
+
+// HTTrendline 计算希尔伯特变换的瞬时趋势线（Instantaneous Trendline）：
+// 最近一个主导周期内收盘价的均值再做加权平滑，周期随市场变化，可作为自适应的均线。
+//
+// @param close      - 收盘价序列
+// @return []float64 - 趋势线序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func HTTrendline(close []float64) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+
+	outBegIdx, output, err := taHTTRENDLINE(close)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// HTTrendlineLookback 返回 HTTrendline 的回看期，即 63 加上 FuncUnstHTTrendline 的不稳定期。
+func HTTrendlineLookback() int {
+	return taHTTRENDLINELookback()
+}
+
+// HTTrendMode 判断市场处于趋势模式还是周期模式（Trend vs Cycle Mode）：1 为趋势，0 为周期。
+// 正弦线刚交叉、相位按主导周期的速度前进时为周期模式，价格偏离趋势线1.5%以上时总是趋势模式。
+//
+// @param close  - 收盘价序列
+// @return []int - 模式序列，与输入等长，回看期内为0，FillTrim 下只包含回看期之后的部分。
+// @return error - 如果输入数据无效或 C 库调用失败，则返回错误。
+func HTTrendMode(close []float64) ([]int, error) {
+	if len(close) == 0 {
+		return []int{}, nil
+	}
+
+	outBegIdx, output, err := taHTTRENDMODE(close)
+	if err != nil {
+		return nil, err
+	}
+
+	return spreadInt(len(close), outBegIdx, output), nil
+}
+
+// HTTrendModeLookback 返回 HTTrendMode 的回看期，即 63 加上 FuncUnstHTTrendMode 的不稳定期。
+func HTTrendModeLookback() int {
+	return taHTTRENDMODELookback()
+}
+
+// CycleMA 计算以主导周期为周期的自适应均线：每根价格柱上以 HTDCPeriod 测得的周期作为 MAVP 的周期，
+// 市场周期变长时均线变慢，变短时变快。周期截断为整数后限制在 minPeriod..maxPeriod 之间。
+//
+// @param close      - 收盘价序列
+// @param minPeriod  - 最小周期，取值 2..100000（如6）
+// @param maxPeriod  - 最大周期，取值 2..100000 且不小于 minPeriod（如50）
+// @param maType     - 均线类型，如 MATypeEMA
+// @return []float64 - 均线序列，与输入等长，主导周期与均线都有效之前按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或计算失败，则返回错误。
+func CycleMA(close []float64, minPeriod, maxPeriod int, maType MAType) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+
+	if err := checkParams("CYCLEMA", float64(minPeriod), float64(maxPeriod), float64(maType)); err != nil {
+		return nil, err
+	}
+
+	periodBegIdx, dcPeriod, err := taHTDCPERIOD(close)
+	if err != nil {
+		return nil, err
+	}
+	if len(dcPeriod) == 0 {
+		return spread(len(close), 0, nil), nil
+	}
+
+	// 主导周期的回看期内周期为0，按 minPeriod 计算，结果随后丢弃
+	periods := make([]float64, len(close))
+	copy(periods[periodBegIdx:], dcPeriod)
+	maBegIdx, ma, err := taMAVP(close, periods, minPeriod, maxPeriod, int(maType))
+	if err != nil {
+		return nil, err
+	}
+	if len(ma) == 0 {
+		return spread(len(close), 0, nil), nil
+	}
+
+	begIdx := max(periodBegIdx, maBegIdx)
+	return spread(len(close), begIdx, ma[begIdx-maBegIdx:]), nil
+}
+
+// CycleMALookback 返回 CycleMA 的回看期，即 HTDCPeriod 与 MAVP(minPeriod, maxPeriod, maType) 回看期中较长的一个。
+//
+// @param minPeriod - 最小周期
+// @param maxPeriod - 最大周期
+// @param maType    - 均线类型
+// @return int      - 回看期
+// @return error    - 参数无效时返回错误
+func CycleMALookback(minPeriod, maxPeriod int, maType MAType) (int, error) {
+	_, _, _, err := mavpParams(minPeriod, maxPeriod, int(maType))
+	mavpLookback, err := lookbackResult("TA_MAVP", taMAVPLookback(minPeriod, maxPeriod, int(maType)), err)
+	if err != nil {
+		return 0, err
+	}
+	return max(HTDCPeriodLookback(), mavpLookback), nil
+}
@@ -0,0 +1,210 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taHTDCPERIOD 调用 TA_HT_DCPERIOD。
+func taHTDCPERIOD(close []float64) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_HT_DCPERIOD(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		return 0, nil, taErr("TA_HT_DCPERIOD", RetCode(retCode), nil)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taHTDCPHASE 调用 TA_HT_DCPHASE。
+func taHTDCPHASE(close []float64) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_HT_DCPHASE(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		return 0, nil, taErr("TA_HT_DCPHASE", RetCode(retCode), nil)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taHTPHASOR 调用 TA_HT_PHASOR。
+func taHTPHASOR(close []float64) (int, []float64, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	outInPhase := make([]C.double, len(close))
+	outQuadrature := make([]C.double, len(close))
+	cOutInPhase := (*C.double)(unsafe.Pointer(&outInPhase[0]))
+	cOutQuadrature := (*C.double)(unsafe.Pointer(&outQuadrature[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_HT_PHASOR(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		&outBegIdx,
+		&outNBElement,
+		cOutInPhase,
+		cOutQuadrature,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		return 0, nil, nil, taErr("TA_HT_PHASOR", RetCode(retCode), nil)
+	}
+
+	return int(outBegIdx), fromC(outInPhase, outNBElement), fromC(outQuadrature, outNBElement), nil
+}
+
+// taHTSINE 调用 TA_HT_SINE。
+func taHTSINE(close []float64) (int, []float64, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	outSine := make([]C.double, len(close))
+	outLeadSine := make([]C.double, len(close))
+	cOutSine := (*C.double)(unsafe.Pointer(&outSine[0]))
+	cOutLeadSine := (*C.double)(unsafe.Pointer(&outLeadSine[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_HT_SINE(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		&outBegIdx,
+		&outNBElement,
+		cOutSine,
+		cOutLeadSine,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		return 0, nil, nil, taErr("TA_HT_SINE", RetCode(retCode), nil)
+	}
+
+	return int(outBegIdx), fromC(outSine, outNBElement), fromC(outLeadSine, outNBElement), nil
+}
+
+// taHTTRENDLINE 调用 TA_HT_TRENDLINE。
+func taHTTRENDLINE(close []float64) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_HT_TRENDLINE(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		return 0, nil, taErr("TA_HT_TRENDLINE", RetCode(retCode), nil)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taHTTRENDMODE 调用 TA_HT_TRENDMODE。
+func taHTTRENDMODE(close []float64) (int, []int, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.int, len(close))
+	cOutput := (*C.int)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_HT_TRENDMODE(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		return 0, nil, taErr("TA_HT_TRENDMODE", RetCode(retCode), nil)
+	}
+
+	return int(outBegIdx), fromCInt(output, outNBElement), nil
+}
+
+// taHTDCPERIODLookback 调用 TA_HT_DCPERIOD_Lookback。
+func taHTDCPERIODLookback() int {
+	defer readSettings()()
+	return int(C.TA_HT_DCPERIOD_Lookback())
+}
+
+// taHTDCPHASELookback 调用 TA_HT_DCPHASE_Lookback。
+func taHTDCPHASELookback() int {
+	defer readSettings()()
+	return int(C.TA_HT_DCPHASE_Lookback())
+}
+
+// taHTPHASORLookback 调用 TA_HT_PHASOR_Lookback。
+func taHTPHASORLookback() int {
+	defer readSettings()()
+	return int(C.TA_HT_PHASOR_Lookback())
+}
+
+// taHTSINELookback 调用 TA_HT_SINE_Lookback。
+func taHTSINELookback() int {
+	defer readSettings()()
+	return int(C.TA_HT_SINE_Lookback())
+}
+
+// taHTTRENDLINELookback 调用 TA_HT_TRENDLINE_Lookback。
+func taHTTRENDLINELookback() int {
+	defer readSettings()()
+	return int(C.TA_HT_TRENDLINE_Lookback())
+}
+
+// taHTTRENDMODELookback 调用 TA_HT_TRENDMODE_Lookback。
+func taHTTRENDMODELookback() int {
+	defer readSettings()()
+	return int(C.TA_HT_TRENDMODE_Lookback())
+}
@@ -0,0 +1,379 @@
+package go4ta
+
+import "math"
+
+// 希尔伯特变换族（TA_HT_*）的原生实现。各函数的主循环相同：先以4周期加权平均平滑价格，
+// 再由希尔伯特变换得到同相与正交分量，测出主导周期；在此基础上分别计算相位、正弦线、趋势线与趋势模式。
+
+// deg2Rad 与 TA-Lib 相同取 rad2Deg 的倒数。
+var deg2Rad = 1.0 / rad2Deg
+
+// constDeg2RadBy360 即 2π，对应 TA-Lib 中的 atan(1)*8。
+var constDeg2RadBy360 = math.Atan(1) * 8.0
+
+const (
+	// htCycleStart 与 htPhaseStart 是主循环开始的下标：前3根初始化价格平滑器，
+	// 之后分别再用9根、34根预热，与 TA-Lib 一致。
+	htCycleStart = 12
+	htPhaseStart = 37
+	// htSmoothPriceSize 是计算相位时保存的平滑价格个数，主导周期不超过50。
+	htSmoothPriceSize = 50
+)
+
+// htCycle 保存测量主导周期所需的变量，对应 TA_HT_DCPERIOD 主循环中的变量。
+type htCycle struct {
+	start          int // 主循环开始的下标
+	today          int // 下一根价格柱的序号，奇偶决定希尔伯特变换使用哪套缓冲
+	wma            priceWMA
+	hilbertIdx     int
+	detrender, q1  hilbert
+	jI, jQ         hilbert
+	period         float64
+	smoothPeriod   float64 // 平滑后的主导周期，即 HT_DCPERIOD 的输出
+	inPhase        float64 // 本根的同相分量 I1，即延迟3根的 detrender
+	prevI2, prevQ2 float64
+	re, im         float64
+	i1ForOddPrev3  float64
+	i1ForEvenPrev3 float64
+	i1ForOddPrev2  float64
+	i1ForEvenPrev2 float64
+}
+
+func newHTCycle(start int) htCycle {
+	return htCycle{start: start, wma: newPriceWMA()}
+}
+
+// update 处理一根价格柱，返回平滑后的价格；ok 为 false 表示仍在预热，尚未更新主导周期。
+func (h *htCycle) update(price float64) (smoothedValue float64, ok bool) {
+	today := h.today
+	h.today++
+	smoothedValue, ok = h.wma.update(price)
+	if !ok || today < h.start {
+		return 0, false
+	}
+
+	adjustedPrevPeriod := (0.075 * h.period) + 0.54
+
+	var q2, i2 float64
+	if today%2 == 0 {
+		h.detrender.even(smoothedValue, h.hilbertIdx, adjustedPrevPeriod)
+		h.q1.even(h.detrender.Value, h.hilbertIdx, adjustedPrevPeriod)
+		h.inPhase = h.i1ForEvenPrev3
+		h.jI.even(h.i1ForEvenPrev3, h.hilbertIdx, adjustedPrevPeriod)
+		h.jQ.even(h.q1.Value, h.hilbertIdx, adjustedPrevPeriod)
+		h.hilbertIdx++
+		if h.hilbertIdx == 3 {
+			h.hilbertIdx = 0
+		}
+
+		q2 = (0.2 * (h.q1.Value + h.jI.Value)) + (0.8 * h.prevQ2)
+		i2 = (0.2 * (h.i1ForEvenPrev3 - h.jQ.Value)) + (0.8 * h.prevI2)
+
+		h.i1ForOddPrev3 = h.i1ForOddPrev2
+		h.i1ForOddPrev2 = h.detrender.Value
+	} else {
+		h.detrender.odd(smoothedValue, h.hilbertIdx, adjustedPrevPeriod)
+		h.q1.odd(h.detrender.Value, h.hilbertIdx, adjustedPrevPeriod)
+		h.inPhase = h.i1ForOddPrev3
+		h.jI.odd(h.i1ForOddPrev3, h.hilbertIdx, adjustedPrevPeriod)
+		h.jQ.odd(h.q1.Value, h.hilbertIdx, adjustedPrevPeriod)
+
+		q2 = (0.2 * (h.q1.Value + h.jI.Value)) + (0.8 * h.prevQ2)
+		i2 = (0.2 * (h.i1ForOddPrev3 - h.jQ.Value)) + (0.8 * h.prevI2)
+
+		h.i1ForEvenPrev3 = h.i1ForEvenPrev2
+		h.i1ForEvenPrev2 = h.detrender.Value
+	}
+
+	// 由相邻两根的相位差得到周期，限制变化幅度后平滑
+	h.re = (0.2 * ((i2 * h.prevI2) + (q2 * h.prevQ2))) + (0.8 * h.re)
+	h.im = (0.2 * ((i2 * h.prevQ2) - (q2 * h.prevI2))) + (0.8 * h.im)
+	h.prevQ2 = q2
+	h.prevI2 = i2
+	tempReal := h.period
+	if h.im != 0.0 && h.re != 0.0 {
+		h.period = 360.0 / (math.Atan(h.im/h.re) * rad2Deg)
+	}
+	tempReal2 := 1.5 * tempReal
+	if h.period > tempReal2 {
+		h.period = tempReal2
+	}
+	tempReal2 = 0.67 * tempReal
+	if h.period < tempReal2 {
+		h.period = tempReal2
+	}
+	if h.period < 6 {
+		h.period = 6
+	} else if h.period > 50 {
+		h.period = 50
+	}
+	h.period = (0.2 * h.period) + (0.8 * tempReal)
+
+	h.smoothPeriod = (0.33 * h.period) + (0.67 * h.smoothPeriod)
+	return smoothedValue, true
+}
+
+// htPhase 在主导周期的基础上计算主导周期的相位，HT_DCPHASE、HT_SINE 与 HT_TRENDMODE 共用。
+type htPhase struct {
+	htCycle
+	smoothPrice    [htSmoothPriceSize]float64 // 最近的平滑价格，环形缓冲
+	smoothPriceIdx int
+	dcPhase        float64
+}
+
+func newHTPhase() htPhase {
+	return htPhase{htCycle: newHTCycle(htPhaseStart)}
+}
+
+// update 处理一根价格柱，返回值同 htCycle.update，ok 时 dcPhase 为本根的相位（度）。
+func (h *htPhase) update(price float64) (float64, bool) {
+	smoothedValue, ok := h.htCycle.update(price)
+	if !ok {
+		return 0, false
+	}
+	h.smoothPrice[h.smoothPriceIdx] = smoothedValue
+
+	// 对最近一个主导周期内的平滑价格做离散傅里叶变换
+	dcPeriodInt := int(h.smoothPeriod + 0.5)
+	realPart, imagPart := 0.0, 0.0
+	idx := h.smoothPriceIdx
+	for i := range dcPeriodInt {
+		tempReal := (float64(i) * constDeg2RadBy360) / float64(dcPeriodInt)
+		tempReal2 := h.smoothPrice[idx]
+		realPart += math.Sin(tempReal) * tempReal2
+		imagPart += math.Cos(tempReal) * tempReal2
+		if idx == 0 {
+			idx = htSmoothPriceSize - 1
+		} else {
+			idx--
+		}
+	}
+
+	// 虚部为0时沿用上一根的相位，与 TA-Lib 相同按实部的符号调整；虚部为 NaN 时两个分支都不进入
+	tempReal := math.Abs(imagPart)
+	if tempReal > 0.0 {
+		h.dcPhase = math.Atan(realPart/imagPart) * rad2Deg
+	} else if tempReal <= 0.01 {
+		if realPart < 0.0 {
+			h.dcPhase -= 90.0
+		} else if realPart > 0.0 {
+			h.dcPhase += 90.0
+		}
+	}
+	h.dcPhase += 90.0
+
+	// 补偿加权平均带来的一根延迟
+	h.dcPhase += 360.0 / h.smoothPeriod
+	if imagPart < 0.0 {
+		h.dcPhase += 180.0
+	}
+	if h.dcPhase > 315.0 {
+		h.dcPhase -= 360.0
+	}
+
+	h.smoothPriceIdx++
+	if h.smoothPriceIdx == htSmoothPriceSize {
+		h.smoothPriceIdx = 0
+	}
+	return smoothedValue, true
+}
+
+// htTrendline 保存瞬时趋势线的变量：当根之前一个主导周期内价格的均值，再做4根加权平滑。
+type htTrendline struct {
+	iTrend1, iTrend2, iTrend3 float64
+}
+
+// update 以 in[today] 为当根价格计算趋势线，smoothPeriod 为当根的主导周期。
+func (t *htTrendline) update(in []float64, today int, smoothPeriod float64) float64 {
+	dcPeriodInt := int(smoothPeriod + 0.5)
+	tempReal := 0.0
+	for idx := today; idx > today-dcPeriodInt; idx-- {
+		tempReal += in[idx]
+	}
+	if dcPeriodInt > 0 {
+		tempReal /= float64(dcPeriodInt)
+	}
+
+	trendline := (4.0*tempReal + 3.0*t.iTrend1 + 2.0*t.iTrend2 + t.iTrend3) / 10.0
+	t.iTrend3 = t.iTrend2
+	t.iTrend2 = t.iTrend1
+	t.iTrend1 = tempReal
+	return trendline
+}
+
+// nativeHTDCPERIODLookback 对应 TA_HT_DCPERIOD_Lookback。
+func nativeHTDCPERIODLookback() int {
+	return 32 + unstablePeriod(FuncUnstHTDCPeriod)
+}
+
+// nativeHTDCPHASELookback 对应 TA_HT_DCPHASE_Lookback。
+func nativeHTDCPHASELookback() int {
+	return 63 + unstablePeriod(FuncUnstHTDCPhase)
+}
+
+// nativeHTPHASORLookback 对应 TA_HT_PHASOR_Lookback。
+func nativeHTPHASORLookback() int {
+	return 32 + unstablePeriod(FuncUnstHTPhasor)
+}
+
+// nativeHTSINELookback 对应 TA_HT_SINE_Lookback。
+func nativeHTSINELookback() int {
+	return 63 + unstablePeriod(FuncUnstHTSine)
+}
+
+// nativeHTTRENDLINELookback 对应 TA_HT_TRENDLINE_Lookback。
+func nativeHTTRENDLINELookback() int {
+	return 63 + unstablePeriod(FuncUnstHTTrendline)
+}
+
+// nativeHTTRENDMODELookback 对应 TA_HT_TRENDMODE_Lookback。
+func nativeHTTRENDMODELookback() int {
+	return 63 + unstablePeriod(FuncUnstHTTrendMode)
+}
+
+// nativeHTDCPERIOD 是 TA_HT_DCPERIOD 的原生实现。
+func nativeHTDCPERIOD(close []float64) (int, []float64, error) {
+	startIdx := nativeHTDCPERIODLookback()
+	if startIdx > len(close)-1 {
+		return 0, nil, nil
+	}
+
+	h := newHTCycle(htCycleStart)
+	output := make([]float64, 0, len(close)-startIdx)
+	for today, v := range close {
+		// 不稳定期内照常递推，只是不输出
+		if _, ok := h.update(v); ok && today >= startIdx {
+			output = append(output, h.smoothPeriod)
+		}
+	}
+	return startIdx, output, nil
+}
+
+// nativeHTDCPHASE 是 TA_HT_DCPHASE 的原生实现。
+func nativeHTDCPHASE(close []float64) (int, []float64, error) {
+	startIdx := nativeHTDCPHASELookback()
+	if startIdx > len(close)-1 {
+		return 0, nil, nil
+	}
+
+	h := newHTPhase()
+	output := make([]float64, 0, len(close)-startIdx)
+	for today, v := range close {
+		if _, ok := h.update(v); ok && today >= startIdx {
+			output = append(output, h.dcPhase)
+		}
+	}
+	return startIdx, output, nil
+}
+
+// nativeHTPHASOR 是 TA_HT_PHASOR 的原生实现，返回同相分量与正交分量。
+func nativeHTPHASOR(close []float64) (int, []float64, []float64, error) {
+	startIdx := nativeHTPHASORLookback()
+	if startIdx > len(close)-1 {
+		return 0, nil, nil, nil
+	}
+
+	h := newHTCycle(htCycleStart)
+	outInPhase := make([]float64, 0, len(close)-startIdx)
+	outQuadrature := make([]float64, 0, len(close)-startIdx)
+	for today, v := range close {
+		if _, ok := h.update(v); ok && today >= startIdx {
+			outInPhase = append(outInPhase, h.inPhase)
+			outQuadrature = append(outQuadrature, h.q1.Value)
+		}
+	}
+	return startIdx, outInPhase, outQuadrature, nil
+}
+
+// nativeHTSINE 是 TA_HT_SINE 的原生实现，返回正弦线与超前45度的正弦线。
+func nativeHTSINE(close []float64) (int, []float64, []float64, error) {
+	startIdx := nativeHTSINELookback()
+	if startIdx > len(close)-1 {
+		return 0, nil, nil, nil
+	}
+
+	h := newHTPhase()
+	outSine := make([]float64, 0, len(close)-startIdx)
+	outLeadSine := make([]float64, 0, len(close)-startIdx)
+	for today, v := range close {
+		if _, ok := h.update(v); ok && today >= startIdx {
+			outSine = append(outSine, math.Sin(h.dcPhase*deg2Rad))
+			outLeadSine = append(outLeadSine, math.Sin((h.dcPhase+45)*deg2Rad))
+		}
+	}
+	return startIdx, outSine, outLeadSine, nil
+}
+
+// nativeHTTRENDLINE 是 TA_HT_TRENDLINE 的原生实现。
+func nativeHTTRENDLINE(close []float64) (int, []float64, error) {
+	startIdx := nativeHTTRENDLINELookback()
+	if startIdx > len(close)-1 {
+		return 0, nil, nil
+	}
+
+	h := newHTCycle(htPhaseStart)
+	var t htTrendline
+	output := make([]float64, 0, len(close)-startIdx)
+	for today, v := range close {
+		if _, ok := h.update(v); ok {
+			trendline := t.update(close, today, h.smoothPeriod)
+			if today >= startIdx {
+				output = append(output, trendline)
+			}
+		}
+	}
+	return startIdx, output, nil
+}
+
+// nativeHTTRENDMODE 是 TA_HT_TRENDMODE 的原生实现，1 表示趋势模式，0 表示周期模式。
+func nativeHTTRENDMODE(close []float64) (int, []int, error) {
+	startIdx := nativeHTTRENDMODELookback()
+	if startIdx > len(close)-1 {
+		return 0, nil, nil
+	}
+
+	h := newHTPhase()
+	var t htTrendline
+	var sine, leadSine, prevDCPhase float64
+	daysInTrend := 0
+	output := make([]int, 0, len(close)-startIdx)
+	for today, v := range close {
+		prevDCPhase = h.dcPhase
+		smoothedValue, ok := h.update(v)
+		if !ok {
+			continue
+		}
+		prevSine, prevLeadSine := sine, leadSine
+		sine = math.Sin(h.dcPhase * deg2Rad)
+		leadSine = math.Sin((h.dcPhase + 45) * deg2Rad)
+		trendline := t.update(close, today, h.smoothPeriod)
+
+		// 默认为趋势模式；正弦线与超前正弦线交叉时重新计数，交叉后不足半个周期视为周期模式
+		trend := 1
+		if (sine > leadSine && prevSine <= prevLeadSine) || (sine < leadSine && prevSine >= prevLeadSine) {
+			daysInTrend = 0
+			trend = 0
+		}
+		daysInTrend++
+		if float64(daysInTrend) < 0.5*h.smoothPeriod {
+			trend = 0
+		}
+
+		// 相位按主导周期的速度前进时为周期模式。0.67*360 须按双精度相乘，不能作为常量精确折叠
+		tempReal := h.dcPhase - prevDCPhase
+		lower, upper := 0.67, 1.5
+		if h.smoothPeriod != 0.0 && tempReal > lower*360.0/h.smoothPeriod && tempReal < upper*360.0/h.smoothPeriod {
+			trend = 0
+		}
+
+		// 价格偏离趋势线1.5%以上时总是趋势模式
+		if trendline != 0.0 && math.Abs((smoothedValue-trendline)/trendline) >= 0.015 {
+			trend = 1
+		}
+
+		if today >= startIdx {
+			output = append(output, trend)
+		}
+	}
+	return startIdx, output, nil
+}
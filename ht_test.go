@@ -0,0 +1,227 @@
+package go4ta
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// sineWave 返回周期为 period 根价格柱的正弦波，围绕 100 上下波动。
+func sineWave(n int, period float64) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = 100 + 10*math.Sin(2*math.Pi*float64(i)/period)
+	}
+	return out
+}
+
+func TestHTDCPeriod(t *testing.T) {
+	for _, period := range []float64{15, 20, 30} {
+		dc, err := HTDCPeriod(sineWave(400, period))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := dc[len(dc)-1]; math.Abs(got-period) > 2 {
+			t.Errorf("HTDCPeriod(sine %v) = %v", period, got)
+		}
+	}
+}
+
+func TestHTLookback(t *testing.T) {
+	resetSettings(t)
+	close := testBars().Close
+	lookbacks := []struct {
+		name     string
+		id       FuncUnstID
+		lookback func() int
+		want     int
+	}{
+		{"HTDCPeriod", FuncUnstHTDCPeriod, HTDCPeriodLookback, 32},
+		{"HTDCPhase", FuncUnstHTDCPhase, HTDCPhaseLookback, 63},
+		{"HTPhasor", FuncUnstHTPhasor, HTPhasorLookback, 32},
+		{"HTSine", FuncUnstHTSine, HTSineLookback, 63},
+		{"HTTrendline", FuncUnstHTTrendline, HTTrendlineLookback, 63},
+		{"HTTrendMode", FuncUnstHTTrendMode, HTTrendModeLookback, 63},
+	}
+	for _, l := range lookbacks {
+		if got := l.lookback(); got != l.want {
+			t.Errorf("%sLookback = %d, want %d", l.name, got, l.want)
+		}
+		if got, err := Lookback(l.name); err != nil || got != l.want {
+			t.Errorf("Lookback(%q) = %d, %v, want %d", l.name, got, err, l.want)
+		}
+	}
+
+	dc, _ := HTDCPeriod(close)
+	trendline, _ := HTTrendline(close)
+	for _, l := range lookbacks {
+		if err := SetUnstablePeriod(l.id, 5); err != nil {
+			t.Fatal(err)
+		}
+		if got := l.lookback(); got != l.want+5 {
+			t.Errorf("%sLookback with unstable period 5 = %d, want %d", l.name, got, l.want+5)
+		}
+	}
+	// 不稳定期只是多丢弃开头的值，之后的值不变
+	dcU, _ := HTDCPeriod(close)
+	checkShifted(t, "HTDCPeriod", dcU, dc, 37)
+	trendlineU, _ := HTTrendline(close)
+	checkShifted(t, "HTTrendline", trendlineU, trendline, 68)
+}
+
+func TestHTOutputs(t *testing.T) {
+	close := testBars().Close
+	n := len(close)
+
+	phasor, err := CalcHTPhasor(close)
+	if err != nil || phasor.Len() != n {
+		t.Fatalf("CalcHTPhasor = %d values, %v", phasor.Len(), err)
+	}
+	inPhase, quadrature, err := HTPhasor(close)
+	if err != nil || !equalFloats(inPhase, phasor.InPhase) || !equalFloats(quadrature, phasor.Quadrature) {
+		t.Errorf("HTPhasor differs from CalcHTPhasor: %v", err)
+	}
+
+	sine, err := CalcHTSine(close)
+	if err != nil || sine.Len() != n {
+		t.Fatalf("CalcHTSine = %d values, %v", sine.Len(), err)
+	}
+	for i := 63; i < n; i++ {
+		v := sine.At(i)
+		if math.Abs(v.Sine) > 1 || math.Abs(v.LeadSine) > 1 {
+			t.Fatalf("HTSine[%d] = %+v, want within [-1, 1]", i, v)
+		}
+	}
+
+	phase, err := HTDCPhase(close)
+	if err != nil || len(phase) != n {
+		t.Fatalf("HTDCPhase = %d values, %v", len(phase), err)
+	}
+	for i := 63; i < n; i++ {
+		if phase[i] < -45 || phase[i] > 315 {
+			t.Fatalf("HTDCPhase[%d] = %v, want within [-45, 315]", i, phase[i])
+		}
+	}
+
+	// 常数序列的趋势线就是该常数
+	flat := flatSeries("flat", 100, 50).close
+	trendline, err := HTTrendline(flat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 63; i < len(flat); i++ {
+		if math.Abs(trendline[i]-50) > 1e-9 {
+			t.Fatalf("HTTrendline(flat)[%d] = %v", i, trendline[i])
+		}
+	}
+
+	// 持续上涨的序列偏离趋势线，处于趋势模式
+	rising := make([]float64, 150)
+	for i := range rising {
+		rising[i] = 100 * math.Pow(1.01, float64(i))
+	}
+	mode, err := HTTrendMode(rising)
+	if err != nil || len(mode) != len(rising) {
+		t.Fatalf("HTTrendMode = %d values, %v", len(mode), err)
+	}
+	for i, v := range mode {
+		want := 1
+		if i < 63 {
+			want = 0
+		}
+		if v != want {
+			t.Fatalf("HTTrendMode(rising)[%d] = %d, want %d", i, v, want)
+		}
+	}
+
+	// 不足回看期时全部填充
+	short := close[:40]
+	if out, err := HTTrendline(short); err != nil || len(out) != 40 || out[39] != 0 {
+		t.Errorf("HTTrendline(40 bars) = %v, %v", out, err)
+	}
+	if dc, err := HTDCPeriod(short); err != nil || dc[31] != 0 || dc[32] == 0 {
+		t.Errorf("HTDCPeriod(40 bars) = %v, %v", dc, err)
+	}
+
+	if out, err := HTTrendMode([]float64{}); err != nil || len(out) != 0 {
+		t.Errorf("HTTrendMode(empty) = %v, %v", out, err)
+	}
+	if r, err := CalcHTSine(nil); err != nil || r.Len() != 0 {
+		t.Errorf("CalcHTSine(empty) = %+v, %v", r, err)
+	}
+}
+
+func TestCycleMA(t *testing.T) {
+	s := randomWalk(rand.New(rand.NewSource(1)), "trending", 300, 100, 0.002, 0.01, 0)
+
+	dc, err := HTDCPeriod(s.close)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := CycleMA(s.close, 6, 50, MATypeEMA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lookback, err := CycleMALookback(6, 50, MATypeEMA)
+	if err != nil || lookback != 49 {
+		t.Fatalf("CycleMALookback(6, 50, EMA) = %d, %v, want 49", lookback, err)
+	}
+
+	// 与按主导周期逐根计算的 MAVP 相同
+	_, want, err := nativeMAVP(s.close, dc, 6, 50, int(MATypeEMA))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range got {
+		if i < lookback {
+			if v != 0 {
+				t.Fatalf("CycleMA[%d] = %v within lookback %d", i, v, lookback)
+			}
+		} else if math.Abs(v-want[i-lookback]) > 1e-9 {
+			t.Fatalf("CycleMA[%d] = %v, want %v", i, v, want[i-lookback])
+		}
+	}
+
+	// 周期较短时以主导周期的回看期为准
+	if lookback, err := CycleMALookback(2, 10, MATypeSMA); err != nil || lookback != 32 {
+		t.Errorf("CycleMALookback(2, 10, SMA) = %d, %v, want 32", lookback, err)
+	}
+	if got, err := Lookback("CycleMA", 2, 10, 0); err != nil || got != 32 {
+		t.Errorf("Lookback(CycleMA) = %d, %v, want 32", got, err)
+	}
+
+	withFillPolicy(t, FillTrim, func() {
+		trimmed, err := CycleMA(s.close, 6, 50, MATypeEMA)
+		if err != nil || !equalFloats(trimmed, got[lookback:]) {
+			t.Errorf("CycleMA with FillTrim = %d values, %v", len(trimmed), err)
+		}
+	})
+
+	var fe *FuncError
+	if _, err := CycleMA(s.close, 1, 50, MATypeEMA); !errors.As(err, &fe) || !errors.Is(err, ErrBadParam) || fe.Param != "minPeriod" {
+		t.Errorf("CycleMA(minPeriod 1) = %v", err)
+	}
+	// minPeriod 大于 maxPeriod 时在计算主导周期之前就拒绝
+	if _, err := CycleMA(s.close[:40], 17, 5, MATypeTEMA); !errors.As(err, &fe) || fe.Func != "TA_MAVP" || fe.Param != "maxPeriod" {
+		t.Errorf("CycleMA(minPeriod 17, maxPeriod 5) = %v", err)
+	}
+	if _, err := CycleMA(s.close, 17, 5, MATypeSMA); !errors.Is(err, ErrBadParam) {
+		t.Errorf("CycleMA(minPeriod 17, maxPeriod 5) = %v", err)
+	}
+	if _, err := CycleMALookback(17, 5, MATypeSMA); !errors.Is(err, ErrBadParam) {
+		t.Errorf("CycleMALookback(17, 5) = %v", err)
+	}
+	if _, err := Lookback("CycleMA", 17, 5); !errors.Is(err, ErrBadParam) {
+		t.Errorf("Lookback(CycleMA, 17, 5) = %v", err)
+	}
+	if _, err := CycleMA(s.close, 6, 50, MAType(9)); !errors.Is(err, ErrBadParam) {
+		t.Errorf("CycleMA(maType 9) = %v", err)
+	}
+	if _, err := CycleMALookback(6, 1, MATypeEMA); !errors.Is(err, ErrBadParam) {
+		t.Errorf("CycleMALookback(maxPeriod 1) = %v", err)
+	}
+	if out, err := CycleMA([]float64{}, 6, 50, MATypeEMA); err != nil || len(out) != 0 {
+		t.Errorf("CycleMA(empty) = %v, %v", out, err)
+	}
+}
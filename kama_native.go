@@ -0,0 +1,27 @@
+package go4ta
+
+// nativeKAMA 是 TA_KAMA 的原生实现，计算见 ma_native.go 中的 intKAMA。
+func nativeKAMA(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := kamaParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intKAMA(close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeKAMALookback 对应 TA_KAMA_Lookback，参数无效时返回 -1。
+func nativeKAMALookback(timePeriod int) int {
+	timePeriod, err := kamaParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return kamaLookback(timePeriod)
+}
+
+// kamaParams 按 TA_KAMA 的规则处理参数。
+func kamaParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_KAMA"}
+	timePeriod = c.integer("timePeriod", timePeriod, 30, 2, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,95 @@
+package go4ta
+
+// Keltner 计算肯特纳通道（Keltner Channels），按位置返回各输出，结果含义见 CalcKeltner。
+//
+// @param high, low, close - 价格序列
+// @param timePeriod       - 中轨均线周期（如20）
+// @param atrPeriod        - ATR 周期（如10）
+// @param multiplier       - ATR 倍数（如2）
+// @param maType           - 中轨均线类型（通常为 MATypeEMA）
+// @return upper, middle, lower - 三个与输入等长的结果序列
+// @return error           - 如果输入数据无效或计算失败，则返回错误。
+func Keltner(high, low, close []float64, timePeriod, atrPeriod int, multiplier float64, maType MAType) (upper, middle, lower []float64, err error) {
+	r, err := CalcKeltner(high, low, close, timePeriod, atrPeriod, multiplier, maType)
+	return r.Upper, r.Middle, r.Lower, err
+}
+
+// CalcKeltner 计算肯特纳通道：中轨为收盘价的均线（MA），上下轨为中轨加减 multiplier 倍的 ATR。
+// 两者都由与 MA、ATR 相同的计算得出，因此同样受不稳定期与兼容模式的影响。
+//
+// @param high, low, close - 价格序列
+// @param timePeriod       - 中轨均线周期（如20）
+// @param atrPeriod        - ATR 周期（如10）
+// @param multiplier       - ATR 倍数（如2）
+// @param maType           - 中轨均线类型（通常为 MATypeEMA）
+// @return KeltnerResult   - 上、中、下轨，与输入等长，中轨与 ATR 都有效之前按 SetFillPolicy 的设置填充，默认为0。
+// @return error           - 如果输入数据无效或计算失败，则返回错误。
+func CalcKeltner(high, low, close []float64, timePeriod, atrPeriod int, multiplier float64, maType MAType) (KeltnerResult, error) {
+	n, err := checkInputs("Keltner", "high, low, close", high, low, close)
+	if err != nil {
+		return KeltnerResult{}, err
+	}
+	if n == 0 {
+		return KeltnerResult{[]float64{}, []float64{}, []float64{}}, nil
+	}
+	if n < timePeriod {
+		return KeltnerResult{}, tooShort("Keltner", n, "timePeriod", timePeriod)
+	}
+	if n < atrPeriod {
+		return KeltnerResult{}, tooShort("Keltner", n, "atrPeriod", atrPeriod)
+	}
+
+	if err := checkParams("KELTNER", float64(timePeriod), float64(atrPeriod), multiplier, float64(maType)); err != nil {
+		return KeltnerResult{}, err
+	}
+
+	maBegIdx, ma, err := taMA(close, timePeriod, int(maType))
+	if err != nil {
+		return KeltnerResult{}, err
+	}
+	atrBegIdx, atr, err := taATR(high, low, close, atrPeriod)
+	if err != nil {
+		return KeltnerResult{}, err
+	}
+
+	// 从中轨与 ATR 都有效的位置开始组合
+	var begIdx int
+	var outUpper, outMiddle, outLower []float64
+	if len(ma) > 0 && len(atr) > 0 {
+		begIdx = max(maBegIdx, atrBegIdx)
+		outMiddle = ma[begIdx-maBegIdx:]
+		atr = atr[begIdx-atrBegIdx:]
+		outUpper = make([]float64, len(outMiddle))
+		outLower = make([]float64, len(outMiddle))
+		for i, mid := range outMiddle {
+			outUpper[i] = mid + multiplier*atr[i]
+			outLower[i] = mid - multiplier*atr[i]
+		}
+	}
+
+	w := newWarmup(0)
+	return KeltnerResult{
+		Upper:  w.spread(n, begIdx, outUpper),
+		Middle: w.spread(n, begIdx, outMiddle),
+		Lower:  w.spread(n, begIdx, outLower),
+	}, nil
+}
+
+// KeltnerLookback 返回 Keltner 的回看期，即 MA(timePeriod, maType) 与 ATR(atrPeriod) 回看期中较长的一个。
+//
+// @param timePeriod - 中轨均线周期
+// @param atrPeriod  - ATR 周期
+// @param maType     - 中轨均线类型
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func KeltnerLookback(timePeriod, atrPeriod int, maType MAType) (int, error) {
+	maLookback, err := MALookback(timePeriod, maType)
+	if err != nil {
+		return 0, err
+	}
+	atrLookback, err := ATRLookback(atrPeriod)
+	if err != nil {
+		return 0, err
+	}
+	return max(maLookback, atrLookback), nil
+}
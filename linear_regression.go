@@ -0,0 +1,87 @@
+package go4ta
+
+import "math"
+
+// CalcLinearRegression 对每个以当根结尾、长度为 timePeriod 的窗口做最小二乘回归，
+// 一次给出 LINEARREG 一族的全部输出以及拟合优度。回归系数与 TA-Lib 的算法相同，
+// 以 TA-Lib 构建时结果与 LinearReg、LinearRegSlope 等函数一致。
+//
+// R² 为 1-SSE/SST，窗口内各值相同时为0；估计标准误差为 sqrt(SSE/(timePeriod-2))，
+// 因此 timePeriod 不小于3。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 回归窗口长度（如14），不小于3
+// @return LinearRegressionResult - 与输入等长的各结果序列，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或参数超出范围，则返回错误。
+func CalcLinearRegression(close []float64, timePeriod int) (LinearRegressionResult, error) {
+	n := len(close)
+	if n == 0 {
+		empty := []float64{}
+		return LinearRegressionResult{empty, empty, empty, empty, empty, empty, empty}, nil
+	}
+	if n < timePeriod {
+		return LinearRegressionResult{}, tooShort("LinearRegression", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("LINEARREGRESSION", float64(timePeriod)); err != nil {
+		return LinearRegressionResult{}, err
+	}
+
+	begIdx := timePeriod - 1
+	size := n - begIdx
+	value := make([]float64, 0, size)
+	slope := make([]float64, 0, size)
+	intercept := make([]float64, 0, size)
+	angle := make([]float64, 0, size)
+	forecast := make([]float64, 0, size)
+	rSquared := make([]float64, 0, size)
+	stdErr := make([]float64, 0, size)
+
+	period := float64(timePeriod)
+	for today := begIdx; today < n; today++ {
+		m, b := linearRegAt(close, today, timePeriod)
+		mean := b + m*(period-1)/2
+		sse, sst := 0.0, 0.0
+		for j, y := range close[today-begIdx : today+1] {
+			e := y - (b + m*float64(j))
+			d := y - mean
+			sse += e * e
+			sst += d * d
+		}
+		r2 := 0.0
+		if sst > 0 {
+			r2 = max(1-sse/sst, 0)
+		}
+
+		value = append(value, b+m*(period-1))
+		slope = append(slope, m)
+		intercept = append(intercept, b)
+		angle = append(angle, math.Atan(m)*(180/math.Pi))
+		forecast = append(forecast, b+m*period)
+		rSquared = append(rSquared, r2)
+		stdErr = append(stdErr, math.Sqrt(sse/(period-2)))
+	}
+
+	w := newWarmup(0)
+	return LinearRegressionResult{
+		Value:     w.spread(n, begIdx, value),
+		Slope:     w.spread(n, begIdx, slope),
+		Intercept: w.spread(n, begIdx, intercept),
+		Angle:     w.spread(n, begIdx, angle),
+		Forecast:  w.spread(n, begIdx, forecast),
+		RSquared:  w.spread(n, begIdx, rSquared),
+		StdErr:    w.spread(n, begIdx, stdErr),
+	}, nil
+}
+
+// LinearRegressionLookback 返回 CalcLinearRegression 的回看期 timePeriod-1。
+//
+// @param timePeriod - 回归窗口长度
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func LinearRegressionLookback(timePeriod int) (int, error) {
+	if timePeriod < 3 || timePeriod > 100000 {
+		return 0, badParam("LinearRegression", "timePeriod", timePeriod)
+	}
+	return timePeriod - 1, nil
+}
@@ -0,0 +1,38 @@
+package go4ta
+
+// LinearRegAngle 计算线性回归角度（LINEARREG_ANGLE），即回归斜率的反正切，以度表示。
+// 角度依赖价格与价格柱间隔的相对量纲，只适合在同一品种内比较。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - 角度序列，取值在 (-90, 90) 之间，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func LinearRegAngle(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("LinearRegAngle", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("LINEARREGANGLE", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taLINEARREGANGLE(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// LinearRegAngleLookback 返回 LinearRegAngle 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func LinearRegAngleLookback(timePeriod int) (int, error) {
+	_, err := linearregAngleParams(timePeriod)
+	return lookbackResult("TA_LINEARREG_ANGLE", taLINEARREGANGLELookback(timePeriod), err)
+}
@@ -0,0 +1,46 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taLINEARREGANGLE 调用 TA_LINEARREG_ANGLE。
+func taLINEARREGANGLE(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_LINEARREG_ANGLE(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := linearregAngleParams(timePeriod)
+		return 0, nil, taErr("TA_LINEARREG_ANGLE", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taLINEARREGANGLELookback 调用 TA_LINEARREG_ANGLE_Lookback，参数无效时返回 -1。
+func taLINEARREGANGLELookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_LINEARREG_ANGLE_Lookback(C.int(timePeriod)))
+}
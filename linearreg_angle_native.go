@@ -0,0 +1,31 @@
+package go4ta
+
+import "math"
+
+// nativeLINEARREGANGLE 是 TA_LINEARREG_ANGLE 的原生实现。
+func nativeLINEARREGANGLE(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := linearregAngleParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	begIdx, output := intLinearReg(close, timePeriod, func(m, b float64) float64 {
+		return math.Atan(m) * (180 / math.Pi)
+	})
+	return begIdx, output, nil
+}
+
+// nativeLINEARREGANGLELookback 对应 TA_LINEARREG_ANGLE_Lookback，参数无效时返回 -1。
+func nativeLINEARREGANGLELookback(timePeriod int) int {
+	timePeriod, err := linearregAngleParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// linearregAngleParams 按 TA_LINEARREG_ANGLE 的规则处理参数。
+func linearregAngleParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_LINEARREG_ANGLE"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
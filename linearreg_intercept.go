@@ -0,0 +1,37 @@
+package go4ta
+
+// LinearRegIntercept 计算线性回归截距（LINEARREG_INTERCEPT），即回归直线在窗口内最早一根价格柱处的取值。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - 截距序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func LinearRegIntercept(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("LinearRegIntercept", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("LINEARREGINTERCEPT", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taLINEARREGINTERCEPT(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// LinearRegInterceptLookback 返回 LinearRegIntercept 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func LinearRegInterceptLookback(timePeriod int) (int, error) {
+	_, err := linearregInterceptParams(timePeriod)
+	return lookbackResult("TA_LINEARREG_INTERCEPT", taLINEARREGINTERCEPTLookback(timePeriod), err)
+}
@@ -0,0 +1,29 @@
+package go4ta
+
+// nativeLINEARREGINTERCEPT 是 TA_LINEARREG_INTERCEPT 的原生实现。
+func nativeLINEARREGINTERCEPT(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := linearregInterceptParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	begIdx, output := intLinearReg(close, timePeriod, func(m, b float64) float64 {
+		return b
+	})
+	return begIdx, output, nil
+}
+
+// nativeLINEARREGINTERCEPTLookback 对应 TA_LINEARREG_INTERCEPT_Lookback，参数无效时返回 -1。
+func nativeLINEARREGINTERCEPTLookback(timePeriod int) int {
+	timePeriod, err := linearregInterceptParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// linearregInterceptParams 按 TA_LINEARREG_INTERCEPT 的规则处理参数。
+func linearregInterceptParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_LINEARREG_INTERCEPT"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,66 @@
+package go4ta
+
+// nativeLINEARREG 是 TA_LINEARREG 的原生实现。
+func nativeLINEARREG(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := linearregParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	begIdx, output := intLinearReg(close, timePeriod, func(m, b float64) float64 {
+		return b + m*float64(timePeriod-1)
+	})
+	return begIdx, output, nil
+}
+
+// intLinearReg 对每个完整窗口做回归，以 value(m, b) 作为输出，timePeriod 须已通过检查。
+// LINEARREG 一族的函数只在取哪个值上不同。
+func intLinearReg(close []float64, timePeriod int, value func(m, b float64) float64) (int, []float64) {
+	startIdx := timePeriod - 1
+	if startIdx > len(close)-1 {
+		return 0, nil
+	}
+
+	output := make([]float64, 0, len(close)-startIdx)
+	for today := startIdx; today < len(close); today++ {
+		output = append(output, value(linearRegAt(close, today, timePeriod)))
+	}
+	return startIdx, output
+}
+
+// linearRegAt 对以 today 结尾的 timePeriod 个值做最小二乘回归，返回斜率 m 与截距 b，
+// 与 TA-Lib 相同，x 轴从窗口内最早的值开始计数。
+func linearRegAt(in []float64, today, timePeriod int) (float64, float64) {
+	period := float64(timePeriod)
+	sumX := float64(timePeriod*(timePeriod-1)) * 0.5
+	sumXSqr := float64(timePeriod * (timePeriod - 1) * (2*timePeriod - 1) / 6)
+	divisor := sumX*sumX - period*sumXSqr
+
+	sumXY := 0.0
+	sumY := 0.0
+	for i := timePeriod; i != 0; {
+		i--
+		tempValue1 := in[today-i]
+		sumY += tempValue1
+		sumXY += float64(i) * tempValue1
+	}
+	m := (period*sumXY - sumX*sumY) / divisor
+	b := (sumY - m*sumX) / period
+	return m, b
+}
+
+// nativeLINEARREGLookback 对应 TA_LINEARREG_Lookback，参数无效时返回 -1。
+func nativeLINEARREGLookback(timePeriod int) int {
+	timePeriod, err := linearregParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// linearregParams 按 TA_LINEARREG 的规则处理参数。
+func linearregParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_LINEARREG"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,29 @@
+package go4ta
+
+// nativeLINEARREGSLOPE 是 TA_LINEARREG_SLOPE 的原生实现。
+func nativeLINEARREGSLOPE(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := linearregSlopeParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	begIdx, output := intLinearReg(close, timePeriod, func(m, b float64) float64 {
+		return m
+	})
+	return begIdx, output, nil
+}
+
+// nativeLINEARREGSLOPELookback 对应 TA_LINEARREG_SLOPE_Lookback，参数无效时返回 -1。
+func nativeLINEARREGSLOPELookback(timePeriod int) int {
+	timePeriod, err := linearregSlopeParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// linearregSlopeParams 按 TA_LINEARREG_SLOPE 的规则处理参数。
+func linearregSlopeParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_LINEARREG_SLOPE"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
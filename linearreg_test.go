@@ -2,6 +2,8 @@ package go4ta
 
 import (
 	"encoding/csv"
+	"errors"
+	"math"
 	"os"
 	"strconv"
 	"testing"
@@ -62,3 +64,120 @@ func TestLinearReg(t *testing.T) {
 		}
 	}
 }
+
+func TestLinearRegFamily(t *testing.T) {
+	file, err := os.Open("test_data/linearreg.csv")
+	if err != nil {
+		t.Fatalf("无法打开测试数据文件: %v", err)
+	}
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatalf("无法读取CSV数据: %v", err)
+	}
+
+	// 跳过表头；第3~5列依次为 LINEARREG_ANGLE、LINEARREG_INTERCEPT、LINEARREG_SLOPE
+	records = records[1:]
+	closeVals := make([]float64, len(records))
+	for i, record := range records {
+		closeVals[i], _ = strconv.ParseFloat(record[1], 64)
+	}
+
+	r, err := CalcLinearRegression(closeVals, 14)
+	if err != nil {
+		t.Fatalf("CalcLinearRegression计算失败: %v", err)
+	}
+	for _, c := range []struct {
+		name string
+		col  int
+		f    func([]float64, int) ([]float64, error)
+		calc []float64
+	}{
+		{"LinearRegAngle", 3, LinearRegAngle, r.Angle},
+		{"LinearRegIntercept", 4, LinearRegIntercept, r.Intercept},
+		{"LinearRegSlope", 5, LinearRegSlope, r.Slope},
+	} {
+		result, err := c.f(closeVals, 14)
+		if err != nil {
+			t.Fatalf("%s计算失败: %v", c.name, err)
+		}
+		if !equalFloats(result, c.calc) {
+			t.Errorf("%s 与 CalcLinearRegression 的结果不同", c.name)
+		}
+		for i, record := range records {
+			if record[c.col] == "" {
+				if result[i] != 0 {
+					t.Errorf("%s[%d] 期望0, 实际%f", c.name, i, result[i])
+				}
+				continue
+			}
+			exp, _ := strconv.ParseFloat(record[c.col], 64)
+			if math.Abs(result[i]-exp) > 1e-6*max(1, math.Abs(exp)) {
+				t.Errorf("%s[%d] 期望%f, 实际%f", c.name, i, exp, result[i])
+			}
+		}
+	}
+
+	// TSF 即回归直线延伸一根，等于 LinearReg 加斜率
+	tsf, err := TSF(closeVals, 14)
+	if err != nil {
+		t.Fatalf("TSF计算失败: %v", err)
+	}
+	value, _ := LinearReg(closeVals, 14)
+	for i := 13; i < len(closeVals); i++ {
+		if math.Abs(tsf[i]-(value[i]+r.Slope[i])) > 1e-6 || tsf[i] != r.Forecast[i] || value[i] != r.Value[i] {
+			t.Errorf("[%d] TSF %f, LinearReg %f, 斜率 %f 不一致", i, tsf[i], value[i], r.Slope[i])
+		}
+	}
+
+	// 简单回归的 R² 等于相关系数的平方，估计标准误差可由残差直接求得
+	for i := 13; i < len(closeVals); i++ {
+		window := closeVals[i-13 : i+1]
+		var sx, sy, sxx, syy, sxy float64
+		for x, y := range window {
+			fx := float64(x)
+			sx, sy, sxx, syy, sxy = sx+fx, sy+y, sxx+fx*fx, syy+y*y, sxy+fx*y
+		}
+		cov := sxy - sx*sy/14
+		r2 := cov * cov / ((sxx - sx*sx/14) * (syy - sy*sy/14))
+		if math.Abs(r.RSquared[i]-r2) > 1e-9 {
+			t.Errorf("RSquared[%d] = %f, 期望%f", i, r.RSquared[i], r2)
+		}
+		sse := 0.0
+		for x, y := range window {
+			e := y - (r.Intercept[i] + r.Slope[i]*float64(x))
+			sse += e * e
+		}
+		if se := math.Sqrt(sse / 12); math.Abs(r.StdErr[i]-se) > 1e-9*max(1, se) {
+			t.Errorf("StdErr[%d] = %f, 期望%f", i, r.StdErr[i], se)
+		}
+	}
+
+	// 恰好落在直线上的序列完全拟合，水平序列的 R² 记为0
+	line := []float64{1, 3, 5, 7, 9}
+	fit, err := CalcLinearRegression(line, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := fit.Last(); math.Abs(v.Slope-2) > 1e-12 || math.Abs(v.RSquared-1) > 1e-12 || v.StdErr > 1e-12 || math.Abs(v.Forecast-11) > 1e-12 {
+		t.Errorf("直线序列的回归结果 %+v", v)
+	}
+	flat, _ := CalcLinearRegression([]float64{2, 2, 2, 2}, 3)
+	if v := flat.Last(); v.RSquared != 0 || v.Slope != 0 || v.Value != 2 {
+		t.Errorf("水平序列的回归结果 %+v", v)
+	}
+
+	if lookback, err := LinearRegressionLookback(14); err != nil || lookback != 13 {
+		t.Errorf("LinearRegressionLookback(14) = %d, %v", lookback, err)
+	}
+	var fe *FuncError
+	if _, err := CalcLinearRegression(closeVals, 2); !errors.As(err, &fe) || fe.Func != "LinearRegression" || fe.Param != "timePeriod" {
+		t.Errorf("CalcLinearRegression(2) = %v", err)
+	}
+	if _, err := LinearRegressionLookback(2); !errors.Is(err, ErrBadParam) {
+		t.Errorf("LinearRegressionLookback(2) = %v", err)
+	}
+	if _, err := TSF(closeVals, 1); !errors.As(err, &fe) || fe.Func != "TA_TSF" {
+		t.Errorf("TSF(1) = %v", err)
+	}
+}
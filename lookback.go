@@ -0,0 +1,55 @@
+package go4ta
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Lookback 按指标名称查询回看期，供数据加载器决定需要预取多少根历史价格柱：
+// 要得到 n 个有效值，至少需要 lookback+n 根价格柱。
+//
+// 名称与导出函数相同且不区分大小写（如 "MACD"、"BBands"、"SuperTrend"），
+// params 按导出函数中参数的顺序给出，省略的末尾参数与 TA-Lib 一样取默认值。
+//
+// @param name    - 指标名称
+// @param params  - 指标参数
+// @return int    - 回看期
+// @return error  - 名称未知或参数无效时返回错误
+func Lookback(name string, params ...float64) (int, error) {
+	ind, ok := indicators[strings.ToUpper(name)]
+	if !ok {
+		return 0, &FuncError{Func: name, Code: RetFuncNotFound}
+	}
+	if len(params) > len(ind.Params) {
+		return 0, fmt.Errorf("%s takes at most %d parameters, got %d: %w", name, len(ind.Params), len(params), ErrBadParam)
+	}
+	// 取值范围由各 XXXLookback 检查，与回看期无关的参数（如 SuperTrend 的 multiplier）可以省略
+	for i, v := range params {
+		if ind.Params[i].Type != ParamReal && v != math.Trunc(v) {
+			return 0, badParam(name, ind.Params[i].Name, v)
+		}
+	}
+	return ind.lookback(lookbackParams(params))
+}
+
+// lookbackParams 是传给 Lookback 的参数，超出长度的参数返回 TA-Lib 的默认值标记。
+type lookbackParams []float64
+
+func (p lookbackParams) int(i int) int {
+	if i >= len(p) {
+		return taIntegerDefault
+	}
+	return int(p[i])
+}
+
+func (p lookbackParams) real(i int) float64 {
+	if i >= len(p) {
+		return taRealDefault
+	}
+	return p[i]
+}
+
+func (p lookbackParams) maType(i int) MAType {
+	return MAType(p.int(i))
+}
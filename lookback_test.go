@@ -0,0 +1,154 @@
+package go4ta
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestLookbackMatchesBegIdx 检查各 XXXLookback 与实际计算时第一个有效值的下标一致。
+func TestLookbackMatchesBegIdx(t *testing.T) {
+	s := randomWalk(rand.New(rand.NewSource(1)), "trending", 300, 100, 0.002, 0.01, 0)
+	check := func(name string, params any, lookback int, lookbackErr error, begIdx int, err error) {
+		t.Helper()
+		if lookbackErr != nil || err != nil {
+			t.Fatalf("%s %v: lookback error %v, calculation error %v", name, params, lookbackErr, err)
+		}
+		if lookback != begIdx {
+			t.Errorf("%s %v: lookback %d, first valid index %d", name, params, lookback, begIdx)
+		}
+	}
+	for _, p := range []int{2, 5, 30} {
+		for maType := 0; maType <= 8; maType++ {
+			lookback, lookbackErr := MALookback(p, MAType(maType))
+			begIdx, _, err := taMA(s.close, p, maType)
+			check("MA", [2]int{p, maType}, lookback, lookbackErr, begIdx, err)
+
+			lookback, lookbackErr = BBandsLookback(p, 2, 2, MAType(maType))
+			begIdx, _, _, _, err = taBBands(s.close, p, 2, 2, maType)
+			check("BBands", [2]int{p, maType}, lookback, lookbackErr, begIdx, err)
+
+			lookback, lookbackErr = APOLookback(p, 26, MAType(maType))
+			begIdx, _, err = taAPO(s.close, p, 26, maType)
+			check("APO", [3]int{p, 26, maType}, lookback, lookbackErr, begIdx, err)
+
+			lookback, lookbackErr = PPOLookback(p, 26, MAType(maType))
+			begIdx, _, err = taPPO(s.close, p, 26, maType)
+			check("PPO", [3]int{p, 26, maType}, lookback, lookbackErr, begIdx, err)
+
+			lookback, lookbackErr = STOCHLookback(p, 3, 3, MAType(maType), MAType(maType))
+			begIdx, _, _, err = taSTOCH(s.high, s.low, s.close, p, 3, 3, maType, maType)
+			check("STOCH", [3]int{p, 3, maType}, lookback, lookbackErr, begIdx, err)
+
+			lookback, lookbackErr = STOCHRSILookback(14, p, 3, MAType(maType))
+			begIdx, _, _, err = taSTOCHRSI(s.close, 14, p, 3, maType)
+			check("STOCHRSI", [3]int{14, p, maType}, lookback, lookbackErr, begIdx, err)
+		}
+
+		lookback, lookbackErr := RSILookback(p)
+		begIdx, _, err := taRSI(s.close, p)
+		check("RSI", p, lookback, lookbackErr, begIdx, err)
+
+		lookback, lookbackErr = ATRLookback(p)
+		begIdx, _, err = taATR(s.high, s.low, s.close, p)
+		check("ATR", p, lookback, lookbackErr, begIdx, err)
+
+		lookback, lookbackErr = ADXLookback(p)
+		begIdx, _, err = taADX(s.high, s.low, s.close, p)
+		check("ADX", p, lookback, lookbackErr, begIdx, err)
+
+		lookback, lookbackErr = STDDEVLookback(p, 1)
+		begIdx, _, err = taSTDDEV(s.close, p, 1)
+		check("STDDEV", p, lookback, lookbackErr, begIdx, err)
+
+		lookback, lookbackErr = LinearRegLookback(p)
+		begIdx, _, err = taLINEARREG(s.close, p)
+		check("LinearReg", p, lookback, lookbackErr, begIdx, err)
+
+		lookback, lookbackErr = MACDLookback(p, 26, 9)
+		begIdx, _, _, _, err = taMACD(s.close, p, 26, 9)
+		check("MACD", [3]int{p, 26, 9}, lookback, lookbackErr, begIdx, err)
+	}
+
+	begIdx, _, err := taOBV(s.close, s.volume)
+	check("OBV", nil, OBVLookback(), nil, begIdx, err)
+	begIdx, _, err = taAD(s.high, s.low, s.close, s.volume)
+	check("AD", nil, ADLookback(), nil, begIdx, err)
+
+	// SuperTrend 在回看期内输出 NaN
+	superTrend, _, _, _, err := SuperTrend(s.high, s.low, s.close, 7, 3)
+	lookback, lookbackErr := SuperTrendLookback(7)
+	if err != nil || lookbackErr != nil {
+		t.Fatalf("SuperTrend: %v, %v", err, lookbackErr)
+	}
+	for i, v := range superTrend {
+		if (i < lookback) != (v != v) {
+			t.Errorf("SuperTrend[%d] = %v with lookback %d", i, v, lookback)
+		}
+	}
+}
+
+func TestLookbackByName(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []float64
+		want   func() (int, error)
+	}{
+		{"MA", []float64{10, 3}, func() (int, error) { return MALookback(10, 3) }},
+		{"ma", []float64{10}, func() (int, error) { return MALookback(10, 0) }},
+		{"EMA", []float64{20}, func() (int, error) { return EMALookback(20) }},
+		{"SMA", nil, func() (int, error) { return SMALookback(30) }},
+		{"WMA", []float64{5}, func() (int, error) { return WMALookback(5) }},
+		{"RSI", nil, func() (int, error) { return RSILookback(14) }},
+		{"MACD", []float64{12, 26, 9}, func() (int, error) { return MACDLookback(12, 26, 9) }},
+		{"BBands", []float64{20, 2.5, 1.5, 4}, func() (int, error) { return BBandsLookback(20, 2.5, 1.5, 4) }},
+		{"ATR", []float64{7}, func() (int, error) { return ATRLookback(7) }},
+		{"ADX", []float64{14}, func() (int, error) { return ADXLookback(14) }},
+		{"STOCH", []float64{14, 3, 5, 1, 2}, func() (int, error) { return STOCHLookback(14, 3, 5, 1, 2) }},
+		{"STOCHRSI", []float64{14, 5, 3}, func() (int, error) { return STOCHRSILookback(14, 5, 3, 0) }},
+		{"OBV", nil, func() (int, error) { return OBVLookback(), nil }},
+		{"AD", nil, func() (int, error) { return ADLookback(), nil }},
+		{"APO", []float64{12, 26, 1}, func() (int, error) { return APOLookback(12, 26, 1) }},
+		{"PPO", []float64{12, 26, 1}, func() (int, error) { return PPOLookback(12, 26, 1) }},
+		{"PPOWithSignal", []float64{12, 26, 9, 1}, func() (int, error) { return PPOWithSignalLookback(12, 26, 9, 1) }},
+		{"STDDEV", []float64{20, 2}, func() (int, error) { return STDDEVLookback(20, 2) }},
+		{"LinearReg", []float64{14}, func() (int, error) { return LinearRegLookback(14) }},
+		{"SuperTrend", []float64{10, 3}, func() (int, error) { return SuperTrendLookback(10) }},
+	}
+	for _, tt := range tests {
+		want, err := tt.want()
+		if err != nil {
+			t.Fatalf("%s %v: %v", tt.name, tt.params, err)
+		}
+		got, err := Lookback(tt.name, tt.params...)
+		if err != nil || got != want {
+			t.Errorf("Lookback(%q, %v) = %d, %v, want %d", tt.name, tt.params, got, err, want)
+		}
+	}
+}
+
+func TestLookbackInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []float64
+	}{
+		{"KDJ", nil},
+		{"RSI", []float64{1}},
+		{"RSI", []float64{14.5}},
+		{"RSI", []float64{14, 1}},
+		{"MA", []float64{10, 9}},
+		{"BBands", []float64{20, 2, 2, 1.5}},
+		{"MACD", []float64{12, 26, 0}},
+		{"SuperTrend", nil},
+	}
+	for _, tt := range tests {
+		if got, err := Lookback(tt.name, tt.params...); err == nil {
+			t.Errorf("Lookback(%q, %v) = %d, want error", tt.name, tt.params, got)
+		}
+	}
+	if _, err := MALookback(0, 0); err == nil {
+		t.Error("MALookback(0, 0) should fail")
+	}
+	if _, err := PPOWithSignalLookback(12, 26, 0, 0); err == nil {
+		t.Error("PPOWithSignalLookback with signal period 0 should fail")
+	}
+}
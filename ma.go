@@ -1,69 +1,40 @@
 package go4ta
 
-/*
-#cgo LDFLAGS: -lta-lib -lm
-#include <ta-lib/ta_libc.h>
-#include <ta-lib/ta_func.h>
-#include <stdlib.h>
-*/
-import "C"
-import (
-	"fmt"
-	"unsafe"
-)
-
-// MA 使用 CGO 直接调用 TA-Lib C 库来计算移动平均线（支持SMA、EMA等）。
+// MA 计算移动平均线（支持SMA、EMA等）。
+// MATypeMAMA 与 MATypeT3 使用 TA-Lib 的默认参数，需要指定快慢极限或成交量因子时改用 MAMA、T3。
 //
 // @param close      - 收盘价序列
 // @param timePeriod - 计算周期（如20）
-// @param maType     - 均线类型（如0=SMA，1=EMA等，见TA-Lib文档）
-//
-//	0: SMA (简单移动平均)
-//	1: EMA (指数移动平均)
-//	2: WMA (加权移动平均)
-//	3: DEMA (双指数移动平均)
-//	4: TEMA (三指数移动平均)
-//	5: TRIMA (三角移动平均)
-//	6: KAMA (考夫曼自适应移动平均)
-//	7: MAMA (MESA 自适应移动平均)
-//	8: T3 (三倍平滑移动平均)
-//
-// @return []float64 - MA结果序列，与输入等长，未计算部分为0。
+// @param maType     - 均线类型，如 MATypeSMA、MATypeEMA，取值见 MAType
+// @return []float64 - MA结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
 // @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
-func MA(close []float64, timePeriod int, maType int) ([]float64, error) {
+func MA(close []float64, timePeriod int, maType MAType) ([]float64, error) {
 	if len(close) == 0 {
 		return []float64{}, nil
 	}
 	if len(close) < timePeriod {
-		return nil, fmt.Errorf("input data length (%d) is too small for the given timePeriod (%d)", len(close), timePeriod)
+		return nil, tooShort("MA", len(close), "timePeriod", timePeriod)
 	}
 
-	cClose := (*C.double)(unsafe.Pointer(&close[0]))
-	output := make([]C.double, len(close))
-	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
-
-	outBegIdx := C.int(0)
-	outNBElement := C.int(0)
-
-	retCode := C.TA_MA(
-		0,
-		C.int(len(close)-1),
-		cClose,
-		C.int(timePeriod),
-		C.TA_MAType(maType),
-		&outBegIdx,
-		&outNBElement,
-		cOutput,
-	)
-
-	if retCode != C.TA_SUCCESS {
-		return nil, fmt.Errorf("TA-Lib C call failed with exit code: %d", retCode)
+	if err := checkParams("MA", float64(timePeriod), float64(maType)); err != nil {
+		return nil, err
 	}
 
-	result := make([]float64, len(close))
-	for i := 0; i < int(outNBElement); i++ {
-		result[int(outBegIdx)+i] = float64(output[i])
+	outBegIdx, output, err := taMA(close, timePeriod, int(maType))
+	if err != nil {
+		return nil, err
 	}
 
-	return result, nil
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// MALookback 返回 MA 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @param maType     - 均线类型
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MALookback(timePeriod int, maType MAType) (int, error) {
+	_, _, err := maParams(timePeriod, int(maType))
+	return lookbackResult("TA_MA", taMALookback(timePeriod, int(maType)), err)
 }
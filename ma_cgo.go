@@ -0,0 +1,47 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taMA 调用 TA_MA，返回第一个有效值的下标以及从该下标起紧凑排列的结果。
+func taMA(close []float64, timePeriod int, maType int) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MA(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		C.TA_MAType(maType),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, paramErr := maParams(timePeriod, maType)
+		return 0, nil, taErr("TA_MA", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taMALookback 调用 TA_MA_Lookback，参数无效时返回 -1。
+func taMALookback(timePeriod, maType int) int {
+	defer readSettings()()
+	return int(C.TA_MA_Lookback(C.int(timePeriod), C.TA_MAType(maType)))
+}
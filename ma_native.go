@@ -0,0 +1,506 @@
+package go4ta
+
+import "math"
+
+// nativeMA 是 TA_MA 的原生实现。
+func nativeMA(close []float64, timePeriod int, maType int) (int, []float64, error) {
+	timePeriod, maType, err := maParams(timePeriod, maType)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intMA(close, timePeriod, maType)
+	return outBegIdx, output, nil
+}
+
+// maLookback 对应 TA_MA_Lookback。
+func maLookback(timePeriod, maType int) int {
+	if timePeriod <= 1 {
+		return 0
+	}
+	switch maType {
+	case 0, 2, 5:
+		return timePeriod - 1
+	case 1:
+		return emaLookback(timePeriod)
+	case 3:
+		return emaLookback(timePeriod) * 2
+	case 4:
+		return emaLookback(timePeriod) * 3
+	case 6:
+		return kamaLookback(timePeriod)
+	case 7:
+		return mamaLookback()
+	case 8:
+		return t3Lookback(timePeriod)
+	}
+	return 0
+}
+
+// intMA 按均线类型分派计算，参数须已校验。周期为1时与 TA-Lib 一样直接复制输入。
+func intMA(in []float64, timePeriod, maType int) (int, []float64) {
+	if timePeriod == 1 {
+		return 0, append([]float64(nil), in...)
+	}
+	switch maType {
+	case 0:
+		return intSMA(in, timePeriod)
+	case 1:
+		return intEMA(in, 0, timePeriod, perToK(timePeriod))
+	case 2:
+		return intWMA(in, timePeriod)
+	case 3:
+		return intDEMA(in, 0, timePeriod)
+	case 4:
+		return intTEMA(in, 0, timePeriod)
+	case 5:
+		return intTRIMA(in, timePeriod)
+	case 6:
+		return intKAMA(in, timePeriod)
+	case 7:
+		outBegIdx, mama, _ := intMAMA(in, 0.5, 0.05)
+		return outBegIdx, mama
+	case 8:
+		return intT3(in, timePeriod, 0.7)
+	}
+	return 0, nil
+}
+
+// intMAFrom 对应以 startIdx 调用 TA_MA，第一个输出不早于 startIdx，参数须已校验。
+// EMA 一族的种子位置取决于 startIdx（MetaStock 兼容模式下则固定为第一个输入值），
+// 其余均线只用到 startIdx 之前一个回看期内的数据，从那里开始计算即可。
+// MAMA 按下标的奇偶计算，只有从第一个输入开始时才与 TA-Lib 一致，MAVP 中总是如此。
+func intMAFrom(in []float64, startIdx, timePeriod, maType int) (int, []float64) {
+	if timePeriod > 1 {
+		switch maType {
+		case 1:
+			return intEMA(in, startIdx, timePeriod, perToK(timePeriod))
+		case 3:
+			return intDEMA(in, startIdx, timePeriod)
+		case 4:
+			return intTEMA(in, startIdx, timePeriod)
+		}
+	}
+	from := max(startIdx-maLookback(timePeriod, maType), 0)
+	outBegIdx, output := intMA(in[from:], timePeriod, maType)
+	return from + outBegIdx, output
+}
+
+func intSMA(in []float64, timePeriod int) (int, []float64) {
+	lookbackTotal := timePeriod - 1
+	if lookbackTotal >= len(in) {
+		return 0, nil
+	}
+
+	output := make([]float64, 0, len(in)-lookbackTotal)
+	periodTotal := 0.0
+	trailingIdx := 0
+	i := 0
+	if timePeriod > 1 {
+		for i < lookbackTotal {
+			periodTotal += in[i]
+			i++
+		}
+	}
+	for ; i < len(in); i++ {
+		periodTotal += in[i]
+		tempReal := periodTotal
+		periodTotal -= in[trailingIdx]
+		trailingIdx++
+		output = append(output, tempReal/float64(timePeriod))
+	}
+	return lookbackTotal, output
+}
+
+func emaLookback(timePeriod int) int {
+	return timePeriod - 1 + unstablePeriod(FuncUnstEMA)
+}
+
+// intEMA 对应 TA_INT_EMA。startIdx 决定初始 SMA 种子的位置，MACD 依赖这一点；
+// MetaStock 兼容模式下种子固定为第一个输入值。
+func intEMA(in []float64, startIdx, timePeriod int, k float64) (int, []float64) {
+	lookbackTotal := emaLookback(timePeriod)
+	if startIdx < lookbackTotal {
+		startIdx = lookbackTotal
+	}
+	if startIdx > len(in)-1 {
+		return 0, nil
+	}
+
+	var today int
+	var prevMA float64
+	if !metastock() {
+		today = startIdx - lookbackTotal
+		tempReal := 0.0
+		for i := timePeriod; i > 0; i-- {
+			tempReal += in[today]
+			today++
+		}
+		prevMA = tempReal / float64(timePeriod)
+	} else {
+		prevMA = in[0]
+		today = 1
+	}
+
+	for today <= startIdx {
+		prevMA = ((in[today] - prevMA) * k) + prevMA
+		today++
+	}
+
+	output := make([]float64, 0, len(in)-startIdx)
+	output = append(output, prevMA)
+	for today < len(in) {
+		prevMA = ((in[today] - prevMA) * k) + prevMA
+		today++
+		output = append(output, prevMA)
+	}
+	return startIdx, output
+}
+
+func intWMA(in []float64, timePeriod int) (int, []float64) {
+	lookbackTotal := timePeriod - 1
+	if lookbackTotal >= len(in) {
+		return 0, nil
+	}
+
+	output := make([]float64, 0, len(in)-lookbackTotal)
+	divider := float64((timePeriod * (timePeriod + 1)) >> 1)
+	trailingIdx := 0
+	periodSub := 0.0
+	periodSum := 0.0
+	inIdx := 0
+	for i := 1; inIdx < lookbackTotal; i++ {
+		tempReal := in[inIdx]
+		inIdx++
+		periodSub += tempReal
+		periodSum += tempReal * float64(i)
+	}
+
+	trailingValue := 0.0
+	for inIdx < len(in) {
+		tempReal := in[inIdx]
+		inIdx++
+		periodSub += tempReal
+		periodSub -= trailingValue
+		periodSum += tempReal * float64(timePeriod)
+		trailingValue = in[trailingIdx]
+		trailingIdx++
+		output = append(output, periodSum/divider)
+		periodSum -= periodSub
+	}
+	return lookbackTotal, output
+}
+
+// intDEMA 对应 TA_DEMA，与 intEMA 相同，startIdx 决定第一条 EMA 种子的位置。
+func intDEMA(in []float64, startIdx, timePeriod int) (int, []float64) {
+	lookbackEMA := emaLookback(timePeriod)
+	k := perToK(timePeriod)
+	if startIdx < lookbackEMA*2 {
+		startIdx = lookbackEMA * 2
+	}
+
+	firstBegIdx, firstEMA := intEMA(in, startIdx-lookbackEMA, timePeriod, k)
+	if len(firstEMA) == 0 {
+		return 0, nil
+	}
+	secondBegIdx, output := intEMA(firstEMA, 0, timePeriod, k)
+	if len(output) == 0 {
+		return 0, nil
+	}
+
+	firstEMAIdx := secondBegIdx
+	for i := range output {
+		output[i] = (2.0 * firstEMA[firstEMAIdx]) - output[i]
+		firstEMAIdx++
+	}
+	return firstBegIdx + secondBegIdx, output
+}
+
+// intTEMA 对应 TA_TEMA，startIdx 的含义同 intDEMA。
+func intTEMA(in []float64, startIdx, timePeriod int) (int, []float64) {
+	lookbackEMA := emaLookback(timePeriod)
+	k := perToK(timePeriod)
+	if startIdx < lookbackEMA*3 {
+		startIdx = lookbackEMA * 3
+	}
+
+	firstBegIdx, firstEMA := intEMA(in, startIdx-2*lookbackEMA, timePeriod, k)
+	if len(firstEMA) == 0 {
+		return 0, nil
+	}
+	secondBegIdx, secondEMA := intEMA(firstEMA, 0, timePeriod, k)
+	if len(secondEMA) == 0 {
+		return 0, nil
+	}
+	thirdBegIdx, output := intEMA(secondEMA, 0, timePeriod, k)
+	if len(output) == 0 {
+		return 0, nil
+	}
+
+	firstEMAIdx := thirdBegIdx + secondBegIdx
+	secondEMAIdx := thirdBegIdx
+	outBegIdx := firstEMAIdx + firstBegIdx
+	for i := range output {
+		output[i] += (3.0 * firstEMA[firstEMAIdx]) - (3.0 * secondEMA[secondEMAIdx])
+		firstEMAIdx++
+		secondEMAIdx++
+	}
+	return outBegIdx, output
+}
+
+func intTRIMA(in []float64, timePeriod int) (int, []float64) {
+	lookbackTotal := timePeriod - 1
+	if lookbackTotal >= len(in) {
+		return 0, nil
+	}
+
+	output := make([]float64, 0, len(in)-lookbackTotal)
+	half := timePeriod >> 1
+	odd := timePeriod%2 == 1
+
+	var factor float64
+	trailingIdx := 0
+	middleIdx := trailingIdx + half
+	if odd {
+		factor = 1.0 / float64((half+1)*(half+1))
+	} else {
+		factor = 1.0 / float64(half*(half+1))
+		middleIdx--
+	}
+	todayIdx := middleIdx + half
+
+	numerator := 0.0
+	numeratorSub := 0.0
+	for i := middleIdx; i >= trailingIdx; i-- {
+		numeratorSub += in[i]
+		numerator += numeratorSub
+	}
+	numeratorAdd := 0.0
+	middleIdx++
+	for i := middleIdx; i <= todayIdx; i++ {
+		numeratorAdd += in[i]
+		numerator += numeratorAdd
+	}
+
+	tempReal := in[trailingIdx]
+	trailingIdx++
+	output = append(output, numerator*factor)
+	todayIdx++
+
+	for todayIdx < len(in) {
+		numerator -= numeratorSub
+		numeratorSub -= tempReal
+		tempReal = in[middleIdx]
+		middleIdx++
+		numeratorSub += tempReal
+
+		if odd {
+			numerator += numeratorAdd
+			numeratorAdd -= tempReal
+		} else {
+			numeratorAdd -= tempReal
+			numerator += numeratorAdd
+		}
+		tempReal = in[todayIdx]
+		todayIdx++
+		numeratorAdd += tempReal
+
+		numerator += tempReal
+
+		tempReal = in[trailingIdx]
+		trailingIdx++
+		output = append(output, numerator*factor)
+	}
+	return lookbackTotal, output
+}
+
+func kamaLookback(timePeriod int) int {
+	return timePeriod + unstablePeriod(FuncUnstKAMA)
+}
+
+func intKAMA(in []float64, timePeriod int) (int, []float64) {
+	constMax := 2.0 / (30.0 + 1.0)
+	constDiff := 2.0/(2.0+1.0) - constMax
+
+	lookbackTotal := kamaLookback(timePeriod)
+	startIdx := lookbackTotal
+	if startIdx > len(in)-1 {
+		return 0, nil
+	}
+
+	// 计算效率系数对应的平滑常数
+	smooth := func(sumROC1, periodROC float64) float64 {
+		var tempReal float64
+		if sumROC1 <= periodROC || isZero(sumROC1) {
+			tempReal = 1.0
+		} else {
+			tempReal = math.Abs(periodROC / sumROC1)
+		}
+		tempReal = (tempReal * constDiff) + constMax
+		return tempReal * tempReal
+	}
+
+	sumROC1 := 0.0
+	today := startIdx - lookbackTotal
+	trailingIdx := today
+	for i := timePeriod; i > 0; i-- {
+		tempReal := in[today]
+		today++
+		tempReal -= in[today]
+		sumROC1 += math.Abs(tempReal)
+	}
+
+	prevKAMA := in[today-1]
+	tempReal := in[today]
+	tempReal2 := in[trailingIdx]
+	trailingIdx++
+	periodROC := tempReal - tempReal2
+	trailingValue := tempReal2
+	prevKAMA = ((in[today] - prevKAMA) * smooth(sumROC1, periodROC)) + prevKAMA
+	today++
+
+	step := func() {
+		tempReal := in[today]
+		tempReal2 := in[trailingIdx]
+		trailingIdx++
+		periodROC := tempReal - tempReal2
+		sumROC1 -= math.Abs(trailingValue - tempReal2)
+		sumROC1 += math.Abs(tempReal - in[today-1])
+		trailingValue = tempReal2
+		prevKAMA = ((in[today] - prevKAMA) * smooth(sumROC1, periodROC)) + prevKAMA
+		today++
+	}
+
+	for today <= startIdx {
+		step()
+	}
+
+	output := make([]float64, 0, len(in)-startIdx)
+	output = append(output, prevKAMA)
+	outBegIdx := today - 1
+	for today < len(in) {
+		step()
+		output = append(output, prevKAMA)
+	}
+	return outBegIdx, output
+}
+
+func t3Lookback(timePeriod int) int {
+	return 6*(timePeriod-1) + unstablePeriod(FuncUnstT3)
+}
+
+func intT3(in []float64, timePeriod int, vFactor float64) (int, []float64) {
+	lookbackTotal := t3Lookback(timePeriod)
+	startIdx := lookbackTotal
+	if startIdx > len(in)-1 {
+		return 0, nil
+	}
+
+	today := startIdx - lookbackTotal
+	k := 2.0 / (float64(timePeriod) + 1.0)
+	oneMinusK := 1.0 - k
+	period := float64(timePeriod)
+
+	tempReal := in[today]
+	today++
+	for i := timePeriod - 1; i > 0; i-- {
+		tempReal += in[today]
+		today++
+	}
+	e1 := tempReal / period
+
+	tempReal = e1
+	for i := timePeriod - 1; i > 0; i-- {
+		e1 = (k * in[today]) + (oneMinusK * e1)
+		today++
+		tempReal += e1
+	}
+	e2 := tempReal / period
+
+	tempReal = e2
+	for i := timePeriod - 1; i > 0; i-- {
+		e1 = (k * in[today]) + (oneMinusK * e1)
+		today++
+		e2 = (k * e1) + (oneMinusK * e2)
+		tempReal += e2
+	}
+	e3 := tempReal / period
+
+	tempReal = e3
+	for i := timePeriod - 1; i > 0; i-- {
+		e1 = (k * in[today]) + (oneMinusK * e1)
+		today++
+		e2 = (k * e1) + (oneMinusK * e2)
+		e3 = (k * e2) + (oneMinusK * e3)
+		tempReal += e3
+	}
+	e4 := tempReal / period
+
+	tempReal = e4
+	for i := timePeriod - 1; i > 0; i-- {
+		e1 = (k * in[today]) + (oneMinusK * e1)
+		today++
+		e2 = (k * e1) + (oneMinusK * e2)
+		e3 = (k * e2) + (oneMinusK * e3)
+		e4 = (k * e3) + (oneMinusK * e4)
+		tempReal += e4
+	}
+	e5 := tempReal / period
+
+	tempReal = e5
+	for i := timePeriod - 1; i > 0; i-- {
+		e1 = (k * in[today]) + (oneMinusK * e1)
+		today++
+		e2 = (k * e1) + (oneMinusK * e2)
+		e3 = (k * e2) + (oneMinusK * e3)
+		e4 = (k * e3) + (oneMinusK * e4)
+		e5 = (k * e4) + (oneMinusK * e5)
+		tempReal += e5
+	}
+	e6 := tempReal / period
+
+	next := func() {
+		e1 = (k * in[today]) + (oneMinusK * e1)
+		today++
+		e2 = (k * e1) + (oneMinusK * e2)
+		e3 = (k * e2) + (oneMinusK * e3)
+		e4 = (k * e3) + (oneMinusK * e4)
+		e5 = (k * e4) + (oneMinusK * e5)
+		e6 = (k * e5) + (oneMinusK * e6)
+	}
+
+	// 跳过不稳定期
+	for today <= startIdx {
+		next()
+	}
+
+	tempReal = vFactor * vFactor
+	c1 := -(tempReal * vFactor)
+	c2 := 3.0 * (tempReal - c1)
+	c3 := -6.0*tempReal - 3.0*(vFactor-c1)
+	c4 := 1.0 + 3.0*vFactor - c1 + 3.0*tempReal
+
+	output := make([]float64, 0, len(in)-startIdx)
+	output = append(output, c1*e6+c2*e5+c3*e4+c4*e3)
+	for today < len(in) {
+		next()
+		output = append(output, c1*e6+c2*e5+c3*e4+c4*e3)
+	}
+	return startIdx, output
+}
+
+// nativeMALookback 对应 TA_MA_Lookback，参数无效时返回 -1。
+func nativeMALookback(timePeriod, maType int) int {
+	timePeriod, maType, err := maParams(timePeriod, maType)
+	if err != nil {
+		return -1
+	}
+	return maLookback(timePeriod, maType)
+}
+
+// maParams 按 TA_MA 的规则处理参数。
+func maParams(timePeriod, maType int) (int, int, error) {
+	c := paramCheck{fn: "TA_MA"}
+	timePeriod = c.integer("timePeriod", timePeriod, 30, 1, 100000)
+	maType = c.maType("maType", maType)
+	return timePeriod, maType, c.err
+}
@@ -0,0 +1,492 @@
+package go4ta
+
+import "math"
+
+// MAStream 是 MA 的流式版本，支持全部9种均线类型。
+type MAStream struct {
+	state maState
+	value float64
+	ready bool
+}
+
+// NewMAStream 创建移动平均线流式指标。
+//
+// @param timePeriod - 计算周期
+// @param maType     - 均线类型，见 MAType
+// @return *MAStream - 流式指标
+// @return error     - 参数无效时返回错误
+func NewMAStream(timePeriod int, maType MAType) (*MAStream, error) {
+	p, ok := optInteger(timePeriod, 30, 1, 100000)
+	if !ok {
+		return nil, badParam("NewMAStream", "timePeriod", timePeriod)
+	}
+	t, ok := optMAType(int(maType))
+	if !ok {
+		return nil, badParam("NewMAStream", "maType", int(maType))
+	}
+	return &MAStream{state: newMAState(p, t)}, nil
+}
+
+// Update 输入一个新的收盘价，返回最新的均线值，未就绪时返回 0。
+func (s *MAStream) Update(close float64) float64 {
+	s.value, s.ready = s.state.update(close)
+	return s.value
+}
+
+// Value 返回最近一次 Update 的结果。
+func (s *MAStream) Value() float64 {
+	return s.value
+}
+
+// Ready 表示当前值是否已越过回看期。
+func (s *MAStream) Ready() bool {
+	return s.ready
+}
+
+func (s *MAStream) snapshot() *streamSnapshot {
+	return &streamSnapshot{kind: "MA", values: []*float64{&s.value}, ready: &s.ready, state: &s.state}
+}
+
+// MarshalBinary 将完整的计算状态编码为二进制快照。
+func (s *MAStream) MarshalBinary() ([]byte, error) {
+	return s.snapshot().marshalBinary()
+}
+
+// UnmarshalBinary 从 MarshalBinary 生成的快照恢复状态。
+func (s *MAStream) UnmarshalBinary(data []byte) error {
+	return s.snapshot().unmarshalBinary(data)
+}
+
+// MarshalJSON 将完整的计算状态编码为 JSON 快照。
+func (s *MAStream) MarshalJSON() ([]byte, error) {
+	return s.snapshot().marshalJSON()
+}
+
+// UnmarshalJSON 从 MarshalJSON 生成的快照恢复状态。
+func (s *MAStream) UnmarshalJSON(data []byte) error {
+	return s.snapshot().unmarshalJSON(data)
+}
+
+// maState 按均线类型保存对应的增量状态，只有与 Type 对应的字段非空。
+// 周期为1时与批量函数一样直接输出输入值。
+type maState struct {
+	Type   int
+	Period int
+	SMA    *smaState
+	EMA    *emaState
+	WMA    *wmaState
+	DEMA   *demaState
+	TEMA   *temaState
+	TRIMA  *trimaState
+	KAMA   *kamaState
+	MAMA   *mamaState
+	T3     *t3State
+}
+
+// newMAState 创建均线的增量状态，参数须已校验。
+func newMAState(timePeriod, maType int) maState {
+	s := maState{Type: maType, Period: timePeriod}
+	if timePeriod == 1 {
+		return s
+	}
+	switch maType {
+	case 0:
+		s.SMA = newSMAState(timePeriod)
+	case 1:
+		s.EMA = newEMAState(timePeriod)
+	case 2:
+		s.WMA = newWMAState(timePeriod)
+	case 3:
+		s.DEMA = &demaState{First: *newEMAState(timePeriod), Second: *newEMAState(timePeriod)}
+	case 4:
+		s.TEMA = &temaState{First: *newEMAState(timePeriod), Second: *newEMAState(timePeriod), Third: *newEMAState(timePeriod)}
+	case 5:
+		s.TRIMA = newTRIMAState(timePeriod)
+	case 6:
+		s.KAMA = newKAMAState(timePeriod)
+	case 7:
+		s.MAMA = newMAMAState(0.5, 0.05)
+	case 8:
+		s.T3 = newT3State(timePeriod, 0.7)
+	}
+	return s
+}
+
+func (s *maState) update(x float64) (float64, bool) {
+	if s.Period == 1 {
+		return x, true
+	}
+	switch s.Type {
+	case 0:
+		return s.SMA.update(x)
+	case 1:
+		return s.EMA.update(x)
+	case 2:
+		return s.WMA.update(x)
+	case 3:
+		return s.DEMA.update(x)
+	case 4:
+		return s.TEMA.update(x)
+	case 5:
+		return s.TRIMA.update(x)
+	case 6:
+		return s.KAMA.update(x)
+	case 7:
+		mama, _, ok := s.MAMA.update(x)
+		return mama, ok
+	case 8:
+		return s.T3.update(x)
+	}
+	return 0, false
+}
+
+// lookback 返回该均线在默认设置下的回看期。流式指标不受 SetUnstablePeriod 与 SetCompatibility 影响，
+// 因此不能使用读取全局设置的 maLookback。
+func (s *maState) lookback() int {
+	p := s.Period
+	if p == 1 {
+		return 0
+	}
+	switch s.Type {
+	case 0, 1, 2, 5:
+		return p - 1
+	case 3:
+		return 2 * (p - 1)
+	case 4:
+		return 3 * (p - 1)
+	case 6:
+		return p
+	case 7:
+		return mamaWarmup
+	case 8:
+		return 6 * (p - 1)
+	}
+	return 0
+}
+
+func (s *maState) checkState() error {
+	if !validMAType(s.Type) || s.Period < 1 {
+		return stateErr("bad moving average type %d or period %d", s.Type, s.Period)
+	}
+	if s.Period == 1 {
+		return nil
+	}
+	states := []bool{s.SMA != nil, s.EMA != nil, s.WMA != nil, s.DEMA != nil, s.TEMA != nil,
+		s.TRIMA != nil, s.KAMA != nil, s.MAMA != nil, s.T3 != nil}
+	if !states[s.Type] {
+		return stateErr("missing state of moving average type %d", s.Type)
+	}
+	return nil
+}
+
+// smaState 对应 intSMA：先累加前 timePeriod-1 个值，之后每次加入新值、输出、再减去最旧的值。
+type smaState struct {
+	Period int
+	Count  int
+	Total  float64
+	Window ring
+}
+
+func newSMAState(timePeriod int) *smaState {
+	return &smaState{Period: timePeriod, Window: newRing(timePeriod)}
+}
+
+func (s *smaState) checkState() error {
+	return s.Window.checkSize(s.Period)
+}
+
+func (s *smaState) update(x float64) (float64, bool) {
+	s.Window.push(x)
+	s.Count++
+	s.Total += x
+	if s.Count < s.Period {
+		return 0, false
+	}
+	tempReal := s.Total
+	s.Total -= s.Window.back(s.Period - 1)
+	return tempReal / float64(s.Period), true
+}
+
+// emaState 对应 intEMA：以前 timePeriod 个值的 SMA 作为种子，之后按 k 递推。
+type emaState struct {
+	Period int
+	K      float64
+	Count  int
+	Value  float64 // 种子累加期间保存累加和
+}
+
+func newEMAState(timePeriod int) *emaState {
+	return &emaState{Period: timePeriod, K: perToK(timePeriod)}
+}
+
+func (s *emaState) update(x float64) (float64, bool) {
+	s.Count++
+	if s.Count < s.Period {
+		s.Value += x
+		return 0, false
+	}
+	if s.Count == s.Period {
+		s.Value += x
+		s.Value /= float64(s.Period)
+	} else {
+		s.Value = ((x - s.Value) * s.K) + s.Value
+	}
+	return s.Value, true
+}
+
+// wmaState 对应 intWMA 中 periodSub/periodSum 的递推。
+type wmaState struct {
+	Period        int
+	Count         int
+	Sub, Sum      float64
+	TrailingValue float64
+	Window        ring
+}
+
+func newWMAState(timePeriod int) *wmaState {
+	return &wmaState{Period: timePeriod, Window: newRing(timePeriod)}
+}
+
+func (s *wmaState) checkState() error {
+	return s.Window.checkSize(s.Period)
+}
+
+func (s *wmaState) update(x float64) (float64, bool) {
+	s.Window.push(x)
+	s.Count++
+	if s.Count < s.Period {
+		s.Sub += x
+		s.Sum += x * float64(s.Count)
+		return 0, false
+	}
+	s.Sub += x
+	s.Sub -= s.TrailingValue
+	s.Sum += x * float64(s.Period)
+	s.TrailingValue = s.Window.back(s.Period - 1)
+	out := s.Sum / float64((s.Period*(s.Period+1))>>1)
+	s.Sum -= s.Sub
+	return out, true
+}
+
+// demaState 是两级串联的 EMA。
+type demaState struct {
+	First, Second emaState
+}
+
+func (s *demaState) update(x float64) (float64, bool) {
+	e1, ok := s.First.update(x)
+	if !ok {
+		return 0, false
+	}
+	e2, ok := s.Second.update(e1)
+	if !ok {
+		return 0, false
+	}
+	return (2.0 * e1) - e2, true
+}
+
+// temaState 是三级串联的 EMA。
+type temaState struct {
+	First, Second, Third emaState
+}
+
+func (s *temaState) update(x float64) (float64, bool) {
+	e1, ok := s.First.update(x)
+	if !ok {
+		return 0, false
+	}
+	e2, ok := s.Second.update(e1)
+	if !ok {
+		return 0, false
+	}
+	e3, ok := s.Third.update(e2)
+	if !ok {
+		return 0, false
+	}
+	return e3 + ((3.0 * e1) - (3.0 * e2)), true
+}
+
+// trimaState 对应 intTRIMA 的分子递推，窗口保存最近 timePeriod+1 个值。
+type trimaState struct {
+	Period       int
+	Count        int
+	Numerator    float64
+	NumeratorSub float64
+	NumeratorAdd float64
+	Window       ring
+}
+
+func newTRIMAState(timePeriod int) *trimaState {
+	return &trimaState{Period: timePeriod, Window: newRing(timePeriod + 1)}
+}
+
+func (s *trimaState) checkState() error {
+	return s.Window.checkSize(s.Period + 1)
+}
+
+func (s *trimaState) update(x float64) (float64, bool) {
+	s.Window.push(x)
+	s.Count++
+	if s.Count < s.Period {
+		return 0, false
+	}
+
+	p := s.Period
+	half := p >> 1
+	odd := p%2 == 1
+	var factor float64
+	if odd {
+		factor = 1.0 / float64((half+1)*(half+1))
+	} else {
+		factor = 1.0 / float64(half*(half+1))
+	}
+	if s.Count == p {
+		// in(i) 是前 timePeriod 个值中的第 i 个
+		in := func(i int) float64 { return s.Window.back(p - 1 - i) }
+		middleIdx := half
+		if !odd {
+			middleIdx--
+		}
+		for i := middleIdx; i >= 0; i-- {
+			s.NumeratorSub += in(i)
+			s.Numerator += s.NumeratorSub
+		}
+		for i := middleIdx + 1; i < p; i++ {
+			s.NumeratorAdd += in(i)
+			s.Numerator += s.NumeratorAdd
+		}
+		return s.Numerator * factor, true
+	}
+
+	// 中间位置的值从加项移到减项，最旧的值移出窗口
+	middle := p - half
+	if odd {
+		middle--
+	}
+	s.Numerator -= s.NumeratorSub
+	s.NumeratorSub -= s.Window.back(p)
+	tempReal := s.Window.back(middle)
+	s.NumeratorSub += tempReal
+	if odd {
+		s.Numerator += s.NumeratorAdd
+		s.NumeratorAdd -= tempReal
+	} else {
+		s.NumeratorAdd -= tempReal
+		s.Numerator += s.NumeratorAdd
+	}
+	s.NumeratorAdd += x
+	s.Numerator += x
+	return s.Numerator * factor, true
+}
+
+// kamaState 对应 intKAMA，窗口保存最近 timePeriod+2 个值。
+type kamaState struct {
+	Period   int
+	Count    int
+	SumROC1  float64
+	PrevKAMA float64
+	Window   ring
+}
+
+func newKAMAState(timePeriod int) *kamaState {
+	return &kamaState{Period: timePeriod, Window: newRing(timePeriod + 2)}
+}
+
+func (s *kamaState) checkState() error {
+	return s.Window.checkSize(s.Period + 2)
+}
+
+func (s *kamaState) update(x float64) (float64, bool) {
+	s.Window.push(x)
+	s.Count++
+	p := s.Period
+	if s.Count <= p {
+		return 0, false
+	}
+
+	constMax := 2.0 / (30.0 + 1.0)
+	constDiff := 2.0/(2.0+1.0) - constMax
+
+	if s.Count == p+1 {
+		for i := p; i > 0; i-- {
+			s.SumROC1 += math.Abs(s.Window.back(i) - s.Window.back(i-1))
+		}
+		s.PrevKAMA = s.Window.back(1)
+	} else {
+		// 最旧的差值移出窗口，最新的差值加入
+		s.SumROC1 -= math.Abs(s.Window.back(p+1) - s.Window.back(p))
+		s.SumROC1 += math.Abs(x - s.Window.back(1))
+	}
+	periodROC := x - s.Window.back(p)
+
+	var tempReal float64
+	if s.SumROC1 <= periodROC || isZero(s.SumROC1) {
+		tempReal = 1.0
+	} else {
+		tempReal = math.Abs(periodROC / s.SumROC1)
+	}
+	tempReal = (tempReal * constDiff) + constMax
+	tempReal *= tempReal
+	s.PrevKAMA = ((x - s.PrevKAMA) * tempReal) + s.PrevKAMA
+	return s.PrevKAMA, true
+}
+
+// t3State 对应 intT3：六级 EMA 依次用前一级的 timePeriod 个值的平均作为种子。
+type t3State struct {
+	Period  int
+	VFactor float64
+	Phase   int // 0..5 为正在初始化第 Phase+1 级 EMA，6 表示已就绪
+	Left    int // 当前阶段还需要的价格柱数
+	Sum     float64
+	E       [6]float64
+}
+
+func newT3State(timePeriod int, vFactor float64) *t3State {
+	return &t3State{Period: timePeriod, VFactor: vFactor, Left: timePeriod}
+}
+
+func (s *t3State) checkState() error {
+	if s.Phase < 0 || s.Phase > 6 {
+		return stateErr("bad T3 phase %d", s.Phase)
+	}
+	return nil
+}
+
+func (s *t3State) update(x float64) (float64, bool) {
+	k := 2.0 / (float64(s.Period) + 1.0)
+	oneMinusK := 1.0 - k
+	period := float64(s.Period)
+
+	// 已完成初始化的各级 EMA 先按新值递推
+	if s.Phase > 0 {
+		s.E[0] = (k * x) + (oneMinusK * s.E[0])
+		for j := 1; j < s.Phase; j++ {
+			s.E[j] = (k * s.E[j-1]) + (oneMinusK * s.E[j])
+		}
+	}
+	if s.Phase < 6 {
+		if s.Phase == 0 {
+			s.Sum += x
+		} else {
+			s.Sum += s.E[s.Phase-1]
+		}
+		s.Left--
+		for s.Left == 0 && s.Phase < 6 {
+			s.E[s.Phase] = s.Sum / period
+			s.Sum = s.E[s.Phase]
+			s.Phase++
+			s.Left = s.Period - 1
+		}
+		if s.Phase < 6 {
+			return 0, false
+		}
+	}
+
+	v := s.VFactor
+	tempReal := v * v
+	c1 := -(tempReal * v)
+	c2 := 3.0 * (tempReal - c1)
+	c3 := -6.0*tempReal - 3.0*(v-c1)
+	c4 := 1.0 + 3.0*v - c1 + 3.0*tempReal
+	return c1*s.E[5] + c2*s.E[4] + c3*s.E[3] + c4*s.E[2], true
+}
@@ -2,6 +2,7 @@ package go4ta
 
 import (
 	"encoding/csv"
+	"errors"
 	"os"
 	"strconv"
 	"testing"
@@ -44,7 +45,7 @@ func TestMA(t *testing.T) {
 	}
 
 	timePeriod := 30 // ema.csv为30周期EMA
-	maType := 1      // 1=EMA
+	maType := MATypeEMA
 	result, err := MA(closeVals, timePeriod, maType)
 	if err != nil {
 		t.Fatalf("MA计算失败: %v", err)
@@ -68,3 +69,45 @@ func TestMA(t *testing.T) {
 		}
 	}
 }
+
+// TestMAFamily 检查各均线的专用函数与 MA 按对应类型计算的结果一致。
+func TestMAFamily(t *testing.T) {
+	close := testBars().Close
+	funcs := []struct {
+		maType   MAType
+		fn       func([]float64, int) ([]float64, error)
+		lookback func(int) (int, error)
+	}{
+		{MATypeDEMA, DEMA, DEMALookback},
+		{MATypeTEMA, TEMA, TEMALookback},
+		{MATypeTRIMA, TRIMA, TRIMALookback},
+		{MATypeKAMA, KAMA, KAMALookback},
+	}
+	for _, f := range funcs {
+		for _, p := range []int{2, 5, 20} {
+			want, err := MA(close, p, f.maType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := f.fn(close, p)
+			if err != nil {
+				t.Fatalf("%v(%d): %v", f.maType, p, err)
+			}
+			if !equalFloats(got, want) {
+				t.Errorf("%v(%d) differs from MA", f.maType, p)
+			}
+			lookback, err := f.lookback(p)
+			maLookback, _ := MALookback(p, f.maType)
+			if err != nil || lookback != maLookback {
+				t.Errorf("%vLookback(%d) = %d, %v; MALookback %d", f.maType, p, lookback, err, maLookback)
+			}
+		}
+		var fe *FuncError
+		if _, err := f.fn(close, 1); !errors.As(err, &fe) || fe.Func != "TA_"+f.maType.String() || fe.Param != "timePeriod" {
+			t.Errorf("%v(1) = %v", f.maType, err)
+		}
+		if out, err := f.fn([]float64{}, 5); err != nil || len(out) != 0 {
+			t.Errorf("%v(empty) = %v, %v", f.maType, out, err)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+TA_RetCode TA_MACD(int startIdx, int endIdx, const double inReal[], int optInFastPeriod, int optInSlowPeriod, int optInSignalPeriod, int *outBegIdx, int *outNBElement, double *outMACD, double *outSignal, double *outHist);
+*/
+import "C"
+import "unsafe"
+
+// taMACD 调用 TA_MACD。
+func taMACD(close []float64, fastPeriod, slowPeriod, signalPeriod int) (int, []float64, []float64, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	outMACD := make([]C.double, len(close))
+	outSignal := make([]C.double, len(close))
+	outHist := make([]C.double, len(close))
+
+	cOutMACD := (*C.double)(unsafe.Pointer(&outMACD[0]))
+	cOutSignal := (*C.double)(unsafe.Pointer(&outSignal[0]))
+	cOutHist := (*C.double)(unsafe.Pointer(&outHist[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MACD(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(fastPeriod),
+		C.int(slowPeriod),
+		C.int(signalPeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutMACD,
+		cOutSignal,
+		cOutHist,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, _, paramErr := macdParams(fastPeriod, slowPeriod, signalPeriod)
+		return 0, nil, nil, nil, taErr("TA_MACD", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(outMACD, outNBElement), fromC(outSignal, outNBElement), fromC(outHist, outNBElement), nil
+}
+
+// taMACDLookback 调用 TA_MACD_Lookback，参数无效时返回 -1。
+func taMACDLookback(fastPeriod, slowPeriod, signalPeriod int) int {
+	defer readSettings()()
+	return int(C.TA_MACD_Lookback(C.int(fastPeriod), C.int(slowPeriod), C.int(signalPeriod)))
+}
@@ -0,0 +1,85 @@
+package go4ta
+
+// nativeMACD 是 TA_MACD 的原生实现。
+func nativeMACD(close []float64, fastPeriod, slowPeriod, signalPeriod int) (int, []float64, []float64, []float64, error) {
+	fastPeriod, slowPeriod, signalPeriod, err := macdParams(fastPeriod, slowPeriod, signalPeriod)
+	if err != nil {
+		return 0, nil, nil, nil, err
+	}
+	return intMACD(close, fastPeriod, slowPeriod, signalPeriod)
+}
+
+func macdLookback(fastPeriod, slowPeriod, signalPeriod int) int {
+	if slowPeriod < fastPeriod {
+		slowPeriod = fastPeriod
+	}
+	return emaLookback(slowPeriod) + emaLookback(signalPeriod)
+}
+
+// intMACD 对应 TA_INT_MACD。快慢两条 EMA 从同一位置开始输出，
+// 因此快线的 SMA 种子并不从序列开头取值，这是 TA-Lib 的实现细节。
+func intMACD(in []float64, fastPeriod, slowPeriod, signalPeriod int) (int, []float64, []float64, []float64, error) {
+	if slowPeriod < fastPeriod {
+		slowPeriod, fastPeriod = fastPeriod, slowPeriod
+	}
+
+	var k1, k2 float64
+	if slowPeriod != 0 {
+		k1 = perToK(slowPeriod)
+	} else {
+		slowPeriod = 26
+		k1 = 0.075
+	}
+	if fastPeriod != 0 {
+		k2 = perToK(fastPeriod)
+	} else {
+		fastPeriod = 12
+		k2 = 0.15
+	}
+
+	lookbackSignal := emaLookback(signalPeriod)
+	lookbackTotal := lookbackSignal + emaLookback(slowPeriod)
+	startIdx := lookbackTotal
+	endIdx := len(in) - 1
+	if startIdx > endIdx {
+		return 0, nil, nil, nil, nil
+	}
+
+	tempInteger := startIdx - lookbackSignal
+	outBegIdx1, slowEMA := intEMA(in, tempInteger, slowPeriod, k1)
+	outBegIdx2, fastEMA := intEMA(in, tempInteger, fastPeriod, k2)
+	if outBegIdx1 != tempInteger || outBegIdx2 != tempInteger ||
+		len(slowEMA) != len(fastEMA) || len(slowEMA) != (endIdx-startIdx)+1+lookbackSignal {
+		return 0, nil, nil, nil, &FuncError{Func: "TA_MACD", Code: RetInternalError}
+	}
+
+	for i := range fastEMA {
+		fastEMA[i] = fastEMA[i] - slowEMA[i]
+	}
+	outMACD := append([]float64(nil), fastEMA[lookbackSignal:]...)
+
+	_, outSignal := intEMA(fastEMA, 0, signalPeriod, perToK(signalPeriod))
+	outHist := make([]float64, len(outSignal))
+	for i := range outSignal {
+		outHist[i] = outMACD[i] - outSignal[i]
+	}
+	return startIdx, outMACD[:len(outSignal)], outSignal, outHist, nil
+}
+
+// nativeMACDLookback 对应 TA_MACD_Lookback，参数无效时返回 -1。
+func nativeMACDLookback(fastPeriod, slowPeriod, signalPeriod int) int {
+	fastPeriod, slowPeriod, signalPeriod, err := macdParams(fastPeriod, slowPeriod, signalPeriod)
+	if err != nil {
+		return -1
+	}
+	return macdLookback(fastPeriod, slowPeriod, signalPeriod)
+}
+
+// macdParams 按 TA_MACD 的规则处理参数。
+func macdParams(fastPeriod, slowPeriod, signalPeriod int) (int, int, int, error) {
+	c := paramCheck{fn: "TA_MACD"}
+	fastPeriod = c.integer("fastPeriod", fastPeriod, 12, 2, 100000)
+	slowPeriod = c.integer("slowPeriod", slowPeriod, 26, 2, 100000)
+	signalPeriod = c.integer("signalPeriod", signalPeriod, 9, 1, 100000)
+	return fastPeriod, slowPeriod, signalPeriod, c.err
+}
@@ -0,0 +1,111 @@
+package go4ta
+
+// MACDStream 是 MACD 的流式版本。
+type MACDStream struct {
+	state              macdState
+	macd, signal, hist float64
+	ready              bool
+}
+
+// NewMACDStream 创建 MACD 流式指标。
+//
+// @param fastPeriod   - 快速均线周期
+// @param slowPeriod   - 慢速均线周期
+// @param signalPeriod - 信号线周期
+// @return *MACDStream - 流式指标
+// @return error       - 参数无效时返回错误
+func NewMACDStream(fastPeriod, slowPeriod, signalPeriod int) (*MACDStream, error) {
+	fast, ok := optInteger(fastPeriod, 12, 2, 100000)
+	if !ok {
+		return nil, badParam("NewMACDStream", "fastPeriod", fastPeriod)
+	}
+	slow, ok := optInteger(slowPeriod, 26, 2, 100000)
+	if !ok {
+		return nil, badParam("NewMACDStream", "slowPeriod", slowPeriod)
+	}
+	signal, ok := optInteger(signalPeriod, 9, 1, 100000)
+	if !ok {
+		return nil, badParam("NewMACDStream", "signalPeriod", signalPeriod)
+	}
+	return &MACDStream{state: *newMACDState(fast, slow, signal)}, nil
+}
+
+// Update 输入一个新的收盘价，返回最新的 macd、signal、hist，未就绪时均为 0。
+func (s *MACDStream) Update(close float64) (macd, signal, hist float64) {
+	s.macd, s.signal, s.hist, s.ready = s.state.update(close)
+	return s.macd, s.signal, s.hist
+}
+
+// Value 返回最近一次 Update 的结果。
+func (s *MACDStream) Value() (macd, signal, hist float64) {
+	return s.macd, s.signal, s.hist
+}
+
+// Ready 表示当前值是否已越过回看期。
+func (s *MACDStream) Ready() bool {
+	return s.ready
+}
+
+func (s *MACDStream) snapshot() *streamSnapshot {
+	return &streamSnapshot{kind: "MACD", values: []*float64{&s.macd, &s.signal, &s.hist}, ready: &s.ready, state: &s.state}
+}
+
+// MarshalBinary 将完整的计算状态编码为二进制快照。
+func (s *MACDStream) MarshalBinary() ([]byte, error) {
+	return s.snapshot().marshalBinary()
+}
+
+// UnmarshalBinary 从 MarshalBinary 生成的快照恢复状态。
+func (s *MACDStream) UnmarshalBinary(data []byte) error {
+	return s.snapshot().unmarshalBinary(data)
+}
+
+// MarshalJSON 将完整的计算状态编码为 JSON 快照。
+func (s *MACDStream) MarshalJSON() ([]byte, error) {
+	return s.snapshot().marshalJSON()
+}
+
+// UnmarshalJSON 从 MarshalJSON 生成的快照恢复状态。
+func (s *MACDStream) UnmarshalJSON(data []byte) error {
+	return s.snapshot().unmarshalJSON(data)
+}
+
+// macdState 对应 intMACD。快线与慢线在同一根价格柱开始输出，
+// 因此快线跳过开头 slowPeriod-fastPeriod 个价格后才开始累加 SMA 种子。
+type macdState struct {
+	Count    int
+	FastSkip int
+	Fast     emaState
+	Slow     emaState
+	Signal   emaState
+}
+
+func newMACDState(fastPeriod, slowPeriod, signalPeriod int) *macdState {
+	if slowPeriod < fastPeriod {
+		slowPeriod, fastPeriod = fastPeriod, slowPeriod
+	}
+	return &macdState{
+		FastSkip: slowPeriod - fastPeriod,
+		Fast:     *newEMAState(fastPeriod),
+		Slow:     *newEMAState(slowPeriod),
+		Signal:   *newEMAState(signalPeriod),
+	}
+}
+
+func (s *macdState) update(close float64) (macd, signal, hist float64, ok bool) {
+	s.Count++
+	slow, ok := s.Slow.update(close)
+	if s.Count <= s.FastSkip {
+		return 0, 0, 0, false
+	}
+	fast, _ := s.Fast.update(close)
+	if !ok {
+		return 0, 0, 0, false
+	}
+	macd = fast - slow
+	signal, ok = s.Signal.update(macd)
+	if !ok {
+		return 0, 0, 0, false
+	}
+	return macd, signal, macd - signal, true
+}
@@ -0,0 +1,54 @@
+package go4ta
+
+// MAMA 计算 MESA 自适应移动平均线，按位置返回各输出，结果含义见 CalcMAMA。
+//
+// @param close     - 收盘价序列
+// @param fastLimit - 快速极限（如0.5）
+// @param slowLimit - 慢速极限（如0.05）
+// @return mama, fama - 两个与输入等长的结果序列
+// @return error    - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MAMA(close []float64, fastLimit, slowLimit float64) (mama, fama []float64, err error) {
+	r, err := CalcMAMA(close, fastLimit, slowLimit)
+	return r.MAMA, r.FAMA, err
+}
+
+// CalcMAMA 计算 MESA 自适应移动平均线（MAMA）及其跟随线 FAMA。
+// 平滑系数由希尔伯特变换测得的相位变化率决定，限制在 slowLimit 与 fastLimit 之间；
+// fastLimit 为 0.5、slowLimit 为 0.05 时 MAMA 与 MA(close, timePeriod, MATypeMAMA) 相同。
+//
+// @param close      - 收盘价序列
+// @param fastLimit  - 快速极限，取值 0.01..0.99
+// @param slowLimit  - 慢速极限，取值 0.01..0.99
+// @return MAMAResult - MAMA 与 FAMA，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error      - 如果输入数据无效或 C 库调用失败，则返回错误。
+func CalcMAMA(close []float64, fastLimit, slowLimit float64) (MAMAResult, error) {
+	if len(close) == 0 {
+		return MAMAResult{[]float64{}, []float64{}}, nil
+	}
+
+	if err := checkParams("MAMA", fastLimit, slowLimit); err != nil {
+		return MAMAResult{}, err
+	}
+
+	outBegIdx, outMAMA, outFAMA, err := taMAMA(close, fastLimit, slowLimit)
+	if err != nil {
+		return MAMAResult{}, err
+	}
+
+	w := newWarmup(0)
+	return MAMAResult{
+		MAMA: w.spread(len(close), outBegIdx, outMAMA),
+		FAMA: w.spread(len(close), outBegIdx, outFAMA),
+	}, nil
+}
+
+// MAMALookback 返回 MAMA 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param fastLimit - 快速极限
+// @param slowLimit - 慢速极限
+// @return int      - 回看期
+// @return error    - 参数无效时返回错误
+func MAMALookback(fastLimit, slowLimit float64) (int, error) {
+	_, _, err := mamaParams(fastLimit, slowLimit)
+	return lookbackResult("TA_MAMA", taMAMALookback(fastLimit, slowLimit), err)
+}
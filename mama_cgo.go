@@ -0,0 +1,51 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taMAMA 调用 TA_MAMA。
+func taMAMA(close []float64, fastLimit, slowLimit float64) (int, []float64, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	outMAMA := make([]C.double, len(close))
+	outFAMA := make([]C.double, len(close))
+
+	cOutMAMA := (*C.double)(unsafe.Pointer(&outMAMA[0]))
+	cOutFAMA := (*C.double)(unsafe.Pointer(&outFAMA[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MAMA(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.double(fastLimit),
+		C.double(slowLimit),
+		&outBegIdx,
+		&outNBElement,
+		cOutMAMA,
+		cOutFAMA,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, paramErr := mamaParams(fastLimit, slowLimit)
+		return 0, nil, nil, taErr("TA_MAMA", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(outMAMA, outNBElement), fromC(outFAMA, outNBElement), nil
+}
+
+// taMAMALookback 调用 TA_MAMA_Lookback，参数无效时返回 -1。
+func taMAMALookback(fastLimit, slowLimit float64) int {
+	defer readSettings()()
+	return int(C.TA_MAMA_Lookback(C.double(fastLimit), C.double(slowLimit)))
+}
@@ -0,0 +1,256 @@
+package go4ta
+
+import "math"
+
+// hilbert 对应 TA-Lib 中 HILBERT_VARIABLES 宏定义的一组变量，奇偶价格柱各自维护一套环形缓冲。
+type hilbert struct {
+	Value                 float64
+	OddBuf, EvenBuf       [3]float64
+	PrevOdd, PrevEven     float64
+	PrevInOdd, PrevInEven float64
+}
+
+const (
+	hilbertA = 0.0962
+	hilbertB = 0.5769
+)
+
+// rad2Deg 对应 TA-Lib 中 180.0/(4.0*atan(1)) 的弧度换算系数。
+var rad2Deg = 180.0 / (4.0 * math.Atan(1))
+
+// odd 对应 DO_HILBERT_ODD 宏。
+func (h *hilbert) odd(input float64, idx int, adjustedPrevPeriod float64) {
+	h.Value = hilbertTransform(input, idx, adjustedPrevPeriod, &h.OddBuf, &h.PrevOdd, &h.PrevInOdd)
+}
+
+// even 对应 DO_HILBERT_EVEN 宏。
+func (h *hilbert) even(input float64, idx int, adjustedPrevPeriod float64) {
+	h.Value = hilbertTransform(input, idx, adjustedPrevPeriod, &h.EvenBuf, &h.PrevEven, &h.PrevInEven)
+}
+
+func hilbertTransform(input float64, idx int, adjustedPrevPeriod float64, buf *[3]float64, prev, prevIn *float64) float64 {
+	tempReal := hilbertA * input
+	value := -buf[idx]
+	buf[idx] = tempReal
+	value += tempReal
+	value -= *prev
+	*prev = hilbertB * *prevIn
+	value += *prev
+	*prevIn = input
+	value *= adjustedPrevPeriod
+	return value
+}
+
+// priceWMA 对应 TA-Lib 希尔伯特变换族中使用的4周期价格加权平滑（DO_PRICE_WMA 宏）。
+// 前3个价格只用于初始化，之后每个价格输出一个平滑值。
+type priceWMA struct {
+	Count         int
+	Sub, Sum      float64
+	TrailingValue float64
+	Window        ring // 最近4个价格
+}
+
+func newPriceWMA() priceWMA {
+	return priceWMA{Window: newRing(4)}
+}
+
+func (w *priceWMA) update(price float64) (float64, bool) {
+	w.Window.push(price)
+	w.Count++
+	if w.Count <= 3 {
+		w.Sub += price
+		w.Sum += price * float64(w.Count)
+		return 0, false
+	}
+	w.Sub += price
+	w.Sub -= w.TrailingValue
+	w.Sum += price * 4.0
+	w.TrailingValue = w.Window.back(3)
+	smoothed := w.Sum * 0.1
+	w.Sum -= w.Sub
+	return smoothed, true
+}
+
+// mamaWarmup 是不含不稳定期的 MAMA 回看期，mamaState 以此判断是否就绪，不受全局设置影响。
+const mamaWarmup = 32
+
+func mamaLookback() int {
+	return mamaWarmup + unstablePeriod(FuncUnstMAMA)
+}
+
+// mamaState 保存 TA_MAMA 主循环中的全部变量，批量计算与流式计算共用。
+type mamaState struct {
+	FastLimit, SlowLimit float64
+	Today                int // 下一根价格柱的序号，奇偶决定希尔伯特变换使用哪套缓冲
+	WMA                  priceWMA
+	HilbertIdx           int
+	Detrender, Q1        hilbert
+	JI, JQ               hilbert
+	Period               float64
+	PrevI2, PrevQ2       float64
+	Re, Im               float64
+	MAMA, FAMA           float64
+	I1ForOddPrev3        float64
+	I1ForEvenPrev3       float64
+	I1ForOddPrev2        float64
+	I1ForEvenPrev2       float64
+	PrevPhase            float64
+}
+
+func newMAMAState(fastLimit, slowLimit float64) *mamaState {
+	return &mamaState{FastLimit: fastLimit, SlowLimit: slowLimit, WMA: newPriceWMA()}
+}
+
+func (s *mamaState) checkState() error {
+	if s.HilbertIdx < 0 || s.HilbertIdx > 2 {
+		return stateErr("bad hilbert index %d", s.HilbertIdx)
+	}
+	return s.WMA.Window.checkSize(4)
+}
+
+// update 处理一根价格柱，ok 表示是否已越过回看期。
+// 前12根价格柱只用于初始化价格平滑器，与 TA-Lib 一致。
+func (s *mamaState) update(todayValue float64) (float64, float64, bool) {
+	today := s.Today
+	s.Today++
+	smoothedValue, ok := s.WMA.update(todayValue)
+	if !ok || today < 12 {
+		return 0, 0, false
+	}
+
+	adjustedPrevPeriod := (0.075 * s.Period) + 0.54
+
+	var q2, i2, tempReal2 float64
+	if today%2 == 0 {
+		s.Detrender.even(smoothedValue, s.HilbertIdx, adjustedPrevPeriod)
+		s.Q1.even(s.Detrender.Value, s.HilbertIdx, adjustedPrevPeriod)
+		s.JI.even(s.I1ForEvenPrev3, s.HilbertIdx, adjustedPrevPeriod)
+		s.JQ.even(s.Q1.Value, s.HilbertIdx, adjustedPrevPeriod)
+		s.HilbertIdx++
+		if s.HilbertIdx == 3 {
+			s.HilbertIdx = 0
+		}
+
+		q2 = (0.2 * (s.Q1.Value + s.JI.Value)) + (0.8 * s.PrevQ2)
+		i2 = (0.2 * (s.I1ForEvenPrev3 - s.JQ.Value)) + (0.8 * s.PrevI2)
+
+		// I1 是延迟3根的 detrender，保存给奇数柱使用
+		s.I1ForOddPrev3 = s.I1ForOddPrev2
+		s.I1ForOddPrev2 = s.Detrender.Value
+
+		if s.I1ForEvenPrev3 != 0.0 {
+			tempReal2 = math.Atan(s.Q1.Value/s.I1ForEvenPrev3) * rad2Deg
+		}
+	} else {
+		s.Detrender.odd(smoothedValue, s.HilbertIdx, adjustedPrevPeriod)
+		s.Q1.odd(s.Detrender.Value, s.HilbertIdx, adjustedPrevPeriod)
+		s.JI.odd(s.I1ForOddPrev3, s.HilbertIdx, adjustedPrevPeriod)
+		s.JQ.odd(s.Q1.Value, s.HilbertIdx, adjustedPrevPeriod)
+
+		q2 = (0.2 * (s.Q1.Value + s.JI.Value)) + (0.8 * s.PrevQ2)
+		i2 = (0.2 * (s.I1ForOddPrev3 - s.JQ.Value)) + (0.8 * s.PrevI2)
+
+		s.I1ForEvenPrev3 = s.I1ForEvenPrev2
+		s.I1ForEvenPrev2 = s.Detrender.Value
+
+		if s.I1ForOddPrev3 != 0.0 {
+			tempReal2 = math.Atan(s.Q1.Value/s.I1ForOddPrev3) * rad2Deg
+		}
+	}
+
+	// 相位差
+	tempReal := s.PrevPhase - tempReal2
+	s.PrevPhase = tempReal2
+	if tempReal < 1.0 {
+		tempReal = 1.0
+	}
+
+	// alpha
+	if tempReal > 1.0 {
+		tempReal = s.FastLimit / tempReal
+		if tempReal < s.SlowLimit {
+			tempReal = s.SlowLimit
+		}
+	} else {
+		tempReal = s.FastLimit
+	}
+
+	s.MAMA = (tempReal * todayValue) + ((1 - tempReal) * s.MAMA)
+	tempReal *= 0.5
+	s.FAMA = (tempReal * s.MAMA) + ((1 - tempReal) * s.FAMA)
+
+	// 为下一根价格柱调整周期
+	s.Re = (0.2 * ((i2 * s.PrevI2) + (q2 * s.PrevQ2))) + (0.8 * s.Re)
+	s.Im = (0.2 * ((i2 * s.PrevQ2) - (q2 * s.PrevI2))) + (0.8 * s.Im)
+	s.PrevQ2 = q2
+	s.PrevI2 = i2
+	tempReal = s.Period
+	if s.Im != 0.0 && s.Re != 0.0 {
+		s.Period = 360.0 / (math.Atan(s.Im/s.Re) * rad2Deg)
+	}
+	tempReal2 = 1.5 * tempReal
+	if s.Period > tempReal2 {
+		s.Period = tempReal2
+	}
+	tempReal2 = 0.67 * tempReal
+	if s.Period < tempReal2 {
+		s.Period = tempReal2
+	}
+	if s.Period < 6 {
+		s.Period = 6
+	} else if s.Period > 50 {
+		s.Period = 50
+	}
+	s.Period = (0.2 * s.Period) + (0.8 * tempReal)
+
+	if today < mamaWarmup {
+		return 0, 0, false
+	}
+	return s.MAMA, s.FAMA, true
+}
+
+// intMAMA 对应 TA_MAMA，返回 MAMA 与 FAMA 两条序列。
+func intMAMA(in []float64, fastLimit, slowLimit float64) (int, []float64, []float64) {
+	startIdx := mamaLookback()
+	if startIdx > len(in)-1 {
+		return 0, nil, nil
+	}
+
+	s := newMAMAState(fastLimit, slowLimit)
+	outMAMA := make([]float64, 0, len(in)-startIdx)
+	outFAMA := make([]float64, 0, len(in)-startIdx)
+	for today, v := range in {
+		// 不稳定期内照常递推，只是不输出
+		if mama, fama, ok := s.update(v); ok && today >= startIdx {
+			outMAMA = append(outMAMA, mama)
+			outFAMA = append(outFAMA, fama)
+		}
+	}
+	return startIdx, outMAMA, outFAMA
+}
+
+// nativeMAMA 是 TA_MAMA 的原生实现。
+func nativeMAMA(close []float64, fastLimit, slowLimit float64) (int, []float64, []float64, error) {
+	fastLimit, slowLimit, err := mamaParams(fastLimit, slowLimit)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	outBegIdx, outMAMA, outFAMA := intMAMA(close, fastLimit, slowLimit)
+	return outBegIdx, outMAMA, outFAMA, nil
+}
+
+// nativeMAMALookback 对应 TA_MAMA_Lookback，参数无效时返回 -1。
+func nativeMAMALookback(fastLimit, slowLimit float64) int {
+	if _, _, err := mamaParams(fastLimit, slowLimit); err != nil {
+		return -1
+	}
+	return mamaLookback()
+}
+
+// mamaParams 按 TA_MAMA 的规则处理参数。
+func mamaParams(fastLimit, slowLimit float64) (float64, float64, error) {
+	c := paramCheck{fn: "TA_MAMA"}
+	fastLimit = c.real("fastLimit", fastLimit, 0.5, 0.01, 0.99)
+	slowLimit = c.real("slowLimit", slowLimit, 0.05, 0.01, 0.99)
+	return fastLimit, slowLimit, c.err
+}
@@ -0,0 +1,57 @@
+package go4ta
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMAMA(t *testing.T) {
+	close := testBars().Close
+
+	want, err := MA(close, 30, MATypeMAMA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := CalcMAMA(close, 0.5, 0.05)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalFloats(r.MAMA, want) {
+		t.Error("MAMA(0.5, 0.05) differs from MA(MATypeMAMA)")
+	}
+	mama, fama, err := MAMA(close, 0.5, 0.05)
+	if err != nil || !equalFloats(mama, r.MAMA) || !equalFloats(fama, r.FAMA) {
+		t.Errorf("MAMA differs from CalcMAMA: %v", err)
+	}
+
+	lookback, err := MAMALookback(0.5, 0.05)
+	if err != nil || lookback != 32 {
+		t.Fatalf("MAMALookback = %d, %v, want 32", lookback, err)
+	}
+	for i := range r.Len() {
+		v := r.At(i)
+		if (i < lookback) != (v.MAMA == 0 && v.FAMA == 0) {
+			t.Errorf("MAMA[%d] = %+v, lookback %d", i, v, lookback)
+		}
+	}
+
+	// 快慢极限不同时结果不同
+	other, err := CalcMAMA(close, 0.9, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if equalFloats(other.MAMA, r.MAMA) {
+		t.Error("limits have no effect")
+	}
+
+	var fe *FuncError
+	if _, err := CalcMAMA(close, 1, 0.05); !errors.As(err, &fe) || fe.Func != "TA_MAMA" || fe.Param != "fastLimit" {
+		t.Errorf("CalcMAMA(fastLimit 1) = %v", err)
+	}
+	if _, err := CalcMAMA(close, 0.5, 0); !errors.As(err, &fe) || fe.Param != "slowLimit" {
+		t.Errorf("CalcMAMA(slowLimit 0) = %v", err)
+	}
+	if r, err := CalcMAMA([]float64{}, 0.5, 0.05); err != nil || r.Len() != 0 {
+		t.Errorf("CalcMAMA(empty) = %+v, %v", r, err)
+	}
+}
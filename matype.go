@@ -0,0 +1,75 @@
+package go4ta
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MAType 对应 TA-Lib 的 TA_MAType，是 MA、BBands、APO、PPO、STOCH 等函数中均线类型参数的取值。
+// MAType 实现了 encoding.TextMarshaler 与 encoding.TextUnmarshaler，在 JSON 等配置中以名称表示，如 "EMA"。
+type MAType int
+
+const (
+	MATypeSMA   MAType = iota // 简单移动平均
+	MATypeEMA                 // 指数移动平均
+	MATypeWMA                 // 加权移动平均
+	MATypeDEMA                // 双指数移动平均
+	MATypeTEMA                // 三指数移动平均
+	MATypeTRIMA               // 三角移动平均
+	MATypeKAMA                // 考夫曼自适应移动平均
+	MATypeMAMA                // MESA 自适应移动平均，快慢极限取 TA-Lib 的默认值 0.5 与 0.05
+	MATypeT3                  // 三倍平滑移动平均，成交量因子取 TA-Lib 的默认值 0.7
+)
+
+// maTypeNames 是各均线类型的名称，下标即取值。
+var maTypeNames = []string{"SMA", "EMA", "WMA", "DEMA", "TEMA", "TRIMA", "KAMA", "MAMA", "T3"}
+
+// Valid 表示 t 是否为 TA-Lib 支持的均线类型。
+func (t MAType) Valid() bool {
+	return validMAType(int(t))
+}
+
+// String 返回均线类型的名称，如 "EMA"；无效取值返回 "MAType(n)"。
+func (t MAType) String() string {
+	if t.Valid() {
+		return maTypeNames[t]
+	}
+	return fmt.Sprintf("MAType(%d)", int(t))
+}
+
+// ParseMAType 由名称或数值解析均线类型。
+//
+// @param text    - 均线类型名称（不区分大小写，如 "ema"）或取值（如 "1"）
+// @return MAType - 均线类型
+// @return error  - 无法识别或取值超出 0..8 时返回 ErrBadParam
+func ParseMAType(text string) (MAType, error) {
+	s := strings.TrimSpace(text)
+	for i, name := range maTypeNames {
+		if strings.EqualFold(s, name) {
+			return MAType(i), nil
+		}
+	}
+	if v, err := strconv.Atoi(s); err == nil && MAType(v).Valid() {
+		return MAType(v), nil
+	}
+	return 0, badParam("ParseMAType", "text", text)
+}
+
+// MarshalText 将均线类型编码为名称。
+func (t MAType) MarshalText() ([]byte, error) {
+	if !t.Valid() {
+		return nil, badParam("MAType.MarshalText", "maType", int(t))
+	}
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText 由名称或数值解码均线类型，见 ParseMAType。
+func (t *MAType) UnmarshalText(text []byte) error {
+	v, err := ParseMAType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
@@ -0,0 +1,55 @@
+package go4ta
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMAType(t *testing.T) {
+	for i, name := range maTypeNames {
+		mt := MAType(i)
+		if !mt.Valid() || mt.String() != name {
+			t.Errorf("MAType(%d) = %q, valid %v", i, mt, mt.Valid())
+		}
+		for _, text := range []string{name, " " + name + " ", string(rune('0' + i))} {
+			if got, err := ParseMAType(text); err != nil || got != mt {
+				t.Errorf("ParseMAType(%q) = %v, %v", text, got, err)
+			}
+		}
+	}
+	if got, err := ParseMAType("tema"); err != nil || got != MATypeTEMA {
+		t.Errorf("ParseMAType(tema) = %v, %v", got, err)
+	}
+	for _, text := range []string{"", "HMA", "9", "-1", "1.5"} {
+		if _, err := ParseMAType(text); !errors.Is(err, ErrBadParam) {
+			t.Errorf("ParseMAType(%q) = %v, want ErrBadParam", text, err)
+		}
+	}
+	if MAType(9).Valid() || MAType(9).String() != "MAType(9)" {
+		t.Errorf("MAType(9) = %q", MAType(9))
+	}
+}
+
+func TestMATypeJSON(t *testing.T) {
+	var cfg struct {
+		Fast MAType `json:"fast"`
+		Slow MAType `json:"slow"`
+	}
+	if err := json.Unmarshal([]byte(`{"fast": "ema", "slow": "3"}`), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Fast != MATypeEMA || cfg.Slow != MATypeDEMA {
+		t.Errorf("got %v, %v", cfg.Fast, cfg.Slow)
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil || string(data) != `{"fast":"EMA","slow":"DEMA"}` {
+		t.Errorf("Marshal = %s, %v", data, err)
+	}
+	if _, err := json.Marshal(MAType(-1)); !errors.Is(err, ErrBadParam) {
+		t.Errorf("Marshal(MAType(-1)) = %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"fast": "HMA"}`), &cfg); !errors.Is(err, ErrBadParam) {
+		t.Errorf("Unmarshal(HMA) = %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package go4ta
+
+// MAVP 计算可变周期的移动平均线（Moving Average with Variable Period）：第 i 个值是以 periods[i] 为周期的
+// 均线在 i 处的值，可用 ATR、STDDEV 或 HTDCPeriod 等指标的结果驱动均线的快慢。
+// 周期截断为整数后限制在 minPeriod..maxPeriod 之间，NaN 按 minPeriod 处理；回看期按 maxPeriod 计算。
+//
+// @param close      - 收盘价序列
+// @param periods    - 各位置的周期，与 close 等长
+// @param minPeriod  - 最小周期，取值 2..100000（如2）
+// @param maxPeriod  - 最大周期，取值 2..100000 且不小于 minPeriod（如30）
+// @param maType     - 均线类型，如 MATypeSMA、MATypeEMA，取值见 MAType
+// @return []float64 - 均线序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MAVP(close, periods []float64, minPeriod, maxPeriod int, maType MAType) ([]float64, error) {
+	n, err := checkInputs("MAVP", "close, periods", close, periods)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	if err := checkParams("MAVP", float64(minPeriod), float64(maxPeriod), float64(maType)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taMAVP(close, periods, minPeriod, maxPeriod, int(maType))
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// MAVPLookback 返回 MAVP 在给定参数下的回看期，即 MA(maxPeriod, maType) 的回看期，与 minPeriod 无关。
+//
+// @param minPeriod - 最小周期
+// @param maxPeriod - 最大周期
+// @param maType    - 均线类型
+// @return int      - 回看期
+// @return error    - 参数无效时返回错误
+func MAVPLookback(minPeriod, maxPeriod int, maType MAType) (int, error) {
+	_, _, _, err := mavpParams(minPeriod, maxPeriod, int(maType))
+	return lookbackResult("TA_MAVP", taMAVPLookback(minPeriod, maxPeriod, int(maType)), err)
+}
@@ -0,0 +1,56 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taMAVP 调用 TA_MAVP。TA-Lib 不检查 minPeriod 不大于 maxPeriod，调用前先按 mavpParams 拒绝。
+func taMAVP(close, periods []float64, minPeriod, maxPeriod, maType int) (int, []float64, error) {
+	if _, _, _, err := mavpParams(minPeriod, maxPeriod, maType); err != nil {
+		return 0, nil, err
+	}
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	cPeriods := (*C.double)(unsafe.Pointer(&periods[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MAVP(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		cPeriods,
+		C.int(minPeriod),
+		C.int(maxPeriod),
+		C.TA_MAType(maType),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, _, paramErr := mavpParams(minPeriod, maxPeriod, maType)
+		return 0, nil, taErr("TA_MAVP", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taMAVPLookback 调用 TA_MAVP_Lookback，参数无效（包括 minPeriod 大于 maxPeriod）时返回 -1。
+func taMAVPLookback(minPeriod, maxPeriod, maType int) int {
+	if _, _, _, err := mavpParams(minPeriod, maxPeriod, maType); err != nil {
+		return -1
+	}
+	defer readSettings()()
+	return int(C.TA_MAVP_Lookback(C.int(minPeriod), C.int(maxPeriod), C.TA_MAType(maType)))
+}
@@ -0,0 +1,71 @@
+package go4ta
+
+// nativeMAVP 是 TA_MAVP 的原生实现。
+func nativeMAVP(close, periods []float64, minPeriod, maxPeriod, maType int) (int, []float64, error) {
+	minPeriod, maxPeriod, maType, err := mavpParams(minPeriod, maxPeriod, maType)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intMAVP(close, periods, minPeriod, maxPeriod, maType)
+	return outBegIdx, output, nil
+}
+
+// nativeMAVPLookback 对应 TA_MAVP_Lookback，参数无效时返回 -1。
+func nativeMAVPLookback(minPeriod, maxPeriod, maType int) int {
+	_, maxPeriod, maType, err := mavpParams(minPeriod, maxPeriod, maType)
+	if err != nil {
+		return -1
+	}
+	return maLookback(maxPeriod, maType)
+}
+
+// mavpParams 按 TA_MAVP 的规则处理参数，另外要求 minPeriod 不大于 maxPeriod：
+// 回看期按 maxPeriod 计算，更长的周期在回看期结束时还没有有效的均线值。
+func mavpParams(minPeriod, maxPeriod, maType int) (int, int, int, error) {
+	c := paramCheck{fn: "TA_MAVP"}
+	minPeriod = c.integer("minPeriod", minPeriod, 2, 2, 100000)
+	maxPeriod = c.integer("maxPeriod", maxPeriod, 30, 2, 100000)
+	c.check(minPeriod <= maxPeriod, "maxPeriod", maxPeriod)
+	maType = c.maType("maType", maType)
+	return minPeriod, maxPeriod, maType, c.err
+}
+
+// intMAVP 对应 TA_MAVP：第 i 个输出是以 periods[i] 为周期的均线在 i 处的值。
+// 回看期按 maxPeriod 计算，每种周期只计算一次均线，各均线都与 TA-Lib 一样从同一个 startIdx 开始。
+func intMAVP(in, periods []float64, minPeriod, maxPeriod, maType int) (int, []float64) {
+	startIdx := maLookback(maxPeriod, maType)
+	if startIdx > len(in)-1 {
+		return 0, nil
+	}
+
+	// 周期截断为整数后限制在 minPeriod..maxPeriod 之间，NaN 按 minPeriod 处理
+	n := len(in) - startIdx
+	localPeriods := make([]int, n)
+	for i := range localPeriods {
+		v := periods[startIdx+i]
+		switch {
+		case !(v >= float64(minPeriod)):
+			localPeriods[i] = minPeriod
+		case v > float64(maxPeriod):
+			localPeriods[i] = maxPeriod
+		default:
+			localPeriods[i] = int(v)
+		}
+	}
+
+	output := make([]float64, n)
+	for i, curPeriod := range localPeriods {
+		if curPeriod == 0 {
+			continue
+		}
+		_, ma := intMAFrom(in, startIdx, curPeriod, maType)
+		output[i] = ma[i]
+		for j := i + 1; j < n; j++ {
+			if localPeriods[j] == curPeriod {
+				localPeriods[j] = 0
+				output[j] = ma[j]
+			}
+		}
+	}
+	return startIdx, output
+}
@@ -0,0 +1,136 @@
+package go4ta
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// constPeriods 返回长度为 n、各位置周期都为 period 的序列。
+func constPeriods(n int, period float64) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = period
+	}
+	return out
+}
+
+func TestMAVP(t *testing.T) {
+	b := testBars()
+	close := b.Close
+	n := len(close)
+
+	// 周期不变且等于 maxPeriod 时与 MA 相同
+	for _, maType := range []MAType{MATypeSMA, MATypeEMA, MATypeWMA, MATypeDEMA, MATypeTEMA, MATypeTRIMA, MATypeKAMA, MATypeT3} {
+		want, err := MA(close, 10, maType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := MAVP(close, constPeriods(n, 10), 2, 10, maType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range got {
+			if math.Abs(got[i]-want[i]) > 1e-9 {
+				t.Fatalf("MAVP(10, %v)[%d] = %v, MA = %v", maType, i, got[i], want[i])
+			}
+		}
+	}
+
+	// 各位置按自己的周期取 SMA，超出范围的周期截断到 minPeriod..maxPeriod，NaN 按 minPeriod 处理
+	periods := make([]float64, n)
+	for i := range periods {
+		periods[i] = []float64{1, 3.7, 5, 8, 50, math.NaN()}[i%6]
+	}
+	got, err := MAVP(close, periods, 3, 20, MATypeSMA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lookback, err := MAVPLookback(3, 20, MATypeSMA)
+	if err != nil || lookback != 19 {
+		t.Fatalf("MAVPLookback(3, 20, SMA) = %d, %v, want 19", lookback, err)
+	}
+	sma := map[int][]float64{}
+	for _, p := range []int{3, 5, 8, 20} {
+		sma[p], _ = SMA(close, p)
+	}
+	for i, v := range got {
+		if i < lookback {
+			if v != 0 {
+				t.Fatalf("MAVP[%d] = %v within lookback %d", i, v, lookback)
+			}
+			continue
+		}
+		p := []int{3, 3, 5, 8, 20, 3}[i%6]
+		if math.Abs(v-sma[p][i]) > 1e-9 {
+			t.Fatalf("MAVP[%d] (period %v) = %v, SMA(%d) = %v", i, periods[i], v, p, sma[p][i])
+		}
+	}
+
+	if byBars, err := b.MAVP(periods, 3, 20, MATypeSMA); err != nil || !equalFloats(byBars, got) {
+		t.Errorf("Bars.MAVP differs from MAVP: %v", err)
+	}
+	res, err := Call("MAVP", map[string][]float64{"real": close, "periods": periods}, map[string]float64{"minPeriod": 3, "maxPeriod": 20})
+	if err != nil || !equalFloats(res["Real"], got) {
+		t.Errorf("Call(MAVP) differs from MAVP: %v", err)
+	}
+	if l, err := Lookback("MAVP", 3, 20, 1); err != nil || l != 19 {
+		t.Errorf("Lookback(MAVP, 3, 20, EMA) = %d, %v, want 19", l, err)
+	}
+
+	// 由波动率驱动的周期
+	atr, err := ATR(b.High, b.Low, close, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, err := MAVP(close, atr, 5, 30, MATypeEMA); err != nil || len(out) != n {
+		t.Errorf("MAVP(ATR) = %d values, %v", len(out), err)
+	}
+
+	withFillPolicy(t, FillTrim, func() {
+		trimmed, err := MAVP(close, periods, 3, 20, MATypeSMA)
+		if err != nil || !equalFloats(trimmed, got[lookback:]) {
+			t.Errorf("MAVP with FillTrim = %d values, %v", len(trimmed), err)
+		}
+	})
+
+	if out, err := MAVP(close[:10], periods[:10], 3, 20, MATypeSMA); err != nil || len(out) != 10 || out[9] != 0 {
+		t.Errorf("MAVP(10 bars) = %v, %v", out, err)
+	}
+	if _, err := MAVP(close, periods[:n-1], 3, 20, MATypeSMA); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("MAVP(short periods) = %v", err)
+	}
+	var fe *FuncError
+	if _, err := MAVP(close, periods, 3, 1, MATypeSMA); !errors.As(err, &fe) || !errors.Is(err, ErrBadParam) || fe.Param != "maxPeriod" {
+		t.Errorf("MAVP(maxPeriod 1) = %v", err)
+	}
+	// minPeriod 大于 maxPeriod 时，超过 maxPeriod 的周期在回看期结束时还没有有效值，一律拒绝
+	tens := constPeriods(40, 10)
+	if _, err := MAVP(close[:40], tens, 17, 5, MATypeTEMA); !errors.As(err, &fe) || fe.Func != "TA_MAVP" || fe.Param != "maxPeriod" {
+		t.Errorf("MAVP(minPeriod 17, maxPeriod 5) = %v", err)
+	}
+	if _, err := MAVPLookback(17, 5, MATypeSMA); !errors.Is(err, ErrBadParam) {
+		t.Errorf("MAVPLookback(17, 5) = %v", err)
+	}
+	if _, err := Lookback("MAVP", 17, 5); !errors.Is(err, ErrBadParam) {
+		t.Errorf("Lookback(MAVP, 17, 5) = %v", err)
+	}
+	if _, err := Call("MAVP", map[string][]float64{"close": close[:40], "periods": tens}, map[string]float64{"minPeriod": 17, "maxPeriod": 5}); !errors.Is(err, ErrBadParam) {
+		t.Errorf("Call(MAVP, minPeriod 17, maxPeriod 5) = %v", err)
+	}
+	if _, _, err := nativeMAVP(close[:40], tens, 17, 5, int(MATypeSMA)); !errors.Is(err, ErrBadParam) {
+		t.Errorf("nativeMAVP(17, 5) = %v", err)
+	}
+	if l := nativeMAVPLookback(17, 5, int(MATypeSMA)); l != -1 {
+		t.Errorf("nativeMAVPLookback(17, 5) = %d, want -1", l)
+	}
+	if _, err := MAVP(close, periods, 20, 20, MATypeSMA); err != nil {
+		t.Errorf("MAVP(minPeriod = maxPeriod) = %v", err)
+	}
+	if _, err := MAVPLookback(3, 20, MAType(9)); !errors.Is(err, ErrBadParam) {
+		t.Errorf("MAVPLookback(maType 9) = %v", err)
+	}
+	if out, err := MAVP(nil, []float64{}, 3, 20, MATypeSMA); err != nil || len(out) != 0 {
+		t.Errorf("MAVP(empty) = %v, %v", out, err)
+	}
+}
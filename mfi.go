@@ -0,0 +1,44 @@
+package go4ta
+
+// MFI 计算资金流量指标（Money Flow Index），即以成交量加权的 RSI，取值在 0 到 100 之间。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param volume     - 成交量序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - MFI 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MFI(high, low, close, volume []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("MFI", "high, low, close, volume", high, low, close, volume)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("MFI", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MFI", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taMFI(high, low, close, volume, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// MFILookback 返回 MFI 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MFILookback(timePeriod int) (int, error) {
+	_, err := mfiParams(timePeriod)
+	return lookbackResult("TA_MFI", taMFILookback(timePeriod), err)
+}
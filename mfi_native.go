@@ -0,0 +1,98 @@
+package go4ta
+
+// nativeMFI 是 TA_MFI 的原生实现。
+func nativeMFI(high, low, close, volume []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := mfiParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	lookbackTotal := mfiLookback(timePeriod)
+	startIdx := lookbackTotal
+	if startIdx > len(high)-1 {
+		return 0, nil, nil
+	}
+
+	// 环形缓冲区保存最近 timePeriod 根的正、负资金流，窗口移动时从合计中减去最早的一根
+	type moneyFlow struct{ positive, negative float64 }
+	mflow := make([]moneyFlow, timePeriod)
+	mflowIdx := 0
+	posSumMF, negSumMF := 0.0, 0.0
+
+	today := startIdx - lookbackTotal
+	prevValue := (high[today] + low[today] + close[today]) / 3.0
+	today++
+	addFlow := func() {
+		tempValue1 := (high[today] + low[today] + close[today]) / 3.0
+		tempValue2 := tempValue1 - prevValue
+		prevValue = tempValue1
+		tempValue1 *= volume[today]
+		today++
+		switch {
+		case tempValue2 < 0:
+			mflow[mflowIdx] = moneyFlow{negative: tempValue1}
+			negSumMF += tempValue1
+		case tempValue2 > 0:
+			mflow[mflowIdx] = moneyFlow{positive: tempValue1}
+			posSumMF += tempValue1
+		default:
+			mflow[mflowIdx] = moneyFlow{}
+		}
+		mflowIdx++
+		if mflowIdx > timePeriod-1 {
+			mflowIdx = 0
+		}
+	}
+	dropFlow := func() {
+		posSumMF -= mflow[mflowIdx].positive
+		negSumMF -= mflow[mflowIdx].negative
+	}
+	// 资金流合计小于1时视为没有成交，输出0
+	value := func() float64 {
+		tempValue1 := posSumMF + negSumMF
+		if tempValue1 < 1.0 {
+			return 0.0
+		}
+		return 100.0 * (posSumMF / tempValue1)
+	}
+
+	for i := timePeriod; i > 0; i-- {
+		addFlow()
+	}
+
+	output := make([]float64, 0, len(high)-startIdx)
+	if today > startIdx {
+		output = append(output, value())
+	} else {
+		for today < startIdx {
+			dropFlow()
+			addFlow()
+		}
+	}
+	for today < len(high) {
+		dropFlow()
+		addFlow()
+		output = append(output, value())
+	}
+	return startIdx, output, nil
+}
+
+func mfiLookback(timePeriod int) int {
+	return timePeriod + unstablePeriod(FuncUnstMFI)
+}
+
+// nativeMFILookback 对应 TA_MFI_Lookback，参数无效时返回 -1。
+func nativeMFILookback(timePeriod int) int {
+	timePeriod, err := mfiParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return mfiLookback(timePeriod)
+}
+
+// mfiParams 按 TA_MFI 的规则处理参数。
+func mfiParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_MFI"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
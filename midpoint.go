@@ -0,0 +1,37 @@
+package go4ta
+
+// MIDPOINT 计算最近 timePeriod 根（含当根）收盘价的最高值与最低值的平均（MidPoint over period）。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MIDPOINT(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("MIDPOINT", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MIDPOINT", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taMIDPOINT(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// MIDPOINTLookback 返回 MIDPOINT 在给定参数下的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MIDPOINTLookback(timePeriod int) (int, error) {
+	_, err := midParams(timePeriod, "TA_MIDPOINT")
+	return lookbackResult("TA_MIDPOINT", taMIDPOINTLookback(timePeriod), err)
+}
@@ -0,0 +1,82 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taMIDPOINT 调用 TA_MIDPOINT。
+func taMIDPOINT(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MIDPOINT(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := midParams(timePeriod, "TA_MIDPOINT")
+		return 0, nil, taErr("TA_MIDPOINT", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taMIDPOINTLookback 调用 TA_MIDPOINT_Lookback，参数无效时返回 -1。
+func taMIDPOINTLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_MIDPOINT_Lookback(C.int(timePeriod)))
+}
+
+// taMIDPRICE 调用 TA_MIDPRICE。
+func taMIDPRICE(high, low []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	output := make([]C.double, len(high))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MIDPRICE(
+		0,
+		C.int(len(high)-1),
+		cHigh,
+		cLow,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := midParams(timePeriod, "TA_MIDPRICE")
+		return 0, nil, taErr("TA_MIDPRICE", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taMIDPRICELookback 调用 TA_MIDPRICE_Lookback，参数无效时返回 -1。
+func taMIDPRICELookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_MIDPRICE_Lookback(C.int(timePeriod)))
+}
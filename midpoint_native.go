@@ -0,0 +1,70 @@
+package go4ta
+
+// nativeMIDPOINT 是 TA_MIDPOINT 的原生实现。
+func nativeMIDPOINT(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := midParams(timePeriod, "TA_MIDPOINT")
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intMIDPRICE(close, close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeMIDPOINTLookback 对应 TA_MIDPOINT_Lookback，参数无效时返回 -1。
+func nativeMIDPOINTLookback(timePeriod int) int {
+	timePeriod, err := midParams(timePeriod, "TA_MIDPOINT")
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// nativeMIDPRICE 是 TA_MIDPRICE 的原生实现。
+func nativeMIDPRICE(high, low []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := midParams(timePeriod, "TA_MIDPRICE")
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intMIDPRICE(high, low, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeMIDPRICELookback 对应 TA_MIDPRICE_Lookback，参数无效时返回 -1。
+func nativeMIDPRICELookback(timePeriod int) int {
+	timePeriod, err := midParams(timePeriod, "TA_MIDPRICE")
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// midParams 按 TA_MIDPOINT、TA_MIDPRICE 的规则处理参数，两者的参数相同。
+func midParams(timePeriod int, fn string) (int, error) {
+	c := paramCheck{fn: fn}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
+
+// intMIDPRICE 对应 TA_MIDPRICE：与 TA-Lib 一样对每个窗口重新扫描最高价与最低价，输出两者的平均。
+// MIDPOINT 以同一序列同时作为 high 与 low。
+func intMIDPRICE(high, low []float64, timePeriod int) (int, []float64) {
+	startIdx := timePeriod - 1
+	if startIdx > len(high)-1 {
+		return 0, nil
+	}
+
+	output := make([]float64, 0, len(high)-startIdx)
+	for today, trailingIdx := startIdx, 0; today < len(high); today, trailingIdx = today+1, trailingIdx+1 {
+		lowest, highest := low[trailingIdx], high[trailingIdx]
+		for i := trailingIdx + 1; i <= today; i++ {
+			if low[i] < lowest {
+				lowest = low[i]
+			}
+			if high[i] > highest {
+				highest = high[i]
+			}
+		}
+		output = append(output, (highest+lowest)/2.0)
+	}
+	return startIdx, output
+}
@@ -0,0 +1,43 @@
+package go4ta
+
+// MIDPRICE 计算最近 timePeriod 根（含当根）最高价与最低价的平均（Midpoint Price over period），
+// 与唐奇安通道的中轨相同。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MIDPRICE(high, low []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("MIDPRICE", "high, low", high, low)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("MIDPRICE", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MIDPRICE", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taMIDPRICE(high, low, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// MIDPRICELookback 返回 MIDPRICE 在给定参数下的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MIDPRICELookback(timePeriod int) (int, error) {
+	_, err := midParams(timePeriod, "TA_MIDPRICE")
+	return lookbackResult("TA_MIDPRICE", taMIDPRICELookback(timePeriod), err)
+}
@@ -0,0 +1,240 @@
+package go4ta
+
+// 滑动窗口内的最高值、最低值及其位置，对应 TA-Lib 的 MAX、MIN、MINMAX 与 MAXINDEX、MININDEX、MINMAXINDEX。
+// 窗口为最近 timePeriod 根（含当根），回看期均为 timePeriod-1。
+// INDEX 版本返回极值在输入序列中的下标（从0开始）；FillTrim 下结果变短，但下标仍指向原输入。
+// 整数无法表示 NaN，回看期内的下标一律为0，需要时用对应的 Lookback 函数区分。
+
+// MAX 计算最近 timePeriod 根的最高值（Highest value over a specified period）。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如30）
+// @return []float64 - 最高值序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MAX(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("MAX", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MAX", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taMAX(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// MAXLookback 返回 MAX 在给定参数下的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MAXLookback(timePeriod int) (int, error) {
+	_, err := minMaxParams(timePeriod, "TA_MAX")
+	return lookbackResult("TA_MAX", taMAXLookback(timePeriod), err)
+}
+
+// MIN 计算最近 timePeriod 根的最低值（Lowest value over a specified period）。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如30）
+// @return []float64 - 最低值序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MIN(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("MIN", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MIN", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taMIN(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// MINLookback 返回 MIN 在给定参数下的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MINLookback(timePeriod int) (int, error) {
+	_, err := minMaxParams(timePeriod, "TA_MIN")
+	return lookbackResult("TA_MIN", taMINLookback(timePeriod), err)
+}
+
+// MINMAX 同时计算最低值与最高值，按位置返回各输出，结果含义见 CalcMINMAX。
+//
+// @param close           - 收盘价序列
+// @param timePeriod      - 计算周期（如30）
+// @return lowest, highest - 两个与输入等长的结果序列
+// @return error           - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MINMAX(close []float64, timePeriod int) (lowest, highest []float64, err error) {
+	r, err := CalcMINMAX(close, timePeriod)
+	return r.Min, r.Max, err
+}
+
+// CalcMINMAX 一次计算最近 timePeriod 根的最低值与最高值（Lowest and highest values over a specified period），
+// 结果与分别调用 MIN、MAX 相同。
+//
+// @param close        - 收盘价序列
+// @param timePeriod   - 计算周期（如30）
+// @return MINMAXResult - 最低值与最高值，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error       - 如果输入数据无效或 C 库调用失败，则返回错误。
+func CalcMINMAX(close []float64, timePeriod int) (MINMAXResult, error) {
+	if len(close) == 0 {
+		return MINMAXResult{[]float64{}, []float64{}}, nil
+	}
+	if len(close) < timePeriod {
+		return MINMAXResult{}, tooShort("MINMAX", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MINMAX", float64(timePeriod)); err != nil {
+		return MINMAXResult{}, err
+	}
+
+	outBegIdx, outMin, outMax, err := taMINMAX(close, timePeriod)
+	if err != nil {
+		return MINMAXResult{}, err
+	}
+
+	w := newWarmup(0)
+	return MINMAXResult{
+		Min: w.spread(len(close), outBegIdx, outMin),
+		Max: w.spread(len(close), outBegIdx, outMax),
+	}, nil
+}
+
+// MINMAXLookback 返回 MINMAX 在给定参数下的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MINMAXLookback(timePeriod int) (int, error) {
+	_, err := minMaxParams(timePeriod, "TA_MINMAX")
+	return lookbackResult("TA_MINMAX", taMINMAXLookback(timePeriod), err)
+}
+
+// MAXINDEX 计算最近 timePeriod 根中最高值所在的下标（Index of highest value over a specified period）。
+// 新出现的值与最高值相同时取新的下标；原最高值移出窗口后重新扫描时，相同的值中取最早的一个。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如30）
+// @return []int     - 下标序列，与输入等长，回看期内为0，FillTrim 下只包含回看期之后的部分。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MAXINDEX(close []float64, timePeriod int) ([]int, error) {
+	if len(close) == 0 {
+		return []int{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("MAXINDEX", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MAXINDEX", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taMAXINDEX(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spreadInt(len(close), outBegIdx, output), nil
+}
+
+// MAXINDEXLookback 返回 MAXINDEX 在给定参数下的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MAXINDEXLookback(timePeriod int) (int, error) {
+	_, err := minMaxParams(timePeriod, "TA_MAXINDEX")
+	return lookbackResult("TA_MAXINDEX", taMAXINDEXLookback(timePeriod), err)
+}
+
+// MININDEX 计算最近 timePeriod 根中最低值所在的下标（Index of lowest value over a specified period）。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如30）
+// @return []int     - 下标序列，与输入等长，回看期内为0，FillTrim 下只包含回看期之后的部分。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MININDEX(close []float64, timePeriod int) ([]int, error) {
+	if len(close) == 0 {
+		return []int{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("MININDEX", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MININDEX", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taMININDEX(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spreadInt(len(close), outBegIdx, output), nil
+}
+
+// MININDEXLookback 返回 MININDEX 在给定参数下的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MININDEXLookback(timePeriod int) (int, error) {
+	_, err := minMaxParams(timePeriod, "TA_MININDEX")
+	return lookbackResult("TA_MININDEX", taMININDEXLookback(timePeriod), err)
+}
+
+// MINMAXINDEX 同时计算最近 timePeriod 根中最低值与最高值所在的下标（Indexes of lowest and highest values），
+// 结果与分别调用 MININDEX、MAXINDEX 相同。
+//
+// @param close          - 收盘价序列
+// @param timePeriod     - 计算周期（如30）
+// @return minIdx, maxIdx - 两个与输入等长的下标序列，回看期内为0，FillTrim 下只包含回看期之后的部分。
+// @return error         - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MINMAXINDEX(close []float64, timePeriod int) (minIdx, maxIdx []int, err error) {
+	if len(close) == 0 {
+		return []int{}, []int{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, nil, tooShort("MINMAXINDEX", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MINMAXINDEX", float64(timePeriod)); err != nil {
+		return nil, nil, err
+	}
+
+	outBegIdx, outMinIdx, outMaxIdx, err := taMINMAXINDEX(close, timePeriod)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return spreadInt(len(close), outBegIdx, outMinIdx), spreadInt(len(close), outBegIdx, outMaxIdx), nil
+}
+
+// MINMAXINDEXLookback 返回 MINMAXINDEX 在给定参数下的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MINMAXINDEXLookback(timePeriod int) (int, error) {
+	_, err := minMaxParams(timePeriod, "TA_MINMAXINDEX")
+	return lookbackResult("TA_MINMAXINDEX", taMINMAXINDEXLookback(timePeriod), err)
+}
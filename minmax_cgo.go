@@ -0,0 +1,222 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taMAX 调用 TA_MAX。
+func taMAX(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MAX(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := minMaxParams(timePeriod, "TA_MAX")
+		return 0, nil, taErr("TA_MAX", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taMIN 调用 TA_MIN。
+func taMIN(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MIN(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := minMaxParams(timePeriod, "TA_MIN")
+		return 0, nil, taErr("TA_MIN", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taMINMAX 调用 TA_MINMAX。
+func taMINMAX(close []float64, timePeriod int) (int, []float64, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	outMin := make([]C.double, len(close))
+	cOutMin := (*C.double)(unsafe.Pointer(&outMin[0]))
+	outMax := make([]C.double, len(close))
+	cOutMax := (*C.double)(unsafe.Pointer(&outMax[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MINMAX(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutMin,
+		cOutMax,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := minMaxParams(timePeriod, "TA_MINMAX")
+		return 0, nil, nil, taErr("TA_MINMAX", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(outMin, outNBElement), fromC(outMax, outNBElement), nil
+}
+
+// taMAXINDEX 调用 TA_MAXINDEX。
+func taMAXINDEX(close []float64, timePeriod int) (int, []int, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.int, len(close))
+	cOutput := (*C.int)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MAXINDEX(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := minMaxParams(timePeriod, "TA_MAXINDEX")
+		return 0, nil, taErr("TA_MAXINDEX", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromCInt(output, outNBElement), nil
+}
+
+// taMININDEX 调用 TA_MININDEX。
+func taMININDEX(close []float64, timePeriod int) (int, []int, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.int, len(close))
+	cOutput := (*C.int)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MININDEX(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := minMaxParams(timePeriod, "TA_MININDEX")
+		return 0, nil, taErr("TA_MININDEX", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromCInt(output, outNBElement), nil
+}
+
+// taMINMAXINDEX 调用 TA_MINMAXINDEX。
+func taMINMAXINDEX(close []float64, timePeriod int) (int, []int, []int, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	outMinIdx := make([]C.int, len(close))
+	cOutMinIdx := (*C.int)(unsafe.Pointer(&outMinIdx[0]))
+	outMaxIdx := make([]C.int, len(close))
+	cOutMaxIdx := (*C.int)(unsafe.Pointer(&outMaxIdx[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_MINMAXINDEX(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutMinIdx,
+		cOutMaxIdx,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := minMaxParams(timePeriod, "TA_MINMAXINDEX")
+		return 0, nil, nil, taErr("TA_MINMAXINDEX", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromCInt(outMinIdx, outNBElement), fromCInt(outMaxIdx, outNBElement), nil
+}
+
+// taMAXLookback 调用 TA_MAX_Lookback，参数无效时返回 -1。
+func taMAXLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_MAX_Lookback(C.int(timePeriod)))
+}
+
+// taMINLookback 调用 TA_MIN_Lookback，参数无效时返回 -1。
+func taMINLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_MIN_Lookback(C.int(timePeriod)))
+}
+
+// taMINMAXLookback 调用 TA_MINMAX_Lookback，参数无效时返回 -1。
+func taMINMAXLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_MINMAX_Lookback(C.int(timePeriod)))
+}
+
+// taMAXINDEXLookback 调用 TA_MAXINDEX_Lookback，参数无效时返回 -1。
+func taMAXINDEXLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_MAXINDEX_Lookback(C.int(timePeriod)))
+}
+
+// taMININDEXLookback 调用 TA_MININDEX_Lookback，参数无效时返回 -1。
+func taMININDEXLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_MININDEX_Lookback(C.int(timePeriod)))
+}
+
+// taMINMAXINDEXLookback 调用 TA_MINMAXINDEX_Lookback，参数无效时返回 -1。
+func taMINMAXINDEXLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_MINMAXINDEX_Lookback(C.int(timePeriod)))
+}
@@ -0,0 +1,191 @@
+package go4ta
+
+// nativeMAX 是 TA_MAX 的原生实现。
+func nativeMAX(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := minMaxParams(timePeriod, "TA_MAX")
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := rollingHighest(close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeMIN 是 TA_MIN 的原生实现。
+func nativeMIN(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := minMaxParams(timePeriod, "TA_MIN")
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := rollingLowest(close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeMINMAX 是 TA_MINMAX 的原生实现。
+func nativeMINMAX(close []float64, timePeriod int) (int, []float64, []float64, error) {
+	timePeriod, err := minMaxParams(timePeriod, "TA_MINMAX")
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	outBegIdx, outMin := rollingLowest(close, timePeriod)
+	_, outMax := rollingHighest(close, timePeriod)
+	return outBegIdx, outMin, outMax, nil
+}
+
+// nativeMAXINDEX 是 TA_MAXINDEX 的原生实现。
+func nativeMAXINDEX(close []float64, timePeriod int) (int, []int, error) {
+	timePeriod, err := minMaxParams(timePeriod, "TA_MAXINDEX")
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := rollingHighestIdx(close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeMININDEX 是 TA_MININDEX 的原生实现。
+func nativeMININDEX(close []float64, timePeriod int) (int, []int, error) {
+	timePeriod, err := minMaxParams(timePeriod, "TA_MININDEX")
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := rollingLowestIdx(close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeMINMAXINDEX 是 TA_MINMAXINDEX 的原生实现。
+func nativeMINMAXINDEX(close []float64, timePeriod int) (int, []int, []int, error) {
+	timePeriod, err := minMaxParams(timePeriod, "TA_MINMAXINDEX")
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	outBegIdx, outMinIdx := rollingLowestIdx(close, timePeriod)
+	_, outMaxIdx := rollingHighestIdx(close, timePeriod)
+	return outBegIdx, outMinIdx, outMaxIdx, nil
+}
+
+// nativeMAXLookback 对应 TA_MAX_Lookback，参数无效时返回 -1。
+func nativeMAXLookback(timePeriod int) int {
+	return minMaxLookback(timePeriod, "TA_MAX")
+}
+
+// nativeMINLookback 对应 TA_MIN_Lookback，参数无效时返回 -1。
+func nativeMINLookback(timePeriod int) int {
+	return minMaxLookback(timePeriod, "TA_MIN")
+}
+
+// nativeMINMAXLookback 对应 TA_MINMAX_Lookback，参数无效时返回 -1。
+func nativeMINMAXLookback(timePeriod int) int {
+	return minMaxLookback(timePeriod, "TA_MINMAX")
+}
+
+// nativeMAXINDEXLookback 对应 TA_MAXINDEX_Lookback，参数无效时返回 -1。
+func nativeMAXINDEXLookback(timePeriod int) int {
+	return minMaxLookback(timePeriod, "TA_MAXINDEX")
+}
+
+// nativeMININDEXLookback 对应 TA_MININDEX_Lookback，参数无效时返回 -1。
+func nativeMININDEXLookback(timePeriod int) int {
+	return minMaxLookback(timePeriod, "TA_MININDEX")
+}
+
+// nativeMINMAXINDEXLookback 对应 TA_MINMAXINDEX_Lookback，参数无效时返回 -1。
+func nativeMINMAXINDEXLookback(timePeriod int) int {
+	return minMaxLookback(timePeriod, "TA_MINMAXINDEX")
+}
+
+// minMaxLookback 是 MAX、MIN 及其 INDEX 版本共同的回看期 timePeriod-1，参数无效时返回 -1。
+func minMaxLookback(timePeriod int, fn string) int {
+	timePeriod, err := minMaxParams(timePeriod, fn)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// minMaxParams 按 TA_MAX、TA_MIN 及其 INDEX 版本的规则处理参数，fn 为报错时的函数名。
+func minMaxParams(timePeriod int, fn string) (int, error) {
+	c := paramCheck{fn: fn}
+	timePeriod = c.integer("timePeriod", timePeriod, 30, 2, 100000)
+	return timePeriod, c.err
+}
+
+// rollingHighest 返回每个长度为 timePeriod 的窗口中的最大值，从下标 timePeriod-1 开始紧凑排列。
+func rollingHighest(in []float64, timePeriod int) (int, []float64) {
+	begIdx, idx := rollingHighestIdx(in, timePeriod)
+	return begIdx, valuesAt(in, idx)
+}
+
+// rollingLowest 返回每个长度为 timePeriod 的窗口中的最小值，见 rollingHighest。
+func rollingLowest(in []float64, timePeriod int) (int, []float64) {
+	begIdx, idx := rollingLowestIdx(in, timePeriod)
+	return begIdx, valuesAt(in, idx)
+}
+
+// valuesAt 返回 in 在各下标处的值。
+func valuesAt(in []float64, idx []int) []float64 {
+	if idx == nil {
+		return nil
+	}
+	output := make([]float64, len(idx))
+	for i, j := range idx {
+		output[i] = in[j]
+	}
+	return output
+}
+
+// rollingHighestIdx 返回每个长度为 timePeriod 的窗口中最大值的下标，从下标 timePeriod-1 开始紧凑排列。
+// 与 TA-Lib 的 MAXINDEX 相同，只在最大值移出窗口时才重新扫描；有多个最大值时取最新的一个，
+// 重新扫描时则取最早的一个。
+func rollingHighestIdx(in []float64, timePeriod int) (int, []int) {
+	startIdx := timePeriod - 1
+	if startIdx > len(in)-1 {
+		return 0, nil
+	}
+	output := make([]int, 0, len(in)-startIdx)
+	highestIdx := -1
+	highest := 0.0
+	for today, trailingIdx := startIdx, 0; today < len(in); today, trailingIdx = today+1, trailingIdx+1 {
+		if tmp := in[today]; highestIdx < trailingIdx {
+			highestIdx = trailingIdx
+			highest = in[highestIdx]
+			for i := highestIdx + 1; i <= today; i++ {
+				if in[i] > highest {
+					highestIdx = i
+					highest = in[i]
+				}
+			}
+		} else if tmp >= highest {
+			highestIdx = today
+			highest = tmp
+		}
+		output = append(output, highestIdx)
+	}
+	return startIdx, output
+}
+
+// rollingLowestIdx 返回每个长度为 timePeriod 的窗口中最小值的下标，见 rollingHighestIdx。
+func rollingLowestIdx(in []float64, timePeriod int) (int, []int) {
+	startIdx := timePeriod - 1
+	if startIdx > len(in)-1 {
+		return 0, nil
+	}
+	output := make([]int, 0, len(in)-startIdx)
+	lowestIdx := -1
+	lowest := 0.0
+	for today, trailingIdx := startIdx, 0; today < len(in); today, trailingIdx = today+1, trailingIdx+1 {
+		if tmp := in[today]; lowestIdx < trailingIdx {
+			lowestIdx = trailingIdx
+			lowest = in[lowestIdx]
+			for i := lowestIdx + 1; i <= today; i++ {
+				if in[i] < lowest {
+					lowestIdx = i
+					lowest = in[i]
+				}
+			}
+		} else if tmp <= lowest {
+			lowestIdx = today
+			lowest = tmp
+		}
+		output = append(output, lowestIdx)
+	}
+	return startIdx, output
+}
@@ -0,0 +1,103 @@
+package go4ta
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestMINMAX(t *testing.T) {
+	b := testBars()
+	close := b.Close
+	const period = 10
+
+	maxs, err := MAX(close, period)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mins, err := MIN(close, period)
+	if err != nil {
+		t.Fatal(err)
+	}
+	maxIdx, err := MAXINDEX(close, period)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minIdx, err := MININDEX(close, period)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range close {
+		if i < period-1 {
+			if maxs[i] != 0 || mins[i] != 0 || maxIdx[i] != 0 || minIdx[i] != 0 {
+				t.Fatalf("[%d] = %v %v %d %d within lookback", i, maxs[i], mins[i], maxIdx[i], minIdx[i])
+			}
+			continue
+		}
+		window := close[i-period+1 : i+1]
+		if maxs[i] != slices.Max(window) || mins[i] != slices.Min(window) {
+			t.Fatalf("MAX/MIN[%d] = %v/%v, want %v/%v", i, maxs[i], mins[i], slices.Max(window), slices.Min(window))
+		}
+		if j := maxIdx[i]; j < i-period+1 || j > i || close[j] != maxs[i] {
+			t.Fatalf("MAXINDEX[%d] = %d", i, j)
+		}
+		if j := minIdx[i]; j < i-period+1 || j > i || close[j] != mins[i] {
+			t.Fatalf("MININDEX[%d] = %d", i, j)
+		}
+	}
+
+	r, err := CalcMINMAX(close, period)
+	if err != nil || !equalFloats(r.Min, mins) || !equalFloats(r.Max, maxs) {
+		t.Errorf("CalcMINMAX differs from MIN/MAX: %v", err)
+	}
+	if v := r.Last(); v.Min != mins[len(mins)-1] || v.Max != maxs[len(maxs)-1] {
+		t.Errorf("MINMAXResult.Last = %+v", v)
+	}
+	lo, hi, err := MINMAXINDEX(close, period)
+	if err != nil || !slices.Equal(lo, minIdx) || !slices.Equal(hi, maxIdx) {
+		t.Errorf("MINMAXINDEX differs from MININDEX/MAXINDEX: %v", err)
+	}
+	if byBars, err := b.MINMAX(period); err != nil || !equalFloats(byBars.Max, maxs) {
+		t.Errorf("Bars.MINMAX differs from CalcMINMAX: %v", err)
+	}
+	res, err := Call("MINMAXINDEX", map[string][]float64{"close": close}, map[string]float64{"timePeriod": period})
+	if err != nil || res["MaxIdx"][len(close)-1] != float64(maxIdx[len(close)-1]) {
+		t.Errorf("Call(MINMAXINDEX) = %v", err)
+	}
+
+	// 相同的值中取较新的下标，重新扫描时取最早的
+	idx, err := MAXINDEX([]float64{1, 3, 2, 3, 1, 1, 0}, 3)
+	if err != nil || !slices.Equal(idx, []int{0, 0, 1, 3, 3, 3, 4}) {
+		t.Errorf("MAXINDEX(ties) = %v, %v", idx, err)
+	}
+
+	// FillTrim 下结果变短，下标仍指向原输入
+	withFillPolicy(t, FillTrim, func() {
+		trimmed, err := MAXINDEX(close, period)
+		if err != nil || !slices.Equal(trimmed, maxIdx[period-1:]) {
+			t.Errorf("MAXINDEX with FillTrim = %v, %v", trimmed, err)
+		}
+	})
+
+	for _, name := range []string{"MAX", "MIN", "MINMAX", "MAXINDEX", "MININDEX", "MINMAXINDEX"} {
+		if l, err := Lookback(name, period); err != nil || l != period-1 {
+			t.Errorf("Lookback(%s, %d) = %d, %v", name, period, l, err)
+		}
+	}
+	var fe *FuncError
+	if _, err := MAX(close, 1); !errors.As(err, &fe) || fe.Func != "TA_MAX" || fe.Param != "timePeriod" {
+		t.Errorf("MAX(1) = %v", err)
+	}
+	if _, _, err := MINMAXINDEX(close, 1); !errors.As(err, &fe) || fe.Func != "TA_MINMAXINDEX" {
+		t.Errorf("MINMAXINDEX(1) = %v", err)
+	}
+	if _, err := MININDEXLookback(0); !errors.Is(err, ErrBadParam) {
+		t.Errorf("MININDEXLookback(0) = %v", err)
+	}
+	if _, err := MIN(close[:5], period); !errors.Is(err, ErrInputTooShort) {
+		t.Errorf("MIN(5 bars) = %v", err)
+	}
+	if lo, hi, err := MINMAXINDEX(nil, period); err != nil || len(lo) != 0 || len(hi) != 0 {
+		t.Errorf("MINMAXINDEX(empty) = %v, %v, %v", lo, hi, err)
+	}
+}
@@ -0,0 +1,44 @@
+package go4ta
+
+// MinusDI 计算负向指标（-DI），对应 TA-Lib 的 MINUS_DI。
+// -DI = 100 * 平滑后的 -DM / 平滑后的真实波幅；周期为1时与 TA-Lib 一样不乘以100。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - MinusDI结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MinusDI(high, low, close []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("MinusDI", "high, low, close", high, low, close)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("MinusDI", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MINUSDI", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taMINUSDI(high, low, close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// MinusDILookback 返回 MinusDI 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MinusDILookback(timePeriod int) (int, error) {
+	_, err := minusDIParams(timePeriod)
+	return lookbackResult("TA_MINUS_DI", taMINUSDILookback(timePeriod), err)
+}
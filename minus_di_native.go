@@ -0,0 +1,34 @@
+package go4ta
+
+// nativeMINUSDI 是 TA_MINUS_DI 的原生实现，计算见 intDI。
+func nativeMINUSDI(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := minusDIParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intDI(high, low, close, timePeriod, minusDILookback(timePeriod), false)
+	return outBegIdx, output, nil
+}
+
+func minusDILookback(timePeriod int) int {
+	if timePeriod > 1 {
+		return timePeriod + unstablePeriod(FuncUnstMinusDI)
+	}
+	return 1
+}
+
+// nativeMINUSDILookback 对应 TA_MINUS_DI_Lookback，参数无效时返回 -1。
+func nativeMINUSDILookback(timePeriod int) int {
+	timePeriod, err := minusDIParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return minusDILookback(timePeriod)
+}
+
+// minusDIParams 按 TA_MINUS_DI 的规则处理参数。
+func minusDIParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_MINUS_DI"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 1, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,43 @@
+package go4ta
+
+// MinusDM 计算负向动向值（-DM），对应 TA-Lib 的 MINUS_DM。
+// 当根最低价的下移幅度在大于最高价上移幅度时计入 -DM，再按 Wilder 方式平滑。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - MinusDM结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MinusDM(high, low []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("MinusDM", "high, low", high, low)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("MinusDM", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MINUSDM", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taMINUSDM(high, low, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// MinusDMLookback 返回 MinusDM 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MinusDMLookback(timePeriod int) (int, error) {
+	_, err := minusDMParams(timePeriod)
+	return lookbackResult("TA_MINUS_DM", taMINUSDMLookback(timePeriod), err)
+}
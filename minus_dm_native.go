@@ -0,0 +1,34 @@
+package go4ta
+
+// nativeMINUSDM 是 TA_MINUS_DM 的原生实现，计算见 intDM。
+func nativeMINUSDM(high, low []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := minusDMParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intDM(high, low, timePeriod, minusDMLookback(timePeriod), false)
+	return outBegIdx, output, nil
+}
+
+func minusDMLookback(timePeriod int) int {
+	if timePeriod > 1 {
+		return timePeriod - 1 + unstablePeriod(FuncUnstMinusDM)
+	}
+	return 1
+}
+
+// nativeMINUSDMLookback 对应 TA_MINUS_DM_Lookback，参数无效时返回 -1。
+func nativeMINUSDMLookback(timePeriod int) int {
+	timePeriod, err := minusDMParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return minusDMLookback(timePeriod)
+}
+
+// minusDMParams 按 TA_MINUS_DM 的规则处理参数。
+func minusDMParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_MINUS_DM"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 1, 100000)
+	return timePeriod, c.err
+}
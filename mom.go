@@ -0,0 +1,37 @@
+package go4ta
+
+// MOM 计算动量（Momentum），即当前价格与 timePeriod 根之前价格的差。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如10）
+// @return []float64 - 动量序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func MOM(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("MOM", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("MOM", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taMOM(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// MOMLookback 返回 MOM 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func MOMLookback(timePeriod int) (int, error) {
+	_, err := momParams(timePeriod)
+	return lookbackResult("TA_MOM", taMOMLookback(timePeriod), err)
+}
@@ -0,0 +1,29 @@
+package go4ta
+
+// nativeMOM 是 TA_MOM 的原生实现。
+func nativeMOM(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := momParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intROC(close, timePeriod, func(price, prevPrice float64) float64 {
+		return price - prevPrice
+	})
+	return outBegIdx, output, nil
+}
+
+// nativeMOMLookback 对应 TA_MOM_Lookback，参数无效时返回 -1。
+func nativeMOMLookback(timePeriod int) int {
+	timePeriod, err := momParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod
+}
+
+// momParams 按 TA_MOM 的规则处理参数。
+func momParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_MOM"}
+	timePeriod = c.integer("timePeriod", timePeriod, 10, 1, 100000)
+	return timePeriod, c.err
+}
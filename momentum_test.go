@@ -0,0 +1,147 @@
+package go4ta
+
+import (
+	"errors"
+	"testing"
+)
+
+// 以下参考数据由逐根按定义直接计算得到，CMO 与 TA-Lib 相同地按 Wilder 方式平滑涨跌幅。
+
+func TestMOM(t *testing.T) {
+	g := readGolden(t, "mom.csv")
+	got, err := MOM(g["Close"], 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "MOM", got, g["MOM_10"])
+}
+
+func TestROCFamily(t *testing.T) {
+	g := readGolden(t, "roc.csv")
+	for _, c := range []struct {
+		name string
+		f    func([]float64, int) ([]float64, error)
+	}{
+		{"ROC_10", ROC}, {"ROCP_10", ROCP}, {"ROCR_10", ROCR}, {"ROCR100_10", ROCR100},
+	} {
+		got, err := c.f(g["Close"], 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, c.name, got, g[c.name])
+	}
+
+	// 前值为0时输出0，而不是 Inf
+	got, err := ROC([]float64{0, 1, 2}, 1)
+	if err != nil || got[1] != 0 || got[2] != 100 {
+		t.Errorf("ROC with zero price = %v, %v", got, err)
+	}
+	if lookback, err := ROCR100Lookback(10); err != nil || lookback != 10 {
+		t.Errorf("ROCR100Lookback(10) = %d, %v", lookback, err)
+	}
+}
+
+func TestCMO(t *testing.T) {
+	resetSettings(t)
+	g := readGolden(t, "cmo.csv")
+	got, err := CMO(g["Close"], 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "CMO", got, g["CMO_14"])
+
+	// 设置不稳定期只去掉开头的输出，后面的值不变
+	if err := SetUnstablePeriod(FuncUnstCMO, 10); err != nil {
+		t.Fatal(err)
+	}
+	shifted, err := CMO(g["Close"], 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkShifted(t, "CMO", shifted, got, 24)
+}
+
+func TestTRIX(t *testing.T) {
+	g := readGolden(t, "trix.csv")
+	got, err := TRIX(g["Close"], 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "TRIX", got, g["TRIX_15"])
+	if lookback, err := TRIXLookback(15); err != nil || lookback != 43 {
+		t.Errorf("TRIXLookback(15) = %d, %v", lookback, err)
+	}
+}
+
+func TestCCI(t *testing.T) {
+	g := readGolden(t, "cci.csv")
+	got, err := CCI(g["High"], g["Low"], g["Close"], 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "CCI", got, g["CCI_14"])
+}
+
+func TestWILLR(t *testing.T) {
+	g := readGolden(t, "willr.csv")
+	got, err := WILLR(g["High"], g["Low"], g["Close"], 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "WILLR", got, g["WILLR_14"])
+}
+
+func TestMFI(t *testing.T) {
+	g := readGolden(t, "mfi.csv")
+	got, err := MFI(g["High"], g["Low"], g["Close"], g["Volume"], 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "MFI", got, g["MFI_14"])
+
+	b := testBars()
+	b.Volume = nil
+	var lm *LengthMismatchError
+	if _, err := b.MFI(14); !errors.As(err, &lm) {
+		t.Errorf("MFI without volume = %v, want LengthMismatchError", err)
+	}
+}
+
+func TestULTOSC(t *testing.T) {
+	g := readGolden(t, "ultosc.csv")
+	got, err := ULTOSC(g["High"], g["Low"], g["Close"], 7, 14, 28)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "ULTOSC", got, g["ULTOSC_7_14_28"])
+
+	// 周期的顺序不影响结果
+	swapped, err := ULTOSC(g["High"], g["Low"], g["Close"], 28, 7, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalFloats(swapped, got) {
+		t.Error("ULTOSC(28, 7, 14) differs from ULTOSC(7, 14, 28)")
+	}
+
+	var short *InputTooShortError
+	if _, err := ULTOSC(g["High"][:20], g["Low"][:20], g["Close"][:20], 7, 28, 14); !errors.As(err, &short) || short.Param != "timePeriod2" {
+		t.Errorf("ULTOSC on 20 bars = %v", err)
+	}
+	var fe *FuncError
+	if _, err := ULTOSC(g["High"], g["Low"], g["Close"], 7, 0, 28); !errors.As(err, &fe) || fe.Func != "TA_ULTOSC" || fe.Param != "timePeriod2" {
+		t.Errorf("ULTOSC(7, 0, 28) = %v", err)
+	}
+}
+
+func TestBOP(t *testing.T) {
+	g := readGolden(t, "bop.csv")
+	got, err := BOP(g["Open"], g["High"], g["Low"], g["Close"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "BOP", got, g["BOP"])
+	if BOPLookback() != 0 {
+		t.Errorf("BOPLookback() = %d", BOPLookback())
+	}
+}
@@ -0,0 +1,106 @@
+package go4ta
+
+import "math"
+
+// 原生 Go 实现与 TA-Lib C 源码逐行对应，运算顺序保持一致，以保证两条路径的结果在浮点误差范围内相同。
+
+const (
+	// taIntegerDefault / taRealDefault 对应 TA_INTEGER_DEFAULT / TA_REAL_DEFAULT，表示使用参数默认值。
+	taIntegerDefault = math.MinInt32
+	taRealDefault    = -4e37
+	// taRealMin / taRealMax 是 TA-Lib 对实数参数允许的取值范围。
+	taRealMin = -3e37
+	taRealMax = 3e37
+)
+
+// optInteger 按 TA-Lib 的规则处理整型参数：TA_INTEGER_DEFAULT 取默认值，超出范围视为 TA_BAD_PARAM。
+func optInteger(v, def, min, max int) (int, bool) {
+	if v == taIntegerDefault {
+		return def, true
+	}
+	if v < min || v > max {
+		return 0, false
+	}
+	return v, true
+}
+
+// optReal 按 TA-Lib 的规则处理实数参数。
+func optReal(v, def, min, max float64) (float64, bool) {
+	if v == taRealDefault {
+		return def, true
+	}
+	if v < min || v > max {
+		return 0, false
+	}
+	return v, true
+}
+
+// optMAType 按 TA-Lib 的规则处理均线类型参数：TA_INTEGER_DEFAULT 取 SMA，超出 0..8 视为 TA_BAD_PARAM。
+func optMAType(v int) (int, bool) {
+	if v == taIntegerDefault {
+		return 0, true
+	}
+	return v, validMAType(v)
+}
+
+// paramCheck 按 TA-Lib 的规则逐个处理函数的可选参数，记录第一个无效参数对应的错误。
+type paramCheck struct {
+	fn  string
+	err error
+}
+
+func (c *paramCheck) integer(name string, v, def, min, max int) int {
+	r, ok := optInteger(v, def, min, max)
+	c.check(ok, name, v)
+	return r
+}
+
+func (c *paramCheck) real(name string, v, def, min, max float64) float64 {
+	r, ok := optReal(v, def, min, max)
+	c.check(ok, name, v)
+	return r
+}
+
+func (c *paramCheck) maType(name string, v int) int {
+	r, ok := optMAType(v)
+	c.check(ok, name, v)
+	return r
+}
+
+func (c *paramCheck) check(ok bool, name string, v any) {
+	if !ok && c.err == nil {
+		c.err = badParam(c.fn, name, v)
+	}
+}
+
+// validMAType 检查均线类型是否在 TA-Lib 支持的 0..8 范围内。
+func validMAType(maType int) bool {
+	return maType >= 0 && maType <= 8
+}
+
+// isZero 对应 TA-Lib 的 TA_IS_ZERO 宏。
+func isZero(v float64) bool {
+	return -0.00000001 < v && v < 0.00000001
+}
+
+// isZeroOrNeg 对应 TA-Lib 的 TA_IS_ZERO_OR_NEG 宏。
+func isZeroOrNeg(v float64) bool {
+	return v < 0.00000001
+}
+
+// perToK 对应 TA-Lib 的 PER_TO_K 宏，将周期换算为 EMA 平滑系数。
+func perToK(period int) float64 {
+	return 2.0 / float64(period+1)
+}
+
+// trueRange 对应 TA-Lib 的 TRUE_RANGE 宏。
+func trueRange(high, low, prevClose float64) float64 {
+	out := high - low
+	if v := math.Abs(high - prevClose); v > out {
+		out = v
+	}
+	if v := math.Abs(low - prevClose); v > out {
+		out = v
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package go4ta
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNativeMATypesOnConstantSeries(t *testing.T) {
+	in := make([]float64, 200)
+	for i := range in {
+		in[i] = 42.5
+	}
+
+	for maType := 0; maType <= 8; maType++ {
+		outBegIdx, output, err := nativeMA(in, 10, maType)
+		if err != nil {
+			t.Fatalf("maType=%d 计算失败: %v", maType, err)
+		}
+		if outBegIdx != maLookback(10, maType) {
+			t.Errorf("maType=%d outBegIdx 期望%d, 实际%d", maType, maLookback(10, maType), outBegIdx)
+		}
+		if outBegIdx+len(output) != len(in) {
+			t.Errorf("maType=%d 输出个数 期望%d, 实际%d", maType, len(in)-outBegIdx, len(output))
+		}
+		for i, v := range output {
+			if maType == 7 {
+				// MAMA 从 0 开始自适应逼近，只检查收敛后的值
+				if i < len(output)-1 {
+					continue
+				}
+			}
+			if math.Abs(v-42.5) > 1e-9 {
+				t.Errorf("maType=%d output[%d] 期望42.5, 实际%.12f", maType, i, v)
+				break
+			}
+		}
+	}
+}
+
+func TestNativeMAMatchesSimpleAverage(t *testing.T) {
+	in := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	outBegIdx, sma, _ := nativeMA(in, 4, 0)
+	if outBegIdx != 3 || len(sma) != 7 || sma[0] != 2.5 || sma[6] != 8.5 {
+		t.Errorf("SMA 结果错误: begIdx=%d, %v", outBegIdx, sma)
+	}
+
+	// WMA(3) 在下标2处 = (1*1+2*2+3*3)/6
+	_, wma, _ := nativeMA(in, 3, 2)
+	if math.Abs(wma[0]-14.0/6.0) > 1e-12 {
+		t.Errorf("WMA[2] 期望%.6f, 实际%.6f", 14.0/6.0, wma[0])
+	}
+
+	// TRIMA(4) 权重为 1,2,2,1
+	_, trima, _ := nativeMA(in, 4, 5)
+	if math.Abs(trima[0]-15.0/6.0) > 1e-12 {
+		t.Errorf("TRIMA[3] 期望%.6f, 实际%.6f", 15.0/6.0, trima[0])
+	}
+}
+
+func TestNativeBadParam(t *testing.T) {
+	in := []float64{1, 2, 3, 4, 5}
+	if _, _, err := nativeMA(in, 0, 0); err == nil {
+		t.Error("周期为0应返回错误")
+	}
+	if _, _, err := nativeMA(in, 3, 9); err == nil {
+		t.Error("非法均线类型应返回错误")
+	}
+	if _, _, err := nativeRSI(in, 1); err == nil {
+		t.Error("RSI 周期为1应返回错误")
+	}
+}
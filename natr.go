@@ -0,0 +1,44 @@
+package go4ta
+
+// NATR 计算归一化平均真实波幅（Normalized ATR），即 ATR 占收盘价的百分比，可在不同价位的品种间比较。
+// 与 TA-Lib 相同，timePeriod 为1时输出未归一化的真实波幅。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - NATR 结果序列，收盘价为0的价格柱为0，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func NATR(high, low, close []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("NATR", "high, low, close", high, low, close)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("NATR", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("NATR", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taNATR(high, low, close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// NATRLookback 返回 NATR 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func NATRLookback(timePeriod int) (int, error) {
+	_, err := natrParams(timePeriod)
+	return lookbackResult("TA_NATR", taNATRLookback(timePeriod), err)
+}
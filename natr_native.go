@@ -0,0 +1,38 @@
+package go4ta
+
+// nativeNATR 是 TA_NATR 的原生实现。与 TA-Lib 相同，周期为1时直接输出真实波幅，不做归一化。
+func nativeNATR(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := natrParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	outBegIdx, output := intWilderTR(high, low, close, timePeriod, FuncUnstNATR)
+	if timePeriod <= 1 {
+		return outBegIdx, output, nil
+	}
+	for i, atr := range output {
+		if tempValue := close[outBegIdx+i]; !isZero(tempValue) {
+			output[i] = (atr / tempValue) * 100.0
+		} else {
+			output[i] = 0.0
+		}
+	}
+	return outBegIdx, output, nil
+}
+
+// nativeNATRLookback 对应 TA_NATR_Lookback，参数无效时返回 -1。
+func nativeNATRLookback(timePeriod int) int {
+	timePeriod, err := natrParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod + unstablePeriod(FuncUnstNATR)
+}
+
+// natrParams 按 TA_NATR 的规则处理参数。
+func natrParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_NATR"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 1, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,46 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taOBV 调用 TA_OBV。
+func taOBV(close, volume []float64) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	cVolume := (*C.double)(unsafe.Pointer(&volume[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_OBV(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		cVolume,
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		return 0, nil, taErr("TA_OBV", RetCode(retCode), nil)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taOBVLookback 调用 TA_OBV_Lookback，参数无效时返回 -1。
+func taOBVLookback() int {
+	defer readSettings()()
+	return int(C.TA_OBV_Lookback())
+}
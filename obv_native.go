@@ -0,0 +1,23 @@
+package go4ta
+
+// nativeOBV 是 TA_OBV 的原生实现。
+func nativeOBV(close, volume []float64) (int, []float64, error) {
+	output := make([]float64, len(close))
+	prevOBV := volume[0]
+	prevReal := close[0]
+	for i, tempReal := range close {
+		if tempReal > prevReal {
+			prevOBV += volume[i]
+		} else if tempReal < prevReal {
+			prevOBV -= volume[i]
+		}
+		output[i] = prevOBV
+		prevReal = tempReal
+	}
+	return 0, output, nil
+}
+
+// nativeOBVLookback 对应 TA_OBV_Lookback，参数无效时返回 -1。
+func nativeOBVLookback() int {
+	return 0
+}
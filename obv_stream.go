@@ -0,0 +1,73 @@
+package go4ta
+
+// OBVStream 是 OBV 的流式版本，从第一根价格柱起即有效。
+type OBVStream struct {
+	state obvState
+	value float64
+	ready bool
+}
+
+// NewOBVStream 创建能量潮流式指标。
+func NewOBVStream() *OBVStream {
+	return &OBVStream{}
+}
+
+// Update 输入新的收盘价与成交量，返回最新的 OBV。
+func (s *OBVStream) Update(close, volume float64) float64 {
+	s.value, s.ready = s.state.update(close, volume)
+	return s.value
+}
+
+// Value 返回最近一次 Update 的结果。
+func (s *OBVStream) Value() float64 {
+	return s.value
+}
+
+// Ready 表示是否已经输入过价格柱。
+func (s *OBVStream) Ready() bool {
+	return s.ready
+}
+
+func (s *OBVStream) snapshot() *streamSnapshot {
+	return &streamSnapshot{kind: "OBV", values: []*float64{&s.value}, ready: &s.ready, state: &s.state}
+}
+
+// MarshalBinary 将完整的计算状态编码为二进制快照。
+func (s *OBVStream) MarshalBinary() ([]byte, error) {
+	return s.snapshot().marshalBinary()
+}
+
+// UnmarshalBinary 从 MarshalBinary 生成的快照恢复状态。
+func (s *OBVStream) UnmarshalBinary(data []byte) error {
+	return s.snapshot().unmarshalBinary(data)
+}
+
+// MarshalJSON 将完整的计算状态编码为 JSON 快照。
+func (s *OBVStream) MarshalJSON() ([]byte, error) {
+	return s.snapshot().marshalJSON()
+}
+
+// UnmarshalJSON 从 MarshalJSON 生成的快照恢复状态。
+func (s *OBVStream) UnmarshalJSON(data []byte) error {
+	return s.snapshot().unmarshalJSON(data)
+}
+
+// obvState 对应 nativeOBV，第一根价格柱的成交量作为初始值。
+type obvState struct {
+	Count     int
+	PrevClose float64
+	PrevOBV   float64
+}
+
+func (s *obvState) update(close, volume float64) (float64, bool) {
+	s.Count++
+	if s.Count == 1 {
+		s.PrevOBV = volume
+	} else if close > s.PrevClose {
+		s.PrevOBV += volume
+	} else if close < s.PrevClose {
+		s.PrevOBV -= volume
+	}
+	s.PrevClose = close
+	return s.PrevOBV, true
+}
@@ -0,0 +1,156 @@
+package go4ta
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// patternBars 在 base 根实体为1、振幅为2的普通阳线之后接上 pattern，使各项平均阈值容易手算：
+// 长实体须大于1，十字星的实体不超过0.2，极短影线小于0.2。
+func patternBars(base int, pattern ...Candle) *Bars {
+	candles := make([]Candle, 0, base+len(pattern))
+	for range base {
+		candles = append(candles, Candle{Open: 100, High: 101.5, Low: 99.5, Close: 101})
+	}
+	return NewBars(append(candles, pattern...))
+}
+
+func TestCandlePatternDoji(t *testing.T) {
+	b := patternBars(10, Candle{Open: 100, High: 101, Low: 99, Close: 100.05})
+	got, err := CDLDOJI(b.Open, b.High, b.Low, b.Close)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := append(make([]int, 10), 100); !slices.Equal(got, want) {
+		t.Errorf("CDLDOJI = %v, want %v", got, want)
+	}
+	if lookback, err := Lookback("CDLDOJI"); err != nil || lookback != 10 {
+		t.Errorf("Lookback(CDLDOJI) = %d, %v", lookback, err)
+	}
+
+	// 回看期之内只有 FillTrim 会改变结果
+	defer SetFillPolicy(GetFillPolicy())
+	if err := SetFillPolicy(FillTrim); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := CDLDOJI(b.Open, b.High, b.Low, b.Close); err != nil || !slices.Equal(got, []int{100}) {
+		t.Errorf("CDLDOJI with FillTrim = %v, %v", got, err)
+	}
+}
+
+func TestCandlePatternEngulfing(t *testing.T) {
+	black := Candle{Open: 101, High: 101.2, Low: 99.8, Close: 100}
+	for _, c := range []struct {
+		name    string
+		candle  Candle
+		outcome int
+	}{
+		{"engulfing", Candle{Open: 99.5, High: 101.8, Low: 99.3, Close: 101.5}, 100},
+		{"open at prior close", Candle{Open: 100, High: 101.8, Low: 99.8, Close: 101.5}, 80},
+		{"inside", Candle{Open: 100.2, High: 101, Low: 100, Close: 100.8}, 0},
+	} {
+		b := patternBars(2, black, c.candle)
+		got, err := CDLENGULFING(b.Open, b.High, b.Low, b.Close)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got[3] != c.outcome {
+			t.Errorf("%s: CDLENGULFING = %d, want %d", c.name, got[3], c.outcome)
+		}
+	}
+}
+
+func TestCandlePatternHammer(t *testing.T) {
+	b := patternBars(11, Candle{Open: 99.6, High: 99.85, Low: 98.5, Close: 99.8})
+	hammer, err := CDLHAMMER(b.Open, b.High, b.Low, b.Close)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hangingMan, err := CDLHANGINGMAN(b.Open, b.High, b.Low, b.Close)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hammer[11] != 100 || hangingMan[11] != 0 {
+		t.Errorf("CDLHAMMER = %d, CDLHANGINGMAN = %d", hammer[11], hangingMan[11])
+	}
+}
+
+func TestCandlePatternMorningStar(t *testing.T) {
+	b := patternBars(12,
+		Candle{Open: 102, High: 102.2, Low: 98.8, Close: 99},
+		Candle{Open: 98.5, High: 98.8, Low: 98.3, Close: 98.6},
+		Candle{Open: 98.8, High: 101.2, Low: 98.7, Close: 101},
+	)
+	got, err := CDLMORNINGSTAR(b.Open, b.High, b.Low, b.Close, 0.3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[14] != 100 {
+		t.Errorf("CDLMORNINGSTAR = %d, want 100", got[14])
+	}
+	// 第三根须深入第一根实体的80%，即收于101.4之上
+	if got, err := CDLMORNINGSTAR(b.Open, b.High, b.Low, b.Close, 0.8); err != nil || got[14] != 0 {
+		t.Errorf("CDLMORNINGSTAR(0.8) = %d, %v", got[14], err)
+	}
+	if lookback, err := Lookback("CDLMORNINGSTAR", 0.3); err != nil || lookback != 12 {
+		t.Errorf("Lookback(CDLMORNINGSTAR) = %d, %v", lookback, err)
+	}
+
+	var fe *FuncError
+	if _, err := CDLMORNINGSTAR(b.Open, b.High, b.Low, b.Close, -0.1); !errors.As(err, &fe) || fe.Func != "TA_CDLMORNINGSTAR" || fe.Param != "penetration" {
+		t.Errorf("CDLMORNINGSTAR(-0.1) = %v", err)
+	}
+	if _, err := CandlePattern("CDLDOJI", b.Open, b.High, b.Low, b.Close, 0.3); !errors.Is(err, ErrBadParam) {
+		t.Errorf("CandlePattern(CDLDOJI, 0.3) = %v", err)
+	}
+}
+
+func TestCandlePatterns(t *testing.T) {
+	b := testBars()
+	all, err := b.CandlePatterns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != b.Len() {
+		t.Fatalf("CandlePatterns = %d bars, want %d", len(all), b.Len())
+	}
+
+	// 与逐个调用的结果一致，且取值只有 TA-Lib 使用的几种
+	found := 0
+	for _, name := range CandlePatternNames() {
+		got, err := b.CandlePattern(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, v := range got {
+			switch v {
+			case 0, 80, -80, 100, -100, 200, -200:
+			default:
+				t.Errorf("%s[%d] = %d", name, i, v)
+			}
+			if (v != 0) != slices.Contains(all[i], name) {
+				t.Errorf("%s[%d] = %d, CandlePatterns %v", name, i, v, all[i])
+			}
+			if v != 0 {
+				found++
+			}
+		}
+	}
+	if found == 0 {
+		t.Error("no patterns found in test bars")
+	}
+
+	if len(CandlePatternNames()) != 61 {
+		t.Errorf("CandlePatternNames = %d names", len(CandlePatternNames()))
+	}
+	if _, err := CandlePattern("CDLNONE", b.Open, b.High, b.Low, b.Close); !errors.Is(err, RetFuncNotFound) {
+		t.Errorf("CandlePattern(CDLNONE) = %v", err)
+	}
+	if _, err := (&Bars{High: b.High, Low: b.Low, Close: b.Close}).CandlePatterns(); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("Bars.CandlePatterns without Open = %v", err)
+	}
+	if got, err := CandlePatterns(nil, nil, nil, nil); err != nil || len(got) != 0 {
+		t.Errorf("CandlePatterns(empty) = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,79 @@
+package go4ta
+
+// nativePLUSDI 是 TA_PLUS_DI 的原生实现。
+func nativePLUSDI(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := plusDIParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intDI(high, low, close, timePeriod, plusDILookback(timePeriod), true)
+	return outBegIdx, output, nil
+}
+
+func plusDILookback(timePeriod int) int {
+	if timePeriod > 1 {
+		return timePeriod + unstablePeriod(FuncUnstPlusDI)
+	}
+	return 1
+}
+
+// intDI 对应 TA_PLUS_DI 与 TA_MINUS_DI，plus 为 true 时计算 +DI。
+// 周期为1时不做平滑，TA-Lib 直接输出 DM/TR 而不乘以100。
+func intDI(high, low, close []float64, timePeriod, lookbackTotal int, plus bool) (int, []float64) {
+	startIdx := lookbackTotal
+	if startIdx > len(high)-1 {
+		return 0, nil
+	}
+
+	output := make([]float64, 0, len(high)-startIdx)
+	if timePeriod <= 1 {
+		for today := startIdx; today < len(high); today++ {
+			diffP := high[today] - high[today-1]
+			diffM := low[today-1] - low[today]
+			di := 0.0
+			if plus && diffP > 0 && diffP > diffM {
+				if tr := trueRange(high[today], low[today], close[today-1]); !isZero(tr) {
+					di = diffP / tr
+				}
+			} else if !plus && diffM > 0 && diffP < diffM {
+				if tr := trueRange(high[today], low[today], close[today-1]); !isZero(tr) {
+					di = diffM / tr
+				}
+			}
+			output = append(output, di)
+		}
+		return startIdx, output
+	}
+
+	s := newDMState(timePeriod)
+	for today := range high {
+		// 不稳定期内照常递推，只是不输出
+		s.update(high[today], low[today], close[today])
+		if today < startIdx {
+			continue
+		}
+		plusDI, minusDI := s.di()
+		if plus {
+			output = append(output, plusDI)
+		} else {
+			output = append(output, minusDI)
+		}
+	}
+	return startIdx, output
+}
+
+// nativePLUSDILookback 对应 TA_PLUS_DI_Lookback，参数无效时返回 -1。
+func nativePLUSDILookback(timePeriod int) int {
+	timePeriod, err := plusDIParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return plusDILookback(timePeriod)
+}
+
+// plusDIParams 按 TA_PLUS_DI 的规则处理参数。
+func plusDIParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_PLUS_DI"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 1, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,43 @@
+package go4ta
+
+// PlusDM 计算正向动向值（+DM），对应 TA-Lib 的 PLUS_DM。
+// 当根最高价的上移幅度在大于最低价下移幅度时计入 +DM，再按 Wilder 方式平滑。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - PlusDM结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func PlusDM(high, low []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("PlusDM", "high, low", high, low)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("PlusDM", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("PLUSDM", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taPLUSDM(high, low, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// PlusDMLookback 返回 PlusDM 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func PlusDMLookback(timePeriod int) (int, error) {
+	_, err := plusDMParams(timePeriod)
+	return lookbackResult("TA_PLUS_DM", taPLUSDMLookback(timePeriod), err)
+}
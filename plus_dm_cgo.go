@@ -0,0 +1,48 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taPLUSDM 调用 TA_PLUS_DM。
+func taPLUSDM(high, low []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	output := make([]C.double, len(high))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_PLUS_DM(
+		0,
+		C.int(len(high)-1),
+		cHigh,
+		cLow,
+		C.int(timePeriod),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := plusDMParams(timePeriod)
+		return 0, nil, taErr("TA_PLUS_DM", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taPLUSDMLookback 调用 TA_PLUS_DM_Lookback，参数无效时返回 -1。
+func taPLUSDMLookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_PLUS_DM_Lookback(C.int(timePeriod)))
+}
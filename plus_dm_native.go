@@ -0,0 +1,85 @@
+package go4ta
+
+// nativePLUSDM 是 TA_PLUS_DM 的原生实现。
+func nativePLUSDM(high, low []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := plusDMParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intDM(high, low, timePeriod, plusDMLookback(timePeriod), true)
+	return outBegIdx, output, nil
+}
+
+func plusDMLookback(timePeriod int) int {
+	if timePeriod > 1 {
+		return timePeriod - 1 + unstablePeriod(FuncUnstPlusDM)
+	}
+	return 1
+}
+
+// intDM 对应 TA_PLUS_DM 与 TA_MINUS_DM，plus 为 true 时计算 +DM。
+// 先累加 timePeriod-1 个 DM，之后按 Wilder 方式平滑；周期为1时直接输出每根价格柱的 DM。
+func intDM(high, low []float64, timePeriod, lookbackTotal int, plus bool) (int, []float64) {
+	startIdx := lookbackTotal
+	if startIdx > len(high)-1 {
+		return 0, nil
+	}
+
+	// dm 返回第 today 根价格柱的 DM，另一方向的变动更大或本方向没有变动时为0
+	dm := func(today int) float64 {
+		diffP := high[today] - high[today-1]
+		diffM := low[today-1] - low[today]
+		if plus && diffP > 0 && diffP > diffM {
+			return diffP
+		}
+		if !plus && diffM > 0 && diffP < diffM {
+			return diffM
+		}
+		return 0
+	}
+
+	output := make([]float64, 0, len(high)-startIdx)
+	if timePeriod <= 1 {
+		for today := startIdx; today < len(high); today++ {
+			output = append(output, dm(today))
+		}
+		return startIdx, output
+	}
+
+	period := float64(timePeriod)
+	prevDM := 0.0
+	today := 0
+	for i := timePeriod - 1; i > 0; i-- {
+		today++
+		prevDM += dm(today)
+	}
+	// 跳过不稳定期
+	for today < startIdx {
+		today++
+		prevDM = prevDM - (prevDM / period) + dm(today)
+	}
+
+	output = append(output, prevDM)
+	for today < len(high)-1 {
+		today++
+		prevDM = prevDM - (prevDM / period) + dm(today)
+		output = append(output, prevDM)
+	}
+	return startIdx, output
+}
+
+// nativePLUSDMLookback 对应 TA_PLUS_DM_Lookback，参数无效时返回 -1。
+func nativePLUSDMLookback(timePeriod int) int {
+	timePeriod, err := plusDMParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return plusDMLookback(timePeriod)
+}
+
+// plusDMParams 按 TA_PLUS_DM 的规则处理参数。
+func plusDMParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_PLUS_DM"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 1, 100000)
+	return timePeriod, c.err
+}
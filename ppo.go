@@ -1,90 +1,128 @@
 package go4ta
 
-/*
-#cgo LDFLAGS: -lta-lib -lm
-#include <ta-lib/ta_libc.h>
-#include <ta-lib/ta_func.h>
-#include <stdlib.h>
-*/
-import "C"
-import (
-	"fmt"
-	"unsafe"
-)
-
-// PPO 使用 CGO 直接调用 TA-Lib C 库来计算百分比价格振荡器（PPO）。
+// PPO 计算百分比价格振荡器（PPO）。
 //
 // @param close        - 收盘价序列
 // @param fastPeriod   - 快速均线周期
 // @param slowPeriod   - 慢速均线周期
-// @param maType       - 均线类型（如0=SMA，1=EMA等，见TA-Lib文档）
-// @return []float64   - PPO结果序列，与输入等长，未计算部分为0。
+// @param maType       - 均线类型（如 MATypeSMA、MATypeEMA，见 MAType）
+// @return []float64   - PPO结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
 // @return error       - 如果输入数据无效或 C 库调用失败，则返回错误。
-func PPO(close []float64, fastPeriod, slowPeriod, maType int) ([]float64, error) {
+func PPO(close []float64, fastPeriod, slowPeriod int, maType MAType) ([]float64, error) {
 	if len(close) == 0 {
 		return []float64{}, nil
 	}
 	if len(close) < fastPeriod || len(close) < slowPeriod {
-		return nil, fmt.Errorf("input data length (%d) is too small for the given periods", len(close))
+		return nil, tooShort("PPO", len(close), "", max(fastPeriod, slowPeriod))
+	}
+
+	if err := checkParams("PPO", float64(fastPeriod), float64(slowPeriod), float64(maType)); err != nil {
+		return nil, err
 	}
 
-	cClose := (*C.double)(unsafe.Pointer(&close[0]))
-	output := make([]C.double, len(close))
-	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+	outBegIdx, output, err := taPPO(close, fastPeriod, slowPeriod, int(maType))
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// PPOWithSignal 计算PPO、信号线（PPO的EMA）和柱状图（PPO-信号线），按位置返回各输出，结果含义见 CalcPPOWithSignal。
+//
+// @param close        - 收盘价序列
+// @param fastPeriod   - 快速均线周期
+// @param slowPeriod   - 慢速均线周期
+// @param signalPeriod - 信号线周期
+// @param maType       - 均线类型
+// @return ppo, signal, hist - 三个结果序列，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error       - 如果输入数据无效或 C 库调用失败，则返回错误。
+func PPOWithSignal(close []float64, fastPeriod, slowPeriod, signalPeriod int, maType MAType) (ppo, signal, hist []float64, err error) {
+	r, err := CalcPPOWithSignal(close, fastPeriod, slowPeriod, signalPeriod, maType)
+	return r.PPO, r.Signal, r.Hist, err
+}
 
-	outBegIdx := C.int(0)
-	outNBElement := C.int(0)
+// CalcPPOWithSignal 计算PPO、信号线（PPO的EMA）和柱状图（PPO-信号线）。
+// 信号线只对 PPO 的有效部分计算，因此信号线和柱状图的回看期为 PPOWithSignalLookback。
+// FillTrim 下三条输出都从信号线的第一个有效值开始，PPO 较早的有效值被一并去掉。
+//
+// @param close        - 收盘价序列
+// @param fastPeriod   - 快速均线周期
+// @param slowPeriod   - 慢速均线周期
+// @param signalPeriod - 信号线周期
+// @param maType       - 均线类型
+// @return PPOResult   - 三个等长的结果序列，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error       - 如果输入数据无效或 C 库调用失败，则返回错误。
+func CalcPPOWithSignal(close []float64, fastPeriod, slowPeriod, signalPeriod int, maType MAType) (PPOResult, error) {
+	if len(close) == 0 {
+		return PPOResult{[]float64{}, []float64{}, []float64{}}, nil
+	}
+	if len(close) < fastPeriod || len(close) < slowPeriod {
+		return PPOResult{}, tooShort("PPOWithSignal", len(close), "", max(fastPeriod, slowPeriod))
+	}
+	if err := checkParams("PPOWITHSIGNAL", float64(fastPeriod), float64(slowPeriod), float64(signalPeriod), float64(maType)); err != nil {
+		return PPOResult{}, err
+	}
 
-	retCode := C.TA_PPO(
-		0,
-		C.int(len(close)-1),
-		cClose,
-		C.int(fastPeriod),
-		C.int(slowPeriod),
-		C.TA_MAType(maType),
-		&outBegIdx,
-		&outNBElement,
-		cOutput,
-	)
+	ppoBegIdx, outPPO, err := taPPO(close, fastPeriod, slowPeriod, int(maType))
+	if err != nil {
+		return PPOResult{}, err
+	}
 
-	if retCode != C.TA_SUCCESS {
-		return nil, fmt.Errorf("TA-Lib C call failed with exit code: %d", retCode)
+	// 信号线的下标相对于 PPO 的第一个有效值
+	signalBegIdx, outSignal := 0, []float64(nil)
+	if len(outPPO) > 0 {
+		signalBegIdx, outSignal, err = taMA(outPPO, signalPeriod, 1)
+		if err != nil {
+			return PPOResult{}, err
+		}
+	}
+	outHist := make([]float64, len(outSignal))
+	for i, v := range outSignal {
+		outHist[i] = outPPO[signalBegIdx+i] - v
 	}
 
-	result := make([]float64, len(close))
-	for i := 0; i < int(outNBElement); i++ {
-		result[int(outBegIdx)+i] = float64(output[i])
+	// FillTrim 下 PPO 也从信号线的第一个有效值开始，三条输出等长
+	alignIdx := ppoBegIdx + signalBegIdx
+	if len(outSignal) == 0 {
+		alignIdx = len(close)
 	}
+	w := newWarmup(0)
+	return PPOResult{
+		PPO:    w.spreadAligned(len(close), ppoBegIdx, alignIdx, outPPO),
+		Signal: w.spreadAligned(len(close), alignIdx, alignIdx, outSignal),
+		Hist:   w.spreadAligned(len(close), alignIdx, alignIdx, outHist),
+	}, nil
+}
 
-	return result, nil
+// PPOLookback 返回 PPO 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param fastPeriod - 快速均线周期
+// @param slowPeriod - 慢速均线周期
+// @param maType     - 均线类型
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func PPOLookback(fastPeriod, slowPeriod int, maType MAType) (int, error) {
+	_, _, _, err := ppoParams(fastPeriod, slowPeriod, int(maType))
+	return lookbackResult("TA_PPO", taPPOLookback(fastPeriod, slowPeriod, int(maType)), err)
 }
 
-// PPOWithSignal 计算PPO、信号线（PPO的EMA）和柱状图（PPO-信号线）
-func PPOWithSignal(close []float64, fastPeriod, slowPeriod, signalPeriod, maType int) (ppo, signal, hist []float64, err error) {
-	ppo, err = PPO(close, fastPeriod, slowPeriod, maType)
+// PPOWithSignalLookback 返回 PPOWithSignal 中信号线与柱状图的回看期，PPO 本身的回看期见 PPOLookback。
+//
+// @param fastPeriod   - 快速均线周期
+// @param slowPeriod   - 慢速均线周期
+// @param signalPeriod - 信号线周期
+// @param maType       - 均线类型
+// @return int         - 回看期
+// @return error       - 参数无效时返回错误
+func PPOWithSignalLookback(fastPeriod, slowPeriod, signalPeriod int, maType MAType) (int, error) {
+	ppoLookback, err := PPOLookback(fastPeriod, slowPeriod, maType)
 	if err != nil {
-		return
-	}
-	// 找到第一个有效PPO的位置
-	firstValid := 0
-	for firstValid < len(ppo) && ppo[firstValid] == 0 {
-		firstValid++
+		return 0, err
 	}
-	// 只对有效区间做EMA
-	signalValid, err := EMA(ppo[firstValid:], signalPeriod)
+	signalLookback, err := EMALookback(signalPeriod)
 	if err != nil {
-		return
-	}
-	signal = make([]float64, len(ppo))
-	hist = make([]float64, len(ppo))
-	for i := range ppo {
-		if i < firstValid {
-			signal[i] = 0
-			hist[i] = 0
-		} else {
-			signal[i] = signalValid[i-firstValid]
-			hist[i] = ppo[i] - signal[i]
-		}
+		return 0, badParam("PPOWithSignal", "signalPeriod", signalPeriod)
 	}
-	return
+	return ppoLookback + signalLookback, nil
 }
@@ -0,0 +1,26 @@
+package go4ta
+
+// nativePPO 是 TA_PPO 的原生实现。
+func nativePPO(close []float64, fastPeriod, slowPeriod, maType int) (int, []float64, error) {
+	fastPeriod, slowPeriod, maType, err := ppoParams(fastPeriod, slowPeriod, maType)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intPO(close, fastPeriod, slowPeriod, maType, true)
+	return outBegIdx, output, nil
+}
+
+// nativePPOLookback 对应 TA_PPO_Lookback，参数无效时返回 -1。
+func nativePPOLookback(fastPeriod, slowPeriod, maType int) int {
+	// PPO 与 APO 的参数与回看期相同
+	return nativeAPOLookback(fastPeriod, slowPeriod, maType)
+}
+
+// ppoParams 按 TA_PPO 的规则处理参数。
+func ppoParams(fastPeriod, slowPeriod, maType int) (int, int, int, error) {
+	c := paramCheck{fn: "TA_PPO"}
+	fastPeriod = c.integer("fastPeriod", fastPeriod, 12, 2, 100000)
+	slowPeriod = c.integer("slowPeriod", slowPeriod, 26, 2, 100000)
+	maType = c.maType("maType", maType)
+	return fastPeriod, slowPeriod, maType, c.err
+}
@@ -49,7 +49,7 @@ func TestPPO(t *testing.T) {
 		}
 	}
 
-	fastPeriod, slowPeriod, signalPeriod, maType := 12, 26, 9, 1 // 1=EMA
+	fastPeriod, slowPeriod, signalPeriod, maType := 12, 26, 9, MATypeEMA
 	ppo, signal, hist, err := PPOWithSignal(closeVals, fastPeriod, slowPeriod, signalPeriod, maType)
 	if err != nil {
 		t.Fatalf("PPOWithSignal计算失败: %v", err)
@@ -0,0 +1,766 @@
+package go4ta
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// IndicatorKind 表示指标的绘制方式。
+type IndicatorKind string
+
+const (
+	KindOverlay    IndicatorKind = "overlay"    // 与价格同一量纲，叠加在价格图上
+	KindOscillator IndicatorKind = "oscillator" // 单独绘制在价格图下方
+	KindPattern    IndicatorKind = "pattern"    // K线形态，结果为 -100/0/100 等标记，标注在对应的价格柱上
+)
+
+// ParamType 是参数的类型。
+type ParamType string
+
+const (
+	ParamInteger ParamType = "integer" // 整数，如周期
+	ParamReal    ParamType = "real"    // 实数，如标准差倍数
+	ParamMAType  ParamType = "maType"  // 均线类型，取值见 ParamSpec.Options
+)
+
+// ParamSpec 描述指标的一个参数。
+type ParamSpec struct {
+	Name     string    `json:"name"`               // 参数名，与导出函数的参数名相同
+	Type     ParamType `json:"type"`               // 参数类型
+	Default  float64   `json:"default"`            // 默认值；Required 为 true 时仅作为界面上的初始值
+	Min      float64   `json:"min"`                // 允许的最小值
+	Max      float64   `json:"max"`                // 允许的最大值
+	Required bool      `json:"required,omitempty"` // 没有默认值，必须给出
+	Options  []string  `json:"options,omitempty"`  // 均线类型各取值的名称，下标即取值
+}
+
+// Indicator 描述一个导出指标，供界面列出可用指标以及在调用前校验配置中的参数。
+type Indicator struct {
+	Name     string        `json:"name"`           // 导出函数名，如 "BBands"
+	Func     string        `json:"func,omitempty"` // 计算所用的 TA-Lib 函数，非 TA-Lib 指标为空
+	Hint     string        `json:"hint"`           // 简短说明
+	Kind     IndicatorKind `json:"kind"`           // 叠加在价格图上还是单独绘制
+	Inputs   []string      `json:"inputs"`         // 需要的价格序列，取值为 time、open、high、low、close、volume，MAVP 另需 periods，CORREL、BETA 为 real0、real1
+	Params   []ParamSpec   `json:"params"`         // 参数，顺序与导出函数相同
+	Outputs  []string      `json:"outputs"`        // 输出名称，顺序与导出函数的返回值相同
+	Lookback string        `json:"lookback"`       // 默认设置下回看期的计算方式，U(X) 表示 X 的不稳定期，C(X) 表示K线形态设置 X 的平均周期
+
+	lookback func(p lookbackParams) (int, error)
+	relation func(values []float64) error // 参数之间的约束，values 为省略参数取默认值后的全部参数
+}
+
+// Validate 按参数说明校验参数，params 的顺序与导出函数相同，省略的末尾参数取默认值。
+//
+// @param params - 参数值
+// @return error - 参数个数过多、缺少必需参数、整型参数不是整数、超出取值范围或参数之间不满足约束
+// （如 MAVP 的 minPeriod 大于 maxPeriod）时返回 ErrBadParam
+func (ind *Indicator) Validate(params ...float64) error {
+	if len(params) > len(ind.Params) {
+		return fmt.Errorf("%s takes at most %d parameters, got %d: %w", ind.Name, len(ind.Params), len(params), ErrBadParam)
+	}
+	fn := ind.errFunc()
+	values := make([]float64, len(ind.Params))
+	for i, spec := range ind.Params {
+		values[i] = spec.Default
+		if i >= len(params) {
+			if spec.Required {
+				return &FuncError{Func: fn, Code: RetBadParam, Param: spec.Name}
+			}
+			continue
+		}
+		v := params[i]
+		if spec.Type == ParamReal {
+			if v == taRealDefault && !spec.Required {
+				continue
+			}
+			if v >= spec.Min && v <= spec.Max {
+				values[i] = v
+				continue
+			}
+			return badParam(fn, spec.Name, v)
+		}
+		if v != math.Trunc(v) {
+			return badParam(fn, spec.Name, v)
+		}
+		if v == taIntegerDefault && !spec.Required {
+			continue
+		}
+		if v >= spec.Min && v <= spec.Max {
+			values[i] = v
+			continue
+		}
+		return badParam(fn, spec.Name, int(v))
+	}
+	if ind.relation != nil {
+		return ind.relation(values)
+	}
+	return nil
+}
+
+// errFunc 返回参数错误中的函数名，与计算过程中 TA-Lib 报告的函数名一致。
+func (ind *Indicator) errFunc() string {
+	if ind.Func == "" {
+		return ind.Name
+	}
+	return "TA_" + ind.Func
+}
+
+// Indicators 返回所有导出指标的说明，按名称排列。
+func Indicators() []Indicator {
+	list := make([]Indicator, 0, len(indicators))
+	for _, ind := range indicators {
+		list = append(list, *ind)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// GetIndicator 按名称返回指标说明，名称不区分大小写。
+//
+// @param name       - 指标名称，如 "STOCHRSI"
+// @return Indicator - 指标说明
+// @return error     - 名称未知时返回 RetFuncNotFound
+func GetIndicator(name string) (Indicator, error) {
+	ind, ok := indicators[strings.ToUpper(name)]
+	if !ok {
+		return Indicator{}, &FuncError{Func: name, Code: RetFuncNotFound}
+	}
+	return *ind, nil
+}
+
+// IndicatorsJSON 以 JSON 数组导出所有指标的说明，字段见 Indicator 与 ParamSpec。
+func IndicatorsJSON() ([]byte, error) {
+	return json.MarshalIndent(Indicators(), "", "  ")
+}
+
+// checkParams 在调用计算之前按注册表校验参数，name 为注册表中的大写名称。
+func checkParams(name string, params ...float64) error {
+	return indicators[name].Validate(params...)
+}
+
+// periodSpec 返回取值范围为 min..100000 的周期参数。
+func periodSpec(name string, def, min float64) ParamSpec {
+	return ParamSpec{Name: name, Type: ParamInteger, Default: def, Min: min, Max: 100000}
+}
+
+// realSpec 返回取值范围为 TA-Lib 全部实数的参数。
+func realSpec(name string, def float64) ParamSpec {
+	return ParamSpec{Name: name, Type: ParamReal, Default: def, Min: taRealMin, Max: taRealMax}
+}
+
+// nonNegSpec 返回不小于0的实数参数。
+func nonNegSpec(name string, def float64) ParamSpec {
+	return ParamSpec{Name: name, Type: ParamReal, Default: def, Min: 0, Max: taRealMax}
+}
+
+// maTypeSpec 返回均线类型参数，默认 SMA。
+func maTypeSpec(name string) ParamSpec {
+	return ParamSpec{Name: name, Type: ParamMAType, Min: 0, Max: 8, Options: maTypeNames}
+}
+
+var (
+	closeInput = []string{"close"}
+	hlInput    = []string{"high", "low"}
+	hlcInput   = []string{"high", "low", "close"}
+	hlcvInput  = []string{"high", "low", "close", "volume"}
+	ohlcInput  = []string{"open", "high", "low", "close"}
+	pairInput  = []string{"real0", "real1"}
+	sarSpecs   = []ParamSpec{nonNegSpec("acceleration", 0.02), nonNegSpec("maximum", 0.2)}
+	poSpecs    = []ParamSpec{periodSpec("fastPeriod", 12, 2), periodSpec("slowPeriod", 26, 2), maTypeSpec("maType")}
+	noLookback = func(lookbackParams) (int, error) { return 0, nil }
+)
+
+// indicators 以大写名称为键，列出各导出指标。
+var indicators = map[string]*Indicator{
+	"MA": {
+		Name: "MA", Func: "MA", Hint: "移动平均线", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 1), maTypeSpec("maType")}, Outputs: []string{"ma"},
+		Lookback: "SMA/WMA/TRIMA: timePeriod-1; EMA: timePeriod-1+U(EMA); DEMA: 2*(timePeriod-1+U(EMA)); TEMA: 3*(timePeriod-1+U(EMA)); " +
+			"KAMA: timePeriod+U(KAMA); MAMA: 32+U(MAMA); T3: 6*(timePeriod-1)+U(T3); timePeriod 为 1 时为 0",
+		lookback: func(p lookbackParams) (int, error) { return MALookback(p.int(0), p.maType(1)) },
+	},
+	"MAVP": {
+		Name: "MAVP", Func: "MAVP", Hint: "可变周期移动平均线", Kind: KindOverlay,
+		Inputs: []string{"close", "periods"}, Params: []ParamSpec{periodSpec("minPeriod", 2, 2), periodSpec("maxPeriod", 30, 2), maTypeSpec("maType")},
+		Outputs:  []string{"mavp"},
+		Lookback: "MA(maxPeriod, maType) 的回看期",
+		lookback: func(p lookbackParams) (int, error) { return MAVPLookback(p.int(0), p.int(1), p.maType(2)) },
+		relation: mavpRelation,
+	},
+	"SMA": {
+		Name: "SMA", Func: "MA", Hint: "简单移动平均", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 1)}, Outputs: []string{"sma"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return SMALookback(p.int(0)) },
+	},
+	"EMA": {
+		Name: "EMA", Func: "MA", Hint: "指数移动平均", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 1)}, Outputs: []string{"ema"},
+		Lookback: "timePeriod-1+U(EMA)",
+		lookback: func(p lookbackParams) (int, error) { return EMALookback(p.int(0)) },
+	},
+	"WMA": {
+		Name: "WMA", Func: "MA", Hint: "加权移动平均", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 1)}, Outputs: []string{"wma"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return WMALookback(p.int(0)) },
+	},
+	"DEMA": {
+		Name: "DEMA", Func: "DEMA", Hint: "双指数移动平均", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 2)}, Outputs: []string{"dema"},
+		Lookback: "2*(timePeriod-1+U(EMA))",
+		lookback: func(p lookbackParams) (int, error) { return DEMALookback(p.int(0)) },
+	},
+	"TEMA": {
+		Name: "TEMA", Func: "TEMA", Hint: "三指数移动平均", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 2)}, Outputs: []string{"tema"},
+		Lookback: "3*(timePeriod-1+U(EMA))",
+		lookback: func(p lookbackParams) (int, error) { return TEMALookback(p.int(0)) },
+	},
+	"TRIMA": {
+		Name: "TRIMA", Func: "TRIMA", Hint: "三角移动平均", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 2)}, Outputs: []string{"trima"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return TRIMALookback(p.int(0)) },
+	},
+	"KAMA": {
+		Name: "KAMA", Func: "KAMA", Hint: "考夫曼自适应移动平均", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 2)}, Outputs: []string{"kama"},
+		Lookback: "timePeriod+U(KAMA)",
+		lookback: func(p lookbackParams) (int, error) { return KAMALookback(p.int(0)) },
+	},
+	"MAMA": {
+		Name: "MAMA", Func: "MAMA", Hint: "MESA 自适应移动平均", Kind: KindOverlay,
+		Inputs: closeInput,
+		Params: []ParamSpec{
+			{Name: "fastLimit", Type: ParamReal, Default: 0.5, Min: 0.01, Max: 0.99},
+			{Name: "slowLimit", Type: ParamReal, Default: 0.05, Min: 0.01, Max: 0.99},
+		},
+		Outputs:  []string{"mama", "fama"},
+		Lookback: "32+U(MAMA)",
+		lookback: func(p lookbackParams) (int, error) { return MAMALookback(p.real(0), p.real(1)) },
+	},
+	"T3": {
+		Name: "T3", Func: "T3", Hint: "三倍平滑移动平均", Kind: KindOverlay,
+		Inputs:   closeInput,
+		Params:   []ParamSpec{periodSpec("timePeriod", 5, 2), {Name: "vFactor", Type: ParamReal, Default: 0.7, Min: 0, Max: 1}},
+		Outputs:  []string{"t3"},
+		Lookback: "6*(timePeriod-1)+U(T3)",
+		lookback: func(p lookbackParams) (int, error) { return T3Lookback(p.int(0), p.real(1)) },
+	},
+	"RSI": {
+		Name: "RSI", Func: "RSI", Hint: "相对强弱指数", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"rsi"},
+		Lookback: "timePeriod+U(RSI)",
+		lookback: func(p lookbackParams) (int, error) { return RSILookback(p.int(0)) },
+	},
+	"MACD": {
+		Name: "MACD", Func: "MACD", Hint: "指数平滑异同移动平均线", Kind: KindOscillator,
+		Inputs:   closeInput,
+		Params:   []ParamSpec{periodSpec("fastPeriod", 12, 2), periodSpec("slowPeriod", 26, 2), periodSpec("signalPeriod", 9, 1)},
+		Outputs:  []string{"macd", "signal", "hist"},
+		Lookback: "max(fastPeriod, slowPeriod)-1+signalPeriod-1+2*U(EMA)",
+		lookback: func(p lookbackParams) (int, error) { return MACDLookback(p.int(0), p.int(1), p.int(2)) },
+	},
+	"BBANDS": {
+		Name: "BBands", Func: "BBANDS", Hint: "布林带", Kind: KindOverlay,
+		Inputs:   closeInput,
+		Params:   []ParamSpec{periodSpec("timePeriod", 5, 2), realSpec("nbDevUp", 2), realSpec("nbDevDn", 2), maTypeSpec("maType")},
+		Outputs:  []string{"upper", "middle", "lower"},
+		Lookback: "MA(timePeriod, maType) 的回看期",
+		lookback: func(p lookbackParams) (int, error) {
+			return BBandsLookback(p.int(0), p.real(1), p.real(2), p.maType(3))
+		},
+	},
+	"SAR": {
+		Name: "SAR", Func: "SAR", Hint: "抛物线转向", Kind: KindOverlay,
+		Inputs: hlInput, Params: sarSpecs, Outputs: []string{"sar"},
+		Lookback: "1",
+		lookback: func(p lookbackParams) (int, error) { return SARLookback(p.real(0), p.real(1)) },
+	},
+	"SAREXT": {
+		Name: "SAREXT", Func: "SAREXT", Hint: "扩展抛物线转向，空头时取负值", Kind: KindOverlay,
+		Inputs: hlInput,
+		Params: []ParamSpec{realSpec("startValue", 0), nonNegSpec("offsetOnReverse", 0),
+			nonNegSpec("accelerationInitLong", 0.02), nonNegSpec("accelerationLong", 0.02), nonNegSpec("accelerationMaxLong", 0.2),
+			nonNegSpec("accelerationInitShort", 0.02), nonNegSpec("accelerationShort", 0.02), nonNegSpec("accelerationMaxShort", 0.2)},
+		Outputs:  []string{"sarext"},
+		Lookback: "1",
+		lookback: func(p lookbackParams) (int, error) {
+			return SAREXTLookback(p.real(0), p.real(1), p.real(2), p.real(3), p.real(4), p.real(5), p.real(6), p.real(7))
+		},
+	},
+	"SARDIRECTION": {
+		Name: "SARDirection", Hint: "抛物线转向所指示的方向", Kind: KindOscillator,
+		Inputs: hlInput, Params: sarSpecs, Outputs: []string{"direction"},
+		Lookback: "1",
+		lookback: func(p lookbackParams) (int, error) { return SARLookback(p.real(0), p.real(1)) },
+	},
+	"ATR": {
+		Name: "ATR", Func: "ATR", Hint: "平均真实波幅", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 1)}, Outputs: []string{"atr"},
+		Lookback: "timePeriod+U(ATR)",
+		lookback: func(p lookbackParams) (int, error) { return ATRLookback(p.int(0)) },
+	},
+	"ADX": {
+		Name: "ADX", Func: "ADX", Hint: "平均趋向指数", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"adx"},
+		Lookback: "2*timePeriod-1+U(ADX)",
+		lookback: func(p lookbackParams) (int, error) { return ADXLookback(p.int(0)) },
+	},
+	"ADXR": {
+		Name: "ADXR", Func: "ADXR", Hint: "平均趋向指数评估", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"adxr"},
+		Lookback: "3*timePeriod-2+U(ADX)",
+		lookback: func(p lookbackParams) (int, error) { return ADXRLookback(p.int(0)) },
+	},
+	"DX": {
+		Name: "DX", Func: "DX", Hint: "动向指数", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"dx"},
+		Lookback: "timePeriod+U(DX)",
+		lookback: func(p lookbackParams) (int, error) { return DXLookback(p.int(0)) },
+	},
+	"PLUSDI": {
+		Name: "PlusDI", Func: "PLUS_DI", Hint: "正向指标 +DI", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 1)}, Outputs: []string{"plusDI"},
+		Lookback: "timePeriod+U(PLUS_DI)；timePeriod 为 1 时为 1",
+		lookback: func(p lookbackParams) (int, error) { return PlusDILookback(p.int(0)) },
+	},
+	"MINUSDI": {
+		Name: "MinusDI", Func: "MINUS_DI", Hint: "负向指标 -DI", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 1)}, Outputs: []string{"minusDI"},
+		Lookback: "timePeriod+U(MINUS_DI)；timePeriod 为 1 时为 1",
+		lookback: func(p lookbackParams) (int, error) { return MinusDILookback(p.int(0)) },
+	},
+	"PLUSDM": {
+		Name: "PlusDM", Func: "PLUS_DM", Hint: "正向动向值 +DM", Kind: KindOscillator,
+		Inputs: hlInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 1)}, Outputs: []string{"plusDM"},
+		Lookback: "timePeriod-1+U(PLUS_DM)；timePeriod 为 1 时为 1",
+		lookback: func(p lookbackParams) (int, error) { return PlusDMLookback(p.int(0)) },
+	},
+	"MINUSDM": {
+		Name: "MinusDM", Func: "MINUS_DM", Hint: "负向动向值 -DM", Kind: KindOscillator,
+		Inputs: hlInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 1)}, Outputs: []string{"minusDM"},
+		Lookback: "timePeriod-1+U(MINUS_DM)；timePeriod 为 1 时为 1",
+		lookback: func(p lookbackParams) (int, error) { return MinusDMLookback(p.int(0)) },
+	},
+	"DMI": {
+		Name: "DMI", Hint: "趋向指标系统：ADX、ADXR、+DI 与 -DI", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"adx", "adxr", "plusDI", "minusDI"},
+		Lookback: "adxr 同 ADXR；adx 同 ADX；plusDI、minusDI 同 PlusDI、MinusDI",
+		lookback: func(p lookbackParams) (int, error) { return DMILookback(p.int(0)) },
+	},
+	"AROON": {
+		Name: "Aroon", Func: "AROON", Hint: "阿隆指标", Kind: KindOscillator,
+		Inputs: hlInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"down", "up"},
+		Lookback: "timePeriod",
+		lookback: func(p lookbackParams) (int, error) { return AroonLookback(p.int(0)) },
+	},
+	"AROONOSC": {
+		Name: "AroonOsc", Func: "AROONOSC", Hint: "阿隆振荡器", Kind: KindOscillator,
+		Inputs: hlInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"aroonOsc"},
+		Lookback: "timePeriod",
+		lookback: func(p lookbackParams) (int, error) { return AroonOscLookback(p.int(0)) },
+	},
+	"VORTEX": {
+		Name: "Vortex", Hint: "涡旋指标", Kind: KindOscillator,
+		Inputs: hlcInput,
+		Params: []ParamSpec{
+			{Name: "timePeriod", Type: ParamInteger, Default: 14, Min: 1, Max: 100000, Required: true},
+		},
+		Outputs:  []string{"plus", "minus"},
+		Lookback: "timePeriod",
+		lookback: func(p lookbackParams) (int, error) { return VortexLookback(p.int(0)) },
+	},
+	"HTDCPERIOD": {
+		Name: "HTDCPeriod", Func: "HT_DCPERIOD", Hint: "希尔伯特变换主导周期", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{}, Outputs: []string{"dcPeriod"},
+		Lookback: "32+U(HT_DCPERIOD)", lookback: func(lookbackParams) (int, error) { return HTDCPeriodLookback(), nil },
+	},
+	"HTDCPHASE": {
+		Name: "HTDCPhase", Func: "HT_DCPHASE", Hint: "希尔伯特变换主导周期相位", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{}, Outputs: []string{"dcPhase"},
+		Lookback: "63+U(HT_DCPHASE)", lookback: func(lookbackParams) (int, error) { return HTDCPhaseLookback(), nil },
+	},
+	"HTPHASOR": {
+		Name: "HTPhasor", Func: "HT_PHASOR", Hint: "希尔伯特变换相量分量", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{}, Outputs: []string{"inPhase", "quadrature"},
+		Lookback: "32+U(HT_PHASOR)", lookback: func(lookbackParams) (int, error) { return HTPhasorLookback(), nil },
+	},
+	"HTSINE": {
+		Name: "HTSine", Func: "HT_SINE", Hint: "希尔伯特变换正弦线", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{}, Outputs: []string{"sine", "leadSine"},
+		Lookback: "63+U(HT_SINE)", lookback: func(lookbackParams) (int, error) { return HTSineLookback(), nil },
+	},
+	"HTTRENDLINE": {
+		Name: "HTTrendline", Func: "HT_TRENDLINE", Hint: "希尔伯特变换瞬时趋势线", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{}, Outputs: []string{"trendline"},
+		Lookback: "63+U(HT_TRENDLINE)", lookback: func(lookbackParams) (int, error) { return HTTrendlineLookback(), nil },
+	},
+	"HTTRENDMODE": {
+		Name: "HTTrendMode", Func: "HT_TRENDMODE", Hint: "希尔伯特变换趋势/周期模式", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{}, Outputs: []string{"trendMode"},
+		Lookback: "63+U(HT_TRENDMODE)", lookback: func(lookbackParams) (int, error) { return HTTrendModeLookback(), nil },
+	},
+	"CYCLEMA": {
+		Name: "CycleMA", Hint: "以主导周期为周期的自适应均线", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("minPeriod", 2, 2), periodSpec("maxPeriod", 30, 2), maTypeSpec("maType")},
+		Outputs:  []string{"cycleMA"},
+		Lookback: "32+U(HT_DCPERIOD) 与 MA(maxPeriod, maType) 回看期中较长的一个",
+		lookback: func(p lookbackParams) (int, error) { return CycleMALookback(p.int(0), p.int(1), p.maType(2)) },
+		relation: mavpRelation,
+	},
+	"STOCH": {
+		Name: "STOCH", Func: "STOCH", Hint: "随机指标（KDJ）", Kind: KindOscillator,
+		Inputs: hlcInput,
+		Params: []ParamSpec{periodSpec("fastKPeriod", 5, 1), periodSpec("slowKPeriod", 3, 1), periodSpec("slowDPeriod", 3, 1),
+			maTypeSpec("maTypeK"), maTypeSpec("maTypeD")},
+		Outputs:  []string{"slowK", "slowD"},
+		Lookback: "fastKPeriod-1 加上 MA(slowKPeriod, maTypeK) 与 MA(slowDPeriod, maTypeD) 的回看期",
+		lookback: func(p lookbackParams) (int, error) {
+			return STOCHLookback(p.int(0), p.int(1), p.int(2), p.maType(3), p.maType(4))
+		},
+	},
+	"STOCHRSI": {
+		Name: "STOCHRSI", Func: "STOCHRSI", Hint: "随机RSI", Kind: KindOscillator,
+		Inputs:   closeInput,
+		Params:   []ParamSpec{periodSpec("timePeriod", 14, 2), periodSpec("fastKPeriod", 5, 1), periodSpec("fastDPeriod", 3, 1), maTypeSpec("maType")},
+		Outputs:  []string{"fastK", "fastD"},
+		Lookback: "RSI(timePeriod) 的回看期加上 fastKPeriod-1 与 MA(fastDPeriod, maType) 的回看期",
+		lookback: func(p lookbackParams) (int, error) {
+			return STOCHRSILookback(p.int(0), p.int(1), p.int(2), p.maType(3))
+		},
+	},
+	"OBV": {
+		Name: "OBV", Func: "OBV", Hint: "能量潮", Kind: KindOscillator,
+		Inputs: []string{"close", "volume"}, Params: []ParamSpec{}, Outputs: []string{"obv"},
+		Lookback: "0", lookback: noLookback,
+	},
+	"AD": {
+		Name: "AD", Func: "AD", Hint: "累积/派发线", Kind: KindOscillator,
+		Inputs: hlcvInput, Params: []ParamSpec{}, Outputs: []string{"ad"},
+		Lookback: "0", lookback: noLookback,
+	},
+	"ADOSC": {
+		Name: "ADOSC", Func: "ADOSC", Hint: "佳庆振荡器", Kind: KindOscillator,
+		Inputs: hlcvInput, Params: []ParamSpec{periodSpec("fastPeriod", 3, 2), periodSpec("slowPeriod", 10, 2)}, Outputs: []string{"adosc"},
+		Lookback: "max(fastPeriod, slowPeriod)-1+U(EMA)",
+		lookback: func(p lookbackParams) (int, error) { return ADOSCLookback(p.int(0), p.int(1)) },
+	},
+	"APO": {
+		Name: "APO", Func: "APO", Hint: "绝对价格振荡器", Kind: KindOscillator,
+		Inputs: closeInput, Params: poSpecs, Outputs: []string{"apo"},
+		Lookback: "MA(max(fastPeriod, slowPeriod), maType) 的回看期",
+		lookback: func(p lookbackParams) (int, error) { return APOLookback(p.int(0), p.int(1), p.maType(2)) },
+	},
+	"PPO": {
+		Name: "PPO", Func: "PPO", Hint: "百分比价格振荡器", Kind: KindOscillator,
+		Inputs: closeInput, Params: poSpecs, Outputs: []string{"ppo"},
+		Lookback: "MA(max(fastPeriod, slowPeriod), maType) 的回看期",
+		lookback: func(p lookbackParams) (int, error) { return PPOLookback(p.int(0), p.int(1), p.maType(2)) },
+	},
+	"PPOWITHSIGNAL": {
+		Name: "PPOWithSignal", Hint: "PPO 及其信号线与柱状图", Kind: KindOscillator,
+		Inputs:   closeInput,
+		Params:   []ParamSpec{periodSpec("fastPeriod", 12, 2), periodSpec("slowPeriod", 26, 2), periodSpec("signalPeriod", 9, 1), maTypeSpec("maType")},
+		Outputs:  []string{"ppo", "signal", "hist"},
+		Lookback: "ppo 同 PPO；signal 与 hist 为 PPO 的回看期加上 signalPeriod-1+U(EMA)",
+		lookback: func(p lookbackParams) (int, error) {
+			return PPOWithSignalLookback(p.int(0), p.int(1), p.int(2), p.maType(3))
+		},
+	},
+	"STDDEV": {
+		Name: "STDDEV", Func: "STDDEV", Hint: "标准差", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 5, 2), realSpec("nbDev", 1)}, Outputs: []string{"stddev"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return STDDEVLookback(p.int(0), p.real(1)) },
+	},
+	"VAR": {
+		Name: "VAR", Func: "VAR", Hint: "方差", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 5, 1), realSpec("nbDev", 1)}, Outputs: []string{"var"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return VARLookback(p.int(0), p.real(1)) },
+	},
+	"CORREL": {
+		Name: "CORREL", Func: "CORREL", Hint: "皮尔逊相关系数", Kind: KindOscillator,
+		Inputs: pairInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 1)}, Outputs: []string{"correl"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return CORRELLookback(p.int(0)) },
+	},
+	"BETA": {
+		Name: "BETA", Func: "BETA", Hint: "贝塔系数", Kind: KindOscillator,
+		Inputs: pairInput, Params: []ParamSpec{periodSpec("timePeriod", 5, 1)}, Outputs: []string{"beta"},
+		Lookback: "timePeriod",
+		lookback: func(p lookbackParams) (int, error) { return BETALookback(p.int(0)) },
+	},
+	"SUM": {
+		Name: "SUM", Func: "SUM", Hint: "累加和", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 2)}, Outputs: []string{"sum"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return SUMLookback(p.int(0)) },
+	},
+	"MAX": {
+		Name: "MAX", Func: "MAX", Hint: "区间最高值", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 2)}, Outputs: []string{"max"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return MAXLookback(p.int(0)) },
+	},
+	"MIN": {
+		Name: "MIN", Func: "MIN", Hint: "区间最低值", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 2)}, Outputs: []string{"min"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return MINLookback(p.int(0)) },
+	},
+	"MINMAX": {
+		Name: "MINMAX", Func: "MINMAX", Hint: "区间最低值与最高值", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 2)}, Outputs: []string{"min", "max"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return MINMAXLookback(p.int(0)) },
+	},
+	"MAXINDEX": {
+		Name: "MAXINDEX", Func: "MAXINDEX", Hint: "区间最高值的下标", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 2)}, Outputs: []string{"maxIndex"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return MAXINDEXLookback(p.int(0)) },
+	},
+	"MININDEX": {
+		Name: "MININDEX", Func: "MININDEX", Hint: "区间最低值的下标", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 2)}, Outputs: []string{"minIndex"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return MININDEXLookback(p.int(0)) },
+	},
+	"MINMAXINDEX": {
+		Name: "MINMAXINDEX", Func: "MINMAXINDEX", Hint: "区间最低值与最高值的下标", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 2)}, Outputs: []string{"minIndex", "maxIndex"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return MINMAXINDEXLookback(p.int(0)) },
+	},
+	"MIDPOINT": {
+		Name: "MIDPOINT", Func: "MIDPOINT", Hint: "区间最高值与最低值的中点", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"midPoint"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return MIDPOINTLookback(p.int(0)) },
+	},
+	"MIDPRICE": {
+		Name: "MIDPRICE", Func: "MIDPRICE", Hint: "区间最高价与最低价的中点", Kind: KindOverlay,
+		Inputs: hlInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"midPrice"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return MIDPRICELookback(p.int(0)) },
+	},
+	"LINEARREG": {
+		Name: "LinearReg", Func: "LINEARREG", Hint: "线性回归", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"linearReg"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return LinearRegLookback(p.int(0)) },
+	},
+	"LINEARREGSLOPE": {
+		Name: "LinearRegSlope", Func: "LINEARREG_SLOPE", Hint: "线性回归斜率", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"slope"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return LinearRegSlopeLookback(p.int(0)) },
+	},
+	"LINEARREGANGLE": {
+		Name: "LinearRegAngle", Func: "LINEARREG_ANGLE", Hint: "线性回归角度", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"angle"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return LinearRegAngleLookback(p.int(0)) },
+	},
+	"LINEARREGINTERCEPT": {
+		Name: "LinearRegIntercept", Func: "LINEARREG_INTERCEPT", Hint: "线性回归截距", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"intercept"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return LinearRegInterceptLookback(p.int(0)) },
+	},
+	"TSF": {
+		Name: "TSF", Func: "TSF", Hint: "时间序列预测", Kind: KindOverlay,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"tsf"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return TSFLookback(p.int(0)) },
+	},
+	"LINEARREGRESSION": {
+		Name: "LinearRegression", Hint: "滚动线性回归及拟合优度", Kind: KindOverlay,
+		Inputs: closeInput,
+		Params: []ParamSpec{
+			{Name: "timePeriod", Type: ParamInteger, Default: 14, Min: 3, Max: 100000, Required: true},
+		},
+		Outputs:  []string{"value", "slope", "intercept", "angle", "forecast", "rSquared", "stdErr"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return LinearRegressionLookback(p.int(0)) },
+	},
+	"MOM": {
+		Name: "MOM", Func: "MOM", Hint: "动量", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 10, 1)}, Outputs: []string{"mom"},
+		Lookback: "timePeriod",
+		lookback: func(p lookbackParams) (int, error) { return MOMLookback(p.int(0)) },
+	},
+	"ROC": {
+		Name: "ROC", Func: "ROC", Hint: "变化率", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 10, 1)}, Outputs: []string{"roc"},
+		Lookback: "timePeriod",
+		lookback: func(p lookbackParams) (int, error) { return ROCLookback(p.int(0)) },
+	},
+	"ROCP": {
+		Name: "ROCP", Func: "ROCP", Hint: "变化百分比", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 10, 1)}, Outputs: []string{"rocp"},
+		Lookback: "timePeriod",
+		lookback: func(p lookbackParams) (int, error) { return ROCPLookback(p.int(0)) },
+	},
+	"ROCR": {
+		Name: "ROCR", Func: "ROCR", Hint: "变化比率", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 10, 1)}, Outputs: []string{"rocr"},
+		Lookback: "timePeriod",
+		lookback: func(p lookbackParams) (int, error) { return ROCRLookback(p.int(0)) },
+	},
+	"ROCR100": {
+		Name: "ROCR100", Func: "ROCR100", Hint: "变化比率（以100为基准）", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 10, 1)}, Outputs: []string{"rocr100"},
+		Lookback: "timePeriod",
+		lookback: func(p lookbackParams) (int, error) { return ROCR100Lookback(p.int(0)) },
+	},
+	"CMO": {
+		Name: "CMO", Func: "CMO", Hint: "钱德动量摆动指标", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"cmo"},
+		Lookback: "timePeriod+U(CMO)；MetaStock 兼容模式下减1",
+		lookback: func(p lookbackParams) (int, error) { return CMOLookback(p.int(0)) },
+	},
+	"TRIX": {
+		Name: "TRIX", Func: "TRIX", Hint: "三重指数平滑变化率", Kind: KindOscillator,
+		Inputs: closeInput, Params: []ParamSpec{periodSpec("timePeriod", 30, 1)}, Outputs: []string{"trix"},
+		Lookback: "3*(timePeriod-1+U(EMA))+1",
+		lookback: func(p lookbackParams) (int, error) { return TRIXLookback(p.int(0)) },
+	},
+	"CCI": {
+		Name: "CCI", Func: "CCI", Hint: "顺势指标", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"cci"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return CCILookback(p.int(0)) },
+	},
+	"WILLR": {
+		Name: "WILLR", Func: "WILLR", Hint: "威廉指标 %R", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"willr"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return WILLRLookback(p.int(0)) },
+	},
+	"MFI": {
+		Name: "MFI", Func: "MFI", Hint: "资金流量指标", Kind: KindOscillator,
+		Inputs: []string{"high", "low", "close", "volume"}, Params: []ParamSpec{periodSpec("timePeriod", 14, 2)}, Outputs: []string{"mfi"},
+		Lookback: "timePeriod+U(MFI)",
+		lookback: func(p lookbackParams) (int, error) { return MFILookback(p.int(0)) },
+	},
+	"ULTOSC": {
+		Name: "ULTOSC", Func: "ULTOSC", Hint: "终极振荡指标", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{periodSpec("timePeriod1", 7, 1), periodSpec("timePeriod2", 14, 1), periodSpec("timePeriod3", 28, 1)}, Outputs: []string{"ultosc"},
+		Lookback: "max(timePeriod1, timePeriod2, timePeriod3)",
+		lookback: func(p lookbackParams) (int, error) { return ULTOSCLookback(p.int(0), p.int(1), p.int(2)) },
+	},
+	"BOP": {
+		Name: "BOP", Func: "BOP", Hint: "均势指标", Kind: KindOscillator,
+		Inputs: []string{"open", "high", "low", "close"}, Params: []ParamSpec{}, Outputs: []string{"bop"},
+		Lookback: "0", lookback: noLookback,
+	},
+	"NATR": {
+		Name: "NATR", Func: "NATR", Hint: "归一化平均真实波幅", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{periodSpec("timePeriod", 14, 1)}, Outputs: []string{"natr"},
+		Lookback: "timePeriod+U(NATR)",
+		lookback: func(p lookbackParams) (int, error) { return NATRLookback(p.int(0)) },
+	},
+	"TRANGE": {
+		Name: "TRANGE", Func: "TRANGE", Hint: "真实波幅", Kind: KindOscillator,
+		Inputs: hlcInput, Params: []ParamSpec{}, Outputs: []string{"trange"},
+		Lookback: "1", lookback: func(lookbackParams) (int, error) { return TRANGELookback(), nil },
+	},
+	"KELTNER": {
+		Name: "Keltner", Hint: "肯特纳通道", Kind: KindOverlay,
+		Inputs: hlcInput,
+		Params: []ParamSpec{
+			{Name: "timePeriod", Type: ParamInteger, Default: 20, Min: 1, Max: 100000, Required: true},
+			{Name: "atrPeriod", Type: ParamInteger, Default: 10, Min: 1, Max: 100000, Required: true},
+			{Name: "multiplier", Type: ParamReal, Default: 2, Min: taRealMin, Max: taRealMax, Required: true},
+			{Name: "maType", Type: ParamMAType, Default: float64(MATypeEMA), Min: 0, Max: 8, Required: true, Options: maTypeNames},
+		},
+		Outputs:  []string{"upper", "middle", "lower"},
+		Lookback: "MA(timePeriod, maType) 与 ATR(atrPeriod) 回看期中较长的一个",
+		lookback: func(p lookbackParams) (int, error) { return KeltnerLookback(p.int(0), p.int(1), p.maType(3)) },
+	},
+	"DONCHIAN": {
+		Name: "Donchian", Hint: "唐奇安通道", Kind: KindOverlay,
+		Inputs: hlInput,
+		Params: []ParamSpec{
+			{Name: "timePeriod", Type: ParamInteger, Default: 20, Min: 1, Max: 100000, Required: true},
+		},
+		Outputs:  []string{"upper", "middle", "lower"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return DonchianLookback(p.int(0)) },
+	},
+	"CHANDELIEREXIT": {
+		Name: "ChandelierExit", Hint: "吊灯止损", Kind: KindOverlay,
+		Inputs: hlcInput,
+		Params: []ParamSpec{
+			{Name: "timePeriod", Type: ParamInteger, Default: 22, Min: 1, Max: 100000, Required: true},
+			{Name: "multiplier", Type: ParamReal, Default: 3, Min: taRealMin, Max: taRealMax, Required: true},
+		},
+		Outputs:  []string{"long", "short"},
+		Lookback: "timePeriod+U(ATR)",
+		lookback: func(p lookbackParams) (int, error) { return ChandelierExitLookback(p.int(0)) },
+	},
+	"SUPERTREND": {
+		Name: "SuperTrend", Hint: "超级趋势", Kind: KindOverlay,
+		Inputs: hlcInput,
+		Params: []ParamSpec{
+			{Name: "period", Type: ParamInteger, Default: 10, Min: 1, Max: 100000, Required: true},
+			{Name: "multiplier", Type: ParamReal, Default: 3, Min: taRealMin, Max: taRealMax, Required: true},
+		},
+		Outputs:  []string{"line", "direction", "lower", "upper"},
+		Lookback: "period+U(ATR)，默认填充为 NaN",
+		lookback: func(p lookbackParams) (int, error) { return SuperTrendLookback(p.int(0)) },
+	},
+	"VWAP": {
+		Name: "VWAP", Hint: "成交量加权平均价，按交易时段累计", Kind: KindOverlay,
+		Inputs: []string{"time", "high", "low", "close", "volume"}, Params: []ParamSpec{}, Outputs: []string{"vwap"},
+		Lookback: "0", lookback: noLookback,
+	},
+	"VWMA": {
+		Name: "VWMA", Hint: "成交量加权移动平均线", Kind: KindOverlay,
+		Inputs: []string{"close", "volume"},
+		Params: []ParamSpec{
+			{Name: "timePeriod", Type: ParamInteger, Default: 20, Min: 1, Max: 100000, Required: true},
+		},
+		Outputs:  []string{"vwma"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return VWMALookback(p.int(0)) },
+	},
+	"CMF": {
+		Name: "CMF", Hint: "佳庆资金流量", Kind: KindOscillator,
+		Inputs: hlcvInput,
+		Params: []ParamSpec{
+			{Name: "timePeriod", Type: ParamInteger, Default: 20, Min: 1, Max: 100000, Required: true},
+		},
+		Outputs:  []string{"cmf"},
+		Lookback: "timePeriod-1",
+		lookback: func(p lookbackParams) (int, error) { return CMFLookback(p.int(0)) },
+	},
+	"FORCEINDEX": {
+		Name: "ForceIndex", Hint: "埃尔德强力指数", Kind: KindOscillator,
+		Inputs: []string{"close", "volume"},
+		Params: []ParamSpec{
+			{Name: "timePeriod", Type: ParamInteger, Default: 13, Min: 1, Max: 100000, Required: true},
+		},
+		Outputs:  []string{"forceIndex"},
+		Lookback: "timePeriod+U(EMA)；timePeriod 为 1 时为 1",
+		lookback: func(p lookbackParams) (int, error) { return ForceIndexLookback(p.int(0)) },
+	},
+	"EMV": {
+		Name: "EMV", Hint: "简易波动指标", Kind: KindOscillator,
+		Inputs: []string{"high", "low", "volume"},
+		Params: []ParamSpec{
+			{Name: "timePeriod", Type: ParamInteger, Default: 14, Min: 1, Max: 100000, Required: true},
+			{Name: "divisor", Type: ParamReal, Default: 10000, Min: taRealMin, Max: taRealMax, Required: true},
+		},
+		Outputs:  []string{"emv"},
+		Lookback: "timePeriod",
+		lookback: func(p lookbackParams) (int, error) { return EMVLookback(p.int(0)) },
+	},
+}
@@ -0,0 +1,140 @@
+package go4ta
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestRegistryMatchesTALib 检查注册表中的默认值与取值范围与 TA-Lib 函数的参数说明一致。
+func TestRegistryMatchesTALib(t *testing.T) {
+	for _, ind := range Indicators() {
+		if ind.Func == "" {
+			continue
+		}
+		f, ok := nativeFuncs[ind.Func]
+		if !ok {
+			t.Errorf("%s: no function info for %s", ind.Name, ind.Func)
+			continue
+		}
+		for _, spec := range ind.Params {
+			if spec.Type == ParamMAType {
+				continue
+			}
+			i := slices.IndexFunc(f.info.Params, func(p FuncParam) bool { return paramKey(p.Name) == paramKey(spec.Name) })
+			if i < 0 {
+				t.Errorf("%s: parameter %s not found in %s", ind.Name, spec.Name, ind.Func)
+				continue
+			}
+			p := f.info.Params[i]
+			if p.Default != spec.Default || p.Min != spec.Min || p.Max != spec.Max || p.Integer != (spec.Type == ParamInteger) {
+				t.Errorf("%s.%s = %+v, TA-Lib %+v", ind.Name, spec.Name, spec, p)
+			}
+		}
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	list := Indicators()
+	if !slices.IsSortedFunc(list, func(a, b Indicator) int { return strings.Compare(a.Name, b.Name) }) {
+		t.Error("Indicators not sorted")
+	}
+	for _, ind := range list {
+		if len(ind.Inputs) == 0 || len(ind.Outputs) == 0 || ind.Lookback == "" {
+			t.Errorf("%s: incomplete entry %+v", ind.Name, ind)
+		}
+		// 没有必需参数的指标可以只用默认值查询回看期
+		if !slices.ContainsFunc(ind.Params, func(p ParamSpec) bool { return p.Required }) {
+			if _, err := Lookback(ind.Name); err != nil {
+				t.Errorf("Lookback(%s) with defaults: %v", ind.Name, err)
+			}
+		}
+	}
+
+	bb, err := GetIndicator("bbands")
+	if err != nil || bb.Name != "BBands" || bb.Kind != KindOverlay || !slices.Equal(bb.Outputs, []string{"upper", "middle", "lower"}) {
+		t.Errorf("GetIndicator(bbands) = %+v, %v", bb, err)
+	}
+	if _, err := GetIndicator("KDJ"); !errors.Is(err, RetFuncNotFound) {
+		t.Errorf("GetIndicator(KDJ): %v", err)
+	}
+}
+
+func TestIndicatorValidate(t *testing.T) {
+	stochRSI, _ := GetIndicator("STOCHRSI")
+	if err := stochRSI.Validate(14, 5, 3, 1); err != nil {
+		t.Error(err)
+	}
+	if err := stochRSI.Validate(taIntegerDefault); err != nil {
+		t.Errorf("TA_INTEGER_DEFAULT should select the default: %v", err)
+	}
+	var fe *FuncError
+	for _, params := range [][]float64{{1}, {14.5}, {14, 5, 3, 9}, {14, 5, 3, 0, 1}} {
+		if err := stochRSI.Validate(params...); !errors.Is(err, ErrBadParam) {
+			t.Errorf("Validate(%v) = %v, want ErrBadParam", params, err)
+		}
+	}
+	if err := stochRSI.Validate(14, 0); !errors.As(err, &fe) || fe.Func != "TA_STOCHRSI" || fe.Param != "fastKPeriod" {
+		t.Errorf("Validate(14, 0) = %v", err)
+	}
+
+	superTrend, _ := GetIndicator("SuperTrend")
+	if err := superTrend.Validate(10); !errors.As(err, &fe) || fe.Param != "multiplier" {
+		t.Errorf("SuperTrend without multiplier: %v", err)
+	}
+
+	// 参数之间的约束与计算时相同，省略的 maxPeriod 取默认值30
+	for _, name := range []string{"MAVP", "CycleMA"} {
+		ind, _ := GetIndicator(name)
+		if err := ind.Validate(17, 5); !errors.As(err, &fe) || fe.Func != "TA_MAVP" || fe.Param != "maxPeriod" {
+			t.Errorf("%s Validate(17, 5) = %v", name, err)
+		}
+		if err := ind.Validate(40); !errors.Is(err, ErrBadParam) {
+			t.Errorf("%s Validate(40) = %v, want ErrBadParam", name, err)
+		}
+		if err := ind.Validate(5, 17, 1); err != nil {
+			t.Errorf("%s Validate(5, 17, 1) = %v", name, err)
+		}
+		if err := ind.Validate(taIntegerDefault, 17); err != nil {
+			t.Errorf("%s Validate(default, 17) = %v", name, err)
+		}
+	}
+
+	// 导出函数在进入计算之前按注册表校验参数
+	b := testBars()
+	if _, err := RSI(b.Close, 1); !errors.As(err, &fe) || fe.Func != "TA_RSI" || fe.Param != "timePeriod" {
+		t.Errorf("RSI(close, 1) = %v", err)
+	}
+	if _, err := CalcSuperTrend(b.High, b.Low, b.Close, 0, 3); !errors.As(err, &fe) || fe.Func != "SuperTrend" || fe.Param != "period" {
+		t.Errorf("CalcSuperTrend(period 0) = %v", err)
+	}
+}
+
+func TestIndicatorsJSON(t *testing.T) {
+	data, err := IndicatorsJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != len(indicators) {
+		t.Fatalf("JSON has %d indicators, want %d", len(decoded), len(indicators))
+	}
+	var macd map[string]any
+	for _, ind := range decoded {
+		if ind["name"] == "MACD" {
+			macd = ind
+		}
+	}
+	params, _ := macd["params"].([]any)
+	if macd["kind"] != "oscillator" || len(params) != 3 || macd["lookback"] == "" {
+		t.Fatalf("MACD JSON = %v", macd)
+	}
+	if p := params[2].(map[string]any); p["name"] != "signalPeriod" || p["default"] != 9.0 || p["min"] != 1.0 {
+		t.Errorf("MACD signalPeriod JSON = %v", p)
+	}
+}
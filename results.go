@@ -0,0 +1,564 @@
+package go4ta
+
+// 多输出指标的结果结构体。各字段与输入等长，At(i) 取第 i 根价格柱上的全部输出，
+// Slice 截取一段（与原结果共享底层数组），Last 取最新一根，结果为空时返回零值。
+
+// MACDResult 是 CalcMACD 的结果。
+type MACDResult struct {
+	MACD   []float64 // 快慢均线之差
+	Signal []float64 // 信号线
+	Hist   []float64 // 柱状图，MACD - Signal
+}
+
+// MACDValue 是 MACDResult 在某一根价格柱上的值。
+type MACDValue struct {
+	MACD, Signal, Hist float64
+}
+
+// Len 返回结果序列的长度。
+func (r MACDResult) Len() int { return len(r.MACD) }
+
+// At 返回第 i 根价格柱上的值。
+func (r MACDResult) At(i int) MACDValue {
+	return MACDValue{r.MACD[i], r.Signal[i], r.Hist[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r MACDResult) Last() MACDValue {
+	if r.Len() == 0 {
+		return MACDValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r MACDResult) Slice(from, to int) MACDResult {
+	return MACDResult{r.MACD[from:to], r.Signal[from:to], r.Hist[from:to]}
+}
+
+// BBandsResult 是 CalcBBands 的结果。
+type BBandsResult struct {
+	Upper  []float64 // 上轨
+	Middle []float64 // 中轨（均线）
+	Lower  []float64 // 下轨
+}
+
+// BBandsValue 是 BBandsResult 在某一根价格柱上的值。
+type BBandsValue struct {
+	Upper, Middle, Lower float64
+}
+
+// Len 返回结果序列的长度。
+func (r BBandsResult) Len() int { return len(r.Middle) }
+
+// At 返回第 i 根价格柱上的值。
+func (r BBandsResult) At(i int) BBandsValue {
+	return BBandsValue{r.Upper[i], r.Middle[i], r.Lower[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r BBandsResult) Last() BBandsValue {
+	if r.Len() == 0 {
+		return BBandsValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r BBandsResult) Slice(from, to int) BBandsResult {
+	return BBandsResult{r.Upper[from:to], r.Middle[from:to], r.Lower[from:to]}
+}
+
+// PPOResult 是 CalcPPOWithSignal 的结果。Signal 与 Hist 的回看期比 PPO 长，见 PPOWithSignalLookback；
+// FillTrim 下三个序列都从 Signal 的第一个有效值开始。
+type PPOResult struct {
+	PPO    []float64
+	Signal []float64 // PPO 的 EMA
+	Hist   []float64 // PPO - Signal
+}
+
+// PPOValue 是 PPOResult 在某一根价格柱上的值。
+type PPOValue struct {
+	PPO, Signal, Hist float64
+}
+
+// Len 返回结果序列的长度。
+func (r PPOResult) Len() int { return len(r.PPO) }
+
+// At 返回第 i 根价格柱上的值。
+func (r PPOResult) At(i int) PPOValue {
+	return PPOValue{r.PPO[i], r.Signal[i], r.Hist[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r PPOResult) Last() PPOValue {
+	if r.Len() == 0 {
+		return PPOValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r PPOResult) Slice(from, to int) PPOResult {
+	return PPOResult{r.PPO[from:to], r.Signal[from:to], r.Hist[from:to]}
+}
+
+// STOCHResult 是 CalcSTOCH 的结果。
+type STOCHResult struct {
+	SlowK []float64
+	SlowD []float64
+}
+
+// STOCHValue 是 STOCHResult 在某一根价格柱上的值。
+type STOCHValue struct {
+	SlowK, SlowD float64
+}
+
+// Len 返回结果序列的长度。
+func (r STOCHResult) Len() int { return len(r.SlowK) }
+
+// At 返回第 i 根价格柱上的值。
+func (r STOCHResult) At(i int) STOCHValue {
+	return STOCHValue{r.SlowK[i], r.SlowD[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r STOCHResult) Last() STOCHValue {
+	if r.Len() == 0 {
+		return STOCHValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r STOCHResult) Slice(from, to int) STOCHResult {
+	return STOCHResult{r.SlowK[from:to], r.SlowD[from:to]}
+}
+
+// STOCHRSIResult 是 CalcSTOCHRSI 的结果。
+type STOCHRSIResult struct {
+	FastK []float64
+	FastD []float64
+}
+
+// STOCHRSIValue 是 STOCHRSIResult 在某一根价格柱上的值。
+type STOCHRSIValue struct {
+	FastK, FastD float64
+}
+
+// Len 返回结果序列的长度。
+func (r STOCHRSIResult) Len() int { return len(r.FastK) }
+
+// At 返回第 i 根价格柱上的值。
+func (r STOCHRSIResult) At(i int) STOCHRSIValue {
+	return STOCHRSIValue{r.FastK[i], r.FastD[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r STOCHRSIResult) Last() STOCHRSIValue {
+	if r.Len() == 0 {
+		return STOCHRSIValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r STOCHRSIResult) Slice(from, to int) STOCHRSIResult {
+	return STOCHRSIResult{r.FastK[from:to], r.FastD[from:to]}
+}
+
+// SuperTrendResult 是 CalcSuperTrend 的结果。
+type SuperTrendResult struct {
+	Line      []float64 // SuperTrend 主线，多头时等于下轨，空头时等于上轨
+	Direction []float64 // 1 为多头，-1 为空头，回看期内为 0
+	Lower     []float64 // 下轨，仅多头时有值，否则为 NaN
+	Upper     []float64 // 上轨，仅空头时有值，否则为 NaN
+}
+
+// SuperTrendValue 是 SuperTrendResult 在某一根价格柱上的值。
+type SuperTrendValue struct {
+	Line, Direction, Lower, Upper float64
+}
+
+// Len 返回结果序列的长度。
+func (r SuperTrendResult) Len() int { return len(r.Line) }
+
+// At 返回第 i 根价格柱上的值。
+func (r SuperTrendResult) At(i int) SuperTrendValue {
+	return SuperTrendValue{r.Line[i], r.Direction[i], r.Lower[i], r.Upper[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r SuperTrendResult) Last() SuperTrendValue {
+	if r.Len() == 0 {
+		return SuperTrendValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r SuperTrendResult) Slice(from, to int) SuperTrendResult {
+	return SuperTrendResult{r.Line[from:to], r.Direction[from:to], r.Lower[from:to], r.Upper[from:to]}
+}
+
+// MAMAResult 是 CalcMAMA 的结果。
+type MAMAResult struct {
+	MAMA []float64
+	FAMA []float64 // 跟随自适应移动平均，以 MAMA 一半的平滑系数平滑 MAMA
+}
+
+// MAMAValue 是 MAMAResult 在某一根价格柱上的值。
+type MAMAValue struct {
+	MAMA, FAMA float64
+}
+
+// Len 返回结果序列的长度。
+func (r MAMAResult) Len() int { return len(r.MAMA) }
+
+// At 返回第 i 根价格柱上的值。
+func (r MAMAResult) At(i int) MAMAValue {
+	return MAMAValue{r.MAMA[i], r.FAMA[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r MAMAResult) Last() MAMAValue {
+	if r.Len() == 0 {
+		return MAMAValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r MAMAResult) Slice(from, to int) MAMAResult {
+	return MAMAResult{r.MAMA[from:to], r.FAMA[from:to]}
+}
+
+// DMIResult 是 CalcDMI 的结果。各序列的回看期不同，FillTrim 下都从 ADXR 的第一个有效值开始。
+type DMIResult struct {
+	ADX     []float64
+	ADXR    []float64
+	PlusDI  []float64
+	MinusDI []float64
+}
+
+// DMIValue 是 DMIResult 在某一根价格柱上的值。
+type DMIValue struct {
+	ADX, ADXR, PlusDI, MinusDI float64
+}
+
+// Len 返回结果序列的长度。
+func (r DMIResult) Len() int { return len(r.ADX) }
+
+// At 返回第 i 根价格柱上的值。
+func (r DMIResult) At(i int) DMIValue {
+	return DMIValue{r.ADX[i], r.ADXR[i], r.PlusDI[i], r.MinusDI[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r DMIResult) Last() DMIValue {
+	if r.Len() == 0 {
+		return DMIValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r DMIResult) Slice(from, to int) DMIResult {
+	return DMIResult{r.ADX[from:to], r.ADXR[from:to], r.PlusDI[from:to], r.MinusDI[from:to]}
+}
+
+// LinearRegressionResult 是 CalcLinearRegression 的结果。
+type LinearRegressionResult struct {
+	Value     []float64 // 回归直线在当根的取值，同 LinearReg
+	Slope     []float64 // 斜率，同 LinearRegSlope
+	Intercept []float64 // 截距，同 LinearRegIntercept
+	Angle     []float64 // 角度，同 LinearRegAngle
+	Forecast  []float64 // 下一根的预测值，同 TSF
+	RSquared  []float64 // 决定系数 R²
+	StdErr    []float64 // 估计标准误差
+}
+
+// LinearRegressionValue 是 LinearRegressionResult 在某一根价格柱上的值。
+type LinearRegressionValue struct {
+	Value, Slope, Intercept, Angle, Forecast, RSquared, StdErr float64
+}
+
+// Len 返回结果序列的长度。
+func (r LinearRegressionResult) Len() int { return len(r.Value) }
+
+// At 返回第 i 根价格柱上的值。
+func (r LinearRegressionResult) At(i int) LinearRegressionValue {
+	return LinearRegressionValue{r.Value[i], r.Slope[i], r.Intercept[i], r.Angle[i], r.Forecast[i], r.RSquared[i], r.StdErr[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r LinearRegressionResult) Last() LinearRegressionValue {
+	if r.Len() == 0 {
+		return LinearRegressionValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r LinearRegressionResult) Slice(from, to int) LinearRegressionResult {
+	return LinearRegressionResult{r.Value[from:to], r.Slope[from:to], r.Intercept[from:to], r.Angle[from:to],
+		r.Forecast[from:to], r.RSquared[from:to], r.StdErr[from:to]}
+}
+
+// KeltnerResult 是 CalcKeltner 的结果。
+type KeltnerResult struct {
+	Upper  []float64 // 上轨，中轨 + multiplier*ATR
+	Middle []float64 // 中轨（均线）
+	Lower  []float64 // 下轨，中轨 - multiplier*ATR
+}
+
+// KeltnerValue 是 KeltnerResult 在某一根价格柱上的值。
+type KeltnerValue struct {
+	Upper, Middle, Lower float64
+}
+
+// Len 返回结果序列的长度。
+func (r KeltnerResult) Len() int { return len(r.Middle) }
+
+// At 返回第 i 根价格柱上的值。
+func (r KeltnerResult) At(i int) KeltnerValue {
+	return KeltnerValue{r.Upper[i], r.Middle[i], r.Lower[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r KeltnerResult) Last() KeltnerValue {
+	if r.Len() == 0 {
+		return KeltnerValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r KeltnerResult) Slice(from, to int) KeltnerResult {
+	return KeltnerResult{r.Upper[from:to], r.Middle[from:to], r.Lower[from:to]}
+}
+
+// DonchianResult 是 CalcDonchian 的结果。
+type DonchianResult struct {
+	Upper  []float64 // 区间最高价
+	Middle []float64 // 上下轨的平均
+	Lower  []float64 // 区间最低价
+}
+
+// DonchianValue 是 DonchianResult 在某一根价格柱上的值。
+type DonchianValue struct {
+	Upper, Middle, Lower float64
+}
+
+// Len 返回结果序列的长度。
+func (r DonchianResult) Len() int { return len(r.Middle) }
+
+// At 返回第 i 根价格柱上的值。
+func (r DonchianResult) At(i int) DonchianValue {
+	return DonchianValue{r.Upper[i], r.Middle[i], r.Lower[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r DonchianResult) Last() DonchianValue {
+	if r.Len() == 0 {
+		return DonchianValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r DonchianResult) Slice(from, to int) DonchianResult {
+	return DonchianResult{r.Upper[from:to], r.Middle[from:to], r.Lower[from:to]}
+}
+
+// ChandelierResult 是 CalcChandelierExit 的结果。
+type ChandelierResult struct {
+	Long  []float64 // 多头止损，区间最高价 - multiplier*ATR
+	Short []float64 // 空头止损，区间最低价 + multiplier*ATR
+}
+
+// ChandelierValue 是 ChandelierResult 在某一根价格柱上的值。
+type ChandelierValue struct {
+	Long, Short float64
+}
+
+// Len 返回结果序列的长度。
+func (r ChandelierResult) Len() int { return len(r.Long) }
+
+// At 返回第 i 根价格柱上的值。
+func (r ChandelierResult) At(i int) ChandelierValue {
+	return ChandelierValue{r.Long[i], r.Short[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r ChandelierResult) Last() ChandelierValue {
+	if r.Len() == 0 {
+		return ChandelierValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r ChandelierResult) Slice(from, to int) ChandelierResult {
+	return ChandelierResult{r.Long[from:to], r.Short[from:to]}
+}
+
+// AroonResult 是 CalcAroon 的结果。
+type AroonResult struct {
+	Down []float64 // Aroon Down，最低价出现得越近越接近100
+	Up   []float64 // Aroon Up，最高价出现得越近越接近100
+}
+
+// AroonValue 是 AroonResult 在某一根价格柱上的值。
+type AroonValue struct {
+	Down, Up float64
+}
+
+// Len 返回结果序列的长度。
+func (r AroonResult) Len() int { return len(r.Down) }
+
+// At 返回第 i 根价格柱上的值。
+func (r AroonResult) At(i int) AroonValue {
+	return AroonValue{r.Down[i], r.Up[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r AroonResult) Last() AroonValue {
+	if r.Len() == 0 {
+		return AroonValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r AroonResult) Slice(from, to int) AroonResult {
+	return AroonResult{r.Down[from:to], r.Up[from:to]}
+}
+
+// VortexResult 是 CalcVortex 的结果。
+type VortexResult struct {
+	Plus  []float64 // VI+
+	Minus []float64 // VI-
+}
+
+// VortexValue 是 VortexResult 在某一根价格柱上的值。
+type VortexValue struct {
+	Plus, Minus float64
+}
+
+// Len 返回结果序列的长度。
+func (r VortexResult) Len() int { return len(r.Plus) }
+
+// At 返回第 i 根价格柱上的值。
+func (r VortexResult) At(i int) VortexValue {
+	return VortexValue{r.Plus[i], r.Minus[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r VortexResult) Last() VortexValue {
+	if r.Len() == 0 {
+		return VortexValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r VortexResult) Slice(from, to int) VortexResult {
+	return VortexResult{r.Plus[from:to], r.Minus[from:to]}
+}
+
+// HTPhasorResult 是 CalcHTPhasor 的结果。
+type HTPhasorResult struct {
+	InPhase    []float64 // 同相分量
+	Quadrature []float64 // 正交分量
+}
+
+// HTPhasorValue 是 HTPhasorResult 在某一根价格柱上的值。
+type HTPhasorValue struct {
+	InPhase, Quadrature float64
+}
+
+// Len 返回结果序列的长度。
+func (r HTPhasorResult) Len() int { return len(r.InPhase) }
+
+// At 返回第 i 根价格柱上的值。
+func (r HTPhasorResult) At(i int) HTPhasorValue {
+	return HTPhasorValue{r.InPhase[i], r.Quadrature[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r HTPhasorResult) Last() HTPhasorValue {
+	if r.Len() == 0 {
+		return HTPhasorValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r HTPhasorResult) Slice(from, to int) HTPhasorResult {
+	return HTPhasorResult{r.InPhase[from:to], r.Quadrature[from:to]}
+}
+
+// HTSineResult 是 CalcHTSine 的结果。
+type HTSineResult struct {
+	Sine     []float64 // 主导周期相位的正弦
+	LeadSine []float64 // 超前45度的正弦
+}
+
+// HTSineValue 是 HTSineResult 在某一根价格柱上的值。
+type HTSineValue struct {
+	Sine, LeadSine float64
+}
+
+// Len 返回结果序列的长度。
+func (r HTSineResult) Len() int { return len(r.Sine) }
+
+// At 返回第 i 根价格柱上的值。
+func (r HTSineResult) At(i int) HTSineValue {
+	return HTSineValue{r.Sine[i], r.LeadSine[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r HTSineResult) Last() HTSineValue {
+	if r.Len() == 0 {
+		return HTSineValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r HTSineResult) Slice(from, to int) HTSineResult {
+	return HTSineResult{r.Sine[from:to], r.LeadSine[from:to]}
+}
+
+// MINMAXResult 是 CalcMINMAX 的结果。
+type MINMAXResult struct {
+	Min []float64 // 窗口内的最低值
+	Max []float64 // 窗口内的最高值
+}
+
+// MINMAXValue 是 MINMAXResult 在某一根价格柱上的值。
+type MINMAXValue struct {
+	Min, Max float64
+}
+
+// Len 返回结果序列的长度。
+func (r MINMAXResult) Len() int { return len(r.Min) }
+
+// At 返回第 i 根价格柱上的值。
+func (r MINMAXResult) At(i int) MINMAXValue {
+	return MINMAXValue{r.Min[i], r.Max[i]}
+}
+
+// Last 返回最后一根价格柱上的值。
+func (r MINMAXResult) Last() MINMAXValue {
+	if r.Len() == 0 {
+		return MINMAXValue{}
+	}
+	return r.At(r.Len() - 1)
+}
+
+// Slice 返回 [from, to) 区间的结果。
+func (r MINMAXResult) Slice(from, to int) MINMAXResult {
+	return MINMAXResult{r.Min[from:to], r.Max[from:to]}
+}
@@ -0,0 +1,90 @@
+package go4ta
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestResultStructs(t *testing.T) {
+	b := testBars()
+	n := b.Len()
+
+	macd, err := CalcMACD(b.Close, 12, 26, 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, s, h, _ := MACD(b.Close, 12, 26, 9)
+	if !equalFloats(macd.MACD, m) || !equalFloats(macd.Signal, s) || !equalFloats(macd.Hist, h) {
+		t.Fatal("MACD shim differs from CalcMACD")
+	}
+	if last := macd.Last(); last != (MACDValue{m[n-1], s[n-1], h[n-1]}) {
+		t.Errorf("MACD Last = %+v", last)
+	}
+	tail := macd.Slice(n-10, n)
+	if tail.Len() != 10 || tail.At(9) != macd.Last() || tail.At(0) != macd.At(n-10) {
+		t.Errorf("MACD Slice = %+v", tail.At(0))
+	}
+
+	// 命名字段避免了按位置取值时把上下轨弄反
+	bb, err := CalcBBands(b.Close, 20, 2, 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 19; i < n; i++ {
+		if v := bb.At(i); !(v.Upper >= v.Middle && v.Middle >= v.Lower) {
+			t.Fatalf("BBands[%d] = %+v", i, v)
+		}
+	}
+	upper, middle, lower, _ := BBands(b.Close, 20, 2, 2, 0)
+	if !equalFloats(bb.Upper, upper) || !equalFloats(bb.Middle, middle) || !equalFloats(bb.Lower, lower) {
+		t.Error("BBands shim differs from CalcBBands")
+	}
+
+	st, err := CalcSuperTrend(b.High, b.Low, b.Close, 7, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	switch v := st.Last(); v.Direction {
+	case 1:
+		if v.Line != v.Lower || !math.IsNaN(v.Upper) {
+			t.Errorf("SuperTrend uptrend Last = %+v", v)
+		}
+	case -1:
+		if v.Line != v.Upper || !math.IsNaN(v.Lower) {
+			t.Errorf("SuperTrend downtrend Last = %+v", v)
+		}
+	default:
+		t.Errorf("SuperTrend Last = %+v", v)
+	}
+	if v := st.At(0); v.Direction != 0 || !math.IsNaN(v.Line) {
+		t.Errorf("SuperTrend At(0) = %+v", v)
+	}
+
+	ppo, err := CalcPPOWithSignal(b.Close, 12, 26, 9, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := ppo.Last(); v.Hist != v.PPO-v.Signal {
+		t.Errorf("PPO Last = %+v", v)
+	}
+	stoch, _ := CalcSTOCH(b.High, b.Low, b.Close, 5, 3, 3, 0, 0)
+	stochRSI, _ := CalcSTOCHRSI(b.Close, 14, 5, 3, 0)
+	if stoch.Len() != n || stochRSI.Slice(0, 5).Len() != 5 {
+		t.Errorf("STOCH len %d, STOCHRSI slice len %d", stoch.Len(), stochRSI.Slice(0, 5).Len())
+	}
+}
+
+func TestResultStructsEmpty(t *testing.T) {
+	macd, err := CalcMACD(nil, 12, 26, 9)
+	if err != nil || macd.Len() != 0 || macd.Last() != (MACDValue{}) {
+		t.Errorf("empty CalcMACD = %+v, %v", macd, err)
+	}
+	st, err := CalcSuperTrend(nil, nil, nil, 7, 3)
+	if err != nil || st.Last() != (SuperTrendValue{}) {
+		t.Errorf("empty CalcSuperTrend = %+v, %v", st, err)
+	}
+	if _, err := CalcBBands([]float64{1, 2}, 20, 2, 2, 0); !errors.Is(err, ErrInputTooShort) {
+		t.Errorf("short CalcBBands: %v", err)
+	}
+}
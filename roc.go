@@ -0,0 +1,37 @@
+package go4ta
+
+// ROC 计算变化率（Rate of Change），((price/prevPrice)-1)*100，prevPrice 为 timePeriod 根之前的价格。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如10）
+// @return []float64 - 变化率序列，前值为0时结果为0，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func ROC(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("ROC", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("ROC", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taROC(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// ROCLookback 返回 ROC 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func ROCLookback(timePeriod int) (int, error) {
+	_, err := rocParams(timePeriod, "TA_ROC")
+	return lookbackResult("TA_ROC", taROCLookback(timePeriod), err)
+}
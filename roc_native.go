@@ -0,0 +1,50 @@
+package go4ta
+
+// nativeROC 是 TA_ROC 的原生实现。
+func nativeROC(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := rocParams(timePeriod, "TA_ROC")
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intROC(close, timePeriod, rocValue)
+	return outBegIdx, output, nil
+}
+
+// rocValue 是 ROC 的百分比变化率，前值为0时输出0。TRIX 也以此计算三重 EMA 的变化率。
+func rocValue(price, prevPrice float64) float64 {
+	if prevPrice != 0.0 {
+		return ((price / prevPrice) - 1.0) * 100.0
+	}
+	return 0.0
+}
+
+// intROC 以当前值与 timePeriod 根之前的值计算 value，MOM 与 ROC 一族只在 value 上不同。
+func intROC(in []float64, timePeriod int, value func(price, prevPrice float64) float64) (int, []float64) {
+	startIdx := timePeriod
+	if startIdx > len(in)-1 {
+		return 0, nil
+	}
+
+	output := make([]float64, 0, len(in)-startIdx)
+	for inIdx := startIdx; inIdx < len(in); inIdx++ {
+		output = append(output, value(in[inIdx], in[inIdx-timePeriod]))
+	}
+	return startIdx, output
+}
+
+// nativeROCLookback 对应 TA_ROC_Lookback，参数无效时返回 -1。
+// ROCP、ROCR、ROCR100 的回看期与参数规则相同。
+func nativeROCLookback(timePeriod int) int {
+	timePeriod, err := rocParams(timePeriod, "TA_ROC")
+	if err != nil {
+		return -1
+	}
+	return timePeriod
+}
+
+// rocParams 按 TA_ROC 一族的规则处理参数，fn 为出错时报告的函数名。
+func rocParams(timePeriod int, fn string) (int, error) {
+	c := paramCheck{fn: fn}
+	timePeriod = c.integer("timePeriod", timePeriod, 10, 1, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,37 @@
+package go4ta
+
+// ROCP 计算变化百分比（Rate of Change Percentage），(price-prevPrice)/prevPrice，以小数表示。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如10）
+// @return []float64 - 变化百分比序列，前值为0时结果为0，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func ROCP(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("ROCP", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("ROCP", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taROCP(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// ROCPLookback 返回 ROCP 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func ROCPLookback(timePeriod int) (int, error) {
+	_, err := rocParams(timePeriod, "TA_ROCP")
+	return lookbackResult("TA_ROCP", taROCPLookback(timePeriod), err)
+}
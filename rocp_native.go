@@ -0,0 +1,25 @@
+package go4ta
+
+// nativeROCP 是 TA_ROCP 的原生实现，前值为0时输出0。
+func nativeROCP(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := rocParams(timePeriod, "TA_ROCP")
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intROC(close, timePeriod, func(price, prevPrice float64) float64 {
+		if prevPrice != 0.0 {
+			return (price - prevPrice) / prevPrice
+		}
+		return 0.0
+	})
+	return outBegIdx, output, nil
+}
+
+// nativeROCPLookback 对应 TA_ROCP_Lookback，参数无效时返回 -1。
+func nativeROCPLookback(timePeriod int) int {
+	timePeriod, err := rocParams(timePeriod, "TA_ROCP")
+	if err != nil {
+		return -1
+	}
+	return timePeriod
+}
@@ -0,0 +1,25 @@
+package go4ta
+
+// nativeROCR100 是 TA_ROCR100 的原生实现，前值为0时输出0。
+func nativeROCR100(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := rocParams(timePeriod, "TA_ROCR100")
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intROC(close, timePeriod, func(price, prevPrice float64) float64 {
+		if prevPrice != 0.0 {
+			return (price / prevPrice) * 100.0
+		}
+		return 0.0
+	})
+	return outBegIdx, output, nil
+}
+
+// nativeROCR100Lookback 对应 TA_ROCR100_Lookback，参数无效时返回 -1。
+func nativeROCR100Lookback(timePeriod int) int {
+	timePeriod, err := rocParams(timePeriod, "TA_ROCR100")
+	if err != nil {
+		return -1
+	}
+	return timePeriod
+}
@@ -0,0 +1,25 @@
+package go4ta
+
+// nativeROCR 是 TA_ROCR 的原生实现，前值为0时输出0。
+func nativeROCR(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := rocParams(timePeriod, "TA_ROCR")
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intROC(close, timePeriod, func(price, prevPrice float64) float64 {
+		if prevPrice != 0.0 {
+			return price / prevPrice
+		}
+		return 0.0
+	})
+	return outBegIdx, output, nil
+}
+
+// nativeROCRLookback 对应 TA_ROCR_Lookback，参数无效时返回 -1。
+func nativeROCRLookback(timePeriod int) int {
+	timePeriod, err := rocParams(timePeriod, "TA_ROCR")
+	if err != nil {
+		return -1
+	}
+	return timePeriod
+}
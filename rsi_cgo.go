@@ -0,0 +1,53 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+TA_RetCode TA_RSI(int startIdx, int endIdx, const double inReal[], int optInTimePeriod, int *outBegIdx, int *outNBElement, double outReal[]);
+*/
+import "C"
+import "unsafe"
+
+// taRSI 调用 TA_RSI。
+func taRSI(close []float64, timePeriod int) (int, []float64, error) {
+	defer readSettings()()
+	// --- 准备 C 语言格式的输入数据 ---
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+
+	// --- 准备 C 语言格式的输出缓冲区 ---
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	// --- 准备用于接收 TA-Lib 输出元数据的变量 ---
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	// --- 调用 C 函数 ---
+	retCode := C.TA_RSI(
+		0,                   // startIdx: 从输入数据的第一个元素开始
+		C.int(len(close)-1), // endIdx: 到输入数据的最后一个元素结束
+		cClose,              // inReal
+		C.int(timePeriod),   // optInTimePeriod
+		&outBegIdx,          // outBegIdx (输出参数)
+		&outNBElement,       // outNBElement (输出参数)
+		cOutput,             // outReal (输出缓冲区)
+	)
+
+	// --- 检查 C 函数调用结果 ---
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := rsiParams(timePeriod)
+		return 0, nil, taErr("TA_RSI", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taRSILookback 调用 TA_RSI_Lookback，参数无效时返回 -1。
+func taRSILookback(timePeriod int) int {
+	defer readSettings()()
+	return int(C.TA_RSI_Lookback(C.int(timePeriod)))
+}
@@ -0,0 +1,139 @@
+package go4ta
+
+// nativeRSI 是 TA_RSI 的原生实现。
+func nativeRSI(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := rsiParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intRSI(close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+func rsiLookback(timePeriod int) int {
+	return gainLossLookback(timePeriod, FuncUnstRSI)
+}
+
+func intRSI(in []float64, timePeriod int) (int, []float64) {
+	return intGainLoss(in, timePeriod, FuncUnstRSI, func(gain, loss float64) float64 {
+		return 100 * (gain / (gain + loss))
+	})
+}
+
+// gainLossLookback 是 RSI 与 CMO 共同的回看期，MetaStock 兼容模式下少一根。
+func gainLossLookback(timePeriod int, id FuncUnstID) int {
+	lookback := timePeriod + unstablePeriod(id)
+	if metastock() {
+		lookback--
+	}
+	return lookback
+}
+
+// intGainLoss 按 Wilder 方式平滑每根价格柱的涨幅与跌幅，RSI 与 CMO 只在由平均涨跌幅得出输出的
+// value 上不同；平均涨跌幅之和为0时输出0。
+func intGainLoss(in []float64, timePeriod int, id FuncUnstID, value func(gain, loss float64) float64) (int, []float64) {
+	lookbackTotal := gainLossLookback(timePeriod, id)
+	startIdx := lookbackTotal
+	if startIdx > len(in)-1 {
+		return 0, nil
+	}
+
+	outValue := func(gain, loss float64) float64 {
+		if isZero(gain + loss) {
+			return 0
+		}
+		return value(gain, loss)
+	}
+
+	period := float64(timePeriod)
+	output := make([]float64, 0, len(in)-startIdx)
+	today := startIdx - lookbackTotal
+	prevValue := in[today]
+
+	// MetaStock 在没有不稳定期时复用第一根价格柱，多输出一个值
+	if unstablePeriod(id) == 0 && metastock() {
+		savePrevValue := prevValue
+		prevGain := 0.0
+		prevLoss := 0.0
+		for i := timePeriod; i > 0; i-- {
+			tempValue1 := in[today]
+			today++
+			tempValue2 := tempValue1 - prevValue
+			prevValue = tempValue1
+			if tempValue2 < 0 {
+				prevLoss -= tempValue2
+			} else {
+				prevGain += tempValue2
+			}
+		}
+		output = append(output, outValue(prevGain/period, prevLoss/period))
+		if today > len(in)-1 {
+			return startIdx, output
+		}
+		today -= timePeriod
+		prevValue = savePrevValue
+	}
+
+	prevGain := 0.0
+	prevLoss := 0.0
+	today++
+	for i := timePeriod; i > 0; i-- {
+		tempValue1 := in[today]
+		today++
+		tempValue2 := tempValue1 - prevValue
+		prevValue = tempValue1
+		if tempValue2 < 0 {
+			prevLoss -= tempValue2
+		} else {
+			prevGain += tempValue2
+		}
+	}
+	prevLoss /= period
+	prevGain /= period
+
+	// 平滑一根价格柱的涨跌幅
+	smooth := func() {
+		tempValue1 := in[today]
+		today++
+		tempValue2 := tempValue1 - prevValue
+		prevValue = tempValue1
+		prevLoss *= period - 1
+		prevGain *= period - 1
+		if tempValue2 < 0 {
+			prevLoss -= tempValue2
+		} else {
+			prevGain += tempValue2
+		}
+		prevLoss /= period
+		prevGain /= period
+	}
+	if today > startIdx {
+		output = append(output, outValue(prevGain, prevLoss))
+	} else {
+		for today < startIdx {
+			smooth()
+		}
+	}
+
+	for today < len(in) {
+		smooth()
+		output = append(output, outValue(prevGain, prevLoss))
+	}
+	return startIdx, output
+}
+
+// nativeRSILookback 对应 TA_RSI_Lookback，参数无效时返回 -1。
+func nativeRSILookback(timePeriod int) int {
+	timePeriod, err := rsiParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return rsiLookback(timePeriod)
+}
+
+// rsiParams 按 TA_RSI 的规则处理参数。
+func rsiParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_RSI"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,102 @@
+package go4ta
+
+// RSIStream 是 RSI 的流式版本。
+type RSIStream struct {
+	state rsiState
+	value float64
+	ready bool
+}
+
+// NewRSIStream 创建相对强弱指数流式指标。
+//
+// @param timePeriod  - 计算周期 (例如 14)
+// @return *RSIStream - 流式指标
+// @return error      - 参数无效时返回错误
+func NewRSIStream(timePeriod int) (*RSIStream, error) {
+	p, ok := optInteger(timePeriod, 14, 2, 100000)
+	if !ok {
+		return nil, badParam("NewRSIStream", "timePeriod", timePeriod)
+	}
+	return &RSIStream{state: rsiState{Period: p}}, nil
+}
+
+// Update 输入一个新的收盘价，返回最新的 RSI，未就绪时返回 0。
+func (s *RSIStream) Update(close float64) float64 {
+	s.value, s.ready = s.state.update(close)
+	return s.value
+}
+
+// Value 返回最近一次 Update 的结果。
+func (s *RSIStream) Value() float64 {
+	return s.value
+}
+
+// Ready 表示当前值是否已越过回看期。
+func (s *RSIStream) Ready() bool {
+	return s.ready
+}
+
+func (s *RSIStream) snapshot() *streamSnapshot {
+	return &streamSnapshot{kind: "RSI", values: []*float64{&s.value}, ready: &s.ready, state: &s.state}
+}
+
+// MarshalBinary 将完整的计算状态编码为二进制快照。
+func (s *RSIStream) MarshalBinary() ([]byte, error) {
+	return s.snapshot().marshalBinary()
+}
+
+// UnmarshalBinary 从 MarshalBinary 生成的快照恢复状态。
+func (s *RSIStream) UnmarshalBinary(data []byte) error {
+	return s.snapshot().unmarshalBinary(data)
+}
+
+// MarshalJSON 将完整的计算状态编码为 JSON 快照。
+func (s *RSIStream) MarshalJSON() ([]byte, error) {
+	return s.snapshot().marshalJSON()
+}
+
+// UnmarshalJSON 从 MarshalJSON 生成的快照恢复状态。
+func (s *RSIStream) UnmarshalJSON(data []byte) error {
+	return s.snapshot().unmarshalJSON(data)
+}
+
+// rsiState 对应 intRSI：先累加 timePeriod 个涨跌幅取平均，之后按 Wilder 方式平滑。
+type rsiState struct {
+	Period    int
+	Count     int
+	PrevValue float64
+	PrevGain  float64
+	PrevLoss  float64
+}
+
+func (s *rsiState) update(close float64) (float64, bool) {
+	s.Count++
+	if s.Count == 1 {
+		s.PrevValue = close
+		return 0, false
+	}
+
+	period := float64(s.Period)
+	tempValue2 := close - s.PrevValue
+	s.PrevValue = close
+	if s.Count > s.Period+1 {
+		s.PrevLoss *= period - 1
+		s.PrevGain *= period - 1
+	}
+	if tempValue2 < 0 {
+		s.PrevLoss -= tempValue2
+	} else {
+		s.PrevGain += tempValue2
+	}
+	if s.Count <= s.Period {
+		return 0, false
+	}
+	s.PrevLoss /= period
+	s.PrevGain /= period
+
+	tempValue1 := s.PrevGain + s.PrevLoss
+	if !isZero(tempValue1) {
+		return 100.0 * (s.PrevGain / tempValue1), true
+	}
+	return 0.0, true
+}
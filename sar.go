@@ -0,0 +1,79 @@
+package go4ta
+
+// SAR 计算抛物线转向指标（Parabolic SAR）。
+//
+// @param high         - 最高价序列
+// @param low          - 最低价序列
+// @param acceleration - 加速因子的初始值与每次的增量（如0.02）
+// @param maximum      - 加速因子的上限（如0.2）
+// @return []float64   - SAR 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error       - 如果输入数据无效或 C 库调用失败，则返回错误。
+func SAR(high, low []float64, acceleration, maximum float64) ([]float64, error) {
+	n, err := checkInputs("SAR", "high, low", high, low)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	if err := checkParams("SAR", acceleration, maximum); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taSAR(high, low, acceleration, maximum)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// SARLookback 返回 SAR 的回看期。SAR 从第二根价格柱起有输出，参数有效时恒为 1。
+//
+// @param acceleration - 加速因子
+// @param maximum      - 加速因子的上限
+// @return int         - 回看期
+// @return error       - 参数无效时返回错误
+func SARLookback(acceleration, maximum float64) (int, error) {
+	_, _, err := sarParams(acceleration, maximum)
+	return lookbackResult("TA_SAR", taSARLookback(acceleration, maximum), err)
+}
+
+// SARDirection 返回 SAR 所指示的方向，编码与 SuperTrend 的 direction 相同：
+// SAR 位于价格下方（多头）时为1，位于上方（空头）时为-1。方向由 SAREXT 结果的符号得出，
+// 因此与按相同参数计算的 SAR 逐根对应。
+//
+// @param high         - 最高价序列
+// @param low          - 最低价序列
+// @param acceleration - 加速因子的初始值与每次的增量（如0.02）
+// @param maximum      - 加速因子的上限（如0.2）
+// @return []float64   - 方向序列，与输入等长，回看期内按 SetFillPolicy 的设置填充，默认为0。
+// @return error       - 如果输入数据无效或计算失败，则返回错误。
+func SARDirection(high, low []float64, acceleration, maximum float64) ([]float64, error) {
+	n, err := checkInputs("SARDirection", "high, low", high, low)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	if err := checkParams("SAR", acceleration, maximum); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taSAREXT(high, low, 0, 0, acceleration, acceleration, maximum, acceleration, acceleration, maximum)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range output {
+		if v < 0 {
+			output[i] = -1
+		} else {
+			output[i] = 1
+		}
+	}
+
+	return newWarmup(0).spread(n, outBegIdx, output), nil
+}
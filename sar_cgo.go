@@ -0,0 +1,49 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taSAR 调用 TA_SAR。
+func taSAR(high, low []float64, acceleration, maximum float64) (int, []float64, error) {
+	defer readSettings()()
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	output := make([]C.double, len(high))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_SAR(
+		0,
+		C.int(len(high)-1),
+		cHigh,
+		cLow,
+		C.double(acceleration),
+		C.double(maximum),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, paramErr := sarParams(acceleration, maximum)
+		return 0, nil, taErr("TA_SAR", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taSARLookback 调用 TA_SAR_Lookback，参数无效时返回 -1。
+func taSARLookback(acceleration, maximum float64) int {
+	defer readSettings()()
+	return int(C.TA_SAR_Lookback(C.double(acceleration), C.double(maximum)))
+}
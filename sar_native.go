@@ -0,0 +1,137 @@
+package go4ta
+
+// nativeSAR 是 TA_SAR 的原生实现，计算见 intSAR。
+func nativeSAR(high, low []float64, acceleration, maximum float64) (int, []float64, error) {
+	acceleration, maximum, err := sarParams(acceleration, maximum)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intSAR(high, low, sarConfig{
+		initLong: acceleration, accelLong: acceleration, maxLong: maximum,
+		initShort: acceleration, accelShort: acceleration, maxShort: maximum,
+	}, 1)
+	return outBegIdx, output, nil
+}
+
+// nativeSARLookback 对应 TA_SAR_Lookback，参数无效时返回 -1。
+func nativeSARLookback(acceleration, maximum float64) int {
+	if _, _, err := sarParams(acceleration, maximum); err != nil {
+		return -1
+	}
+	return 1
+}
+
+// sarParams 按 TA_SAR 的规则处理参数。
+func sarParams(acceleration, maximum float64) (float64, float64, error) {
+	c := paramCheck{fn: "TA_SAR"}
+	acceleration = c.real("acceleration", acceleration, 0.02, 0, taRealMax)
+	maximum = c.real("maximum", maximum, 0.2, 0, taRealMax)
+	return acceleration, maximum, c.err
+}
+
+// sarConfig 是 intSAR 的参数，含义与 TA_SAREXT 的同名参数相同。
+// SAR 相当于多空加速因子相同、不指定初始值也不偏移的 SAREXT。
+type sarConfig struct {
+	startValue, offsetOnReverse     float64
+	initLong, accelLong, maxLong    float64
+	initShort, accelShort, maxShort float64
+}
+
+// intSAR 对应 TA_SAR 与 TA_SAREXT 共同的计算，空头时输出 shortSign*SAR（SAR 为1，SAREXT 为-1）。
+// 每根先判断价格是否穿越 SAR，穿越时反转并以前一段的极值点作为新的 SAR，
+// 否则输出当前 SAR，再按加速因子向极值点推进，且不越过最近两根的价格。
+func intSAR(high, low []float64, cfg sarConfig, shortSign float64) (int, []float64) {
+	startIdx := 1
+	if startIdx > len(high)-1 {
+		return 0, nil
+	}
+
+	if cfg.initLong > cfg.maxLong {
+		cfg.initLong = cfg.maxLong
+	}
+	if cfg.accelLong > cfg.maxLong {
+		cfg.accelLong = cfg.maxLong
+	}
+	if cfg.initShort > cfg.maxShort {
+		cfg.initShort = cfg.maxShort
+	}
+	if cfg.accelShort > cfg.maxShort {
+		cfg.accelShort = cfg.maxShort
+	}
+	afLong, afShort := cfg.initLong, cfg.initShort
+
+	// 未指定初始值时按第二根的 -DM 判断方向：向下的变动占优则以空头开始
+	var isLong bool
+	switch {
+	case cfg.startValue == 0:
+		diffP := high[startIdx] - high[startIdx-1]
+		diffM := low[startIdx-1] - low[startIdx]
+		isLong = !(diffM > 0 && diffP < diffM)
+	default:
+		isLong = cfg.startValue > 0
+	}
+
+	today := startIdx
+	var ep, sar float64
+	switch {
+	case cfg.startValue == 0 && isLong:
+		ep, sar = high[today], low[today-1]
+	case cfg.startValue == 0:
+		ep, sar = low[today], high[today-1]
+	case isLong:
+		ep, sar = high[today], cfg.startValue
+	default:
+		ep, sar = low[today], -cfg.startValue
+	}
+
+	output := make([]float64, len(high)-startIdx)
+	newLow, newHigh := low[today], high[today]
+	for outIdx := range output {
+		prevLow, prevHigh := newLow, newHigh
+		newLow, newHigh = low[today], high[today]
+		today++
+
+		if isLong {
+			if newLow <= sar {
+				// 反转为空头
+				isLong = false
+				sar = max(ep, prevHigh, newHigh)
+				if cfg.offsetOnReverse != 0.0 {
+					sar += sar * cfg.offsetOnReverse
+				}
+				output[outIdx] = shortSign * sar
+				afShort = cfg.initShort
+				ep = newLow
+				sar = max(sar+afShort*(ep-sar), prevHigh, newHigh)
+			} else {
+				output[outIdx] = sar
+				if newHigh > ep {
+					ep = newHigh
+					afLong = min(afLong+cfg.accelLong, cfg.maxLong)
+				}
+				sar = min(sar+afLong*(ep-sar), prevLow, newLow)
+			}
+		} else {
+			if newHigh >= sar {
+				// 反转为多头
+				isLong = true
+				sar = min(ep, prevLow, newLow)
+				if cfg.offsetOnReverse != 0.0 {
+					sar -= sar * cfg.offsetOnReverse
+				}
+				output[outIdx] = sar
+				afLong = cfg.initLong
+				ep = newHigh
+				sar = min(sar+afLong*(ep-sar), prevLow, newLow)
+			} else {
+				output[outIdx] = shortSign * sar
+				if newLow < ep {
+					ep = newLow
+					afShort = min(afShort+cfg.accelShort, cfg.maxShort)
+				}
+				sar = max(sar+afShort*(ep-sar), prevHigh, newHigh)
+			}
+		}
+	}
+	return startIdx, output
+}
@@ -0,0 +1,104 @@
+package go4ta
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// 参考数据按 Wilder 的定义逐根计算：SAR 不越过前一根与当前根的极值，价格触及 SAR 时反转，
+// 反转后的 SAR 取前一段的极值点；初始方向由第二根的 -DM 判断。
+
+func TestSAR(t *testing.T) {
+	g := readGolden(t, "sar.csv")
+	sar, err := SAR(g["High"], g["Low"], 0.02, 0.2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "SAR", sar, g["SAR"])
+	fast, err := SAR(g["High"], g["Low"], 0.05, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "SAR_005_01", fast, g["SAR_005_01"])
+
+	// 加速因子大于上限时按上限计算
+	capped, err := SAR(g["High"], g["Low"], 0.3, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	same, err := SAR(g["High"], g["Low"], 0.1, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalFloats(capped, same) {
+		t.Error("SAR(0.3, 0.1) differs from SAR(0.1, 0.1)")
+	}
+
+	if lookback, err := SARLookback(0.02, 0.2); err != nil || lookback != 1 {
+		t.Errorf("SARLookback = %d, %v", lookback, err)
+	}
+	var fe *FuncError
+	if _, err := SAR(g["High"], g["Low"], -0.02, 0.2); !errors.As(err, &fe) || fe.Func != "TA_SAR" || fe.Param != "acceleration" {
+		t.Errorf("SAR(-0.02) = %v", err)
+	}
+	if got, err := SAR(g["High"][:1], g["Low"][:1], 0.02, 0.2); err != nil || len(got) != 1 || got[0] != 0 {
+		t.Errorf("SAR(single bar) = %v, %v", got, err)
+	}
+}
+
+func TestSAREXT(t *testing.T) {
+	g := readGolden(t, "sar.csv")
+	ext, err := SAREXT(g["High"], g["Low"], 0, 0, 0.02, 0.02, 0.2, 0.02, 0.02, 0.2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "SAREXT", ext, g["SAREXT"])
+	custom, err := SAREXT(g["High"], g["Low"], -105, 0.01, 0.01, 0.03, 0.25, 0.04, 0.02, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "SAREXT_CUSTOM", custom, g["SAREXT_CUSTOM"])
+
+	// 默认参数下绝对值与 SAR 相同
+	sar, err := SAR(g["High"], g["Low"], 0.02, 0.2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range sar {
+		if math.Abs(math.Abs(ext[i])-sar[i]) > 1e-9 {
+			t.Errorf("|SAREXT[%d]| = %f, SAR %f", i, math.Abs(ext[i]), sar[i])
+		}
+	}
+
+	var fe *FuncError
+	if _, err := SAREXTLookback(0, -0.01, 0.02, 0.02, 0.2, 0.02, 0.02, 0.2); !errors.As(err, &fe) || fe.Param != "offsetOnReverse" {
+		t.Errorf("SAREXTLookback(offset -0.01) = %v", err)
+	}
+}
+
+func TestSARDirection(t *testing.T) {
+	g := readGolden(t, "sar.csv")
+	dir, err := SARDirection(g["High"], g["Low"], 0.02, 0.2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "DIRECTION", dir, g["DIRECTION"])
+
+	// 多头时 SAR 在最低价下方，空头时在最高价上方
+	sar, err := SAR(g["High"], g["Low"], 0.02, 0.2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < len(dir); i++ {
+		if (dir[i] == 1 && sar[i] > g["Low"][i]) || (dir[i] == -1 && sar[i] < g["High"][i]) {
+			t.Errorf("[%d] direction %v with SAR %f, high %f, low %f", i, dir[i], sar[i], g["High"][i], g["Low"][i])
+		}
+	}
+
+	b := testBars()
+	r, err := b.SARDirection(0.02, 0.2)
+	if err != nil || len(r) != b.Len() {
+		t.Errorf("Bars.SARDirection = %d values, %v", len(r), err)
+	}
+}
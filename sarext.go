@@ -0,0 +1,58 @@
+package go4ta
+
+// SAREXT 计算扩展的抛物线转向指标（Parabolic SAR - Extended），多头与空头可分别设置加速因子。
+// 与 TA-Lib 相同，空头时的结果取负值，其绝对值才是 SAR 所在的价格。
+//
+// @param high                  - 最高价序列
+// @param low                   - 最低价序列
+// @param startValue            - 初始 SAR，正值表示以多头开始、负值表示以空头开始（取其绝对值），0 表示按前两根价格柱自动判断
+// @param offsetOnReverse       - 反转时 SAR 向外偏移的比例（如0.01表示1%），0 表示不偏移
+// @param accelerationInitLong  - 多头加速因子的初始值（如0.02）
+// @param accelerationLong      - 多头加速因子每次的增量（如0.02）
+// @param accelerationMaxLong   - 多头加速因子的上限（如0.2）
+// @param accelerationInitShort - 空头加速因子的初始值（如0.02）
+// @param accelerationShort     - 空头加速因子每次的增量（如0.02）
+// @param accelerationMaxShort  - 空头加速因子的上限（如0.2）
+// @return []float64            - SAREXT 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error                - 如果输入数据无效或 C 库调用失败，则返回错误。
+func SAREXT(high, low []float64, startValue, offsetOnReverse,
+	accelerationInitLong, accelerationLong, accelerationMaxLong,
+	accelerationInitShort, accelerationShort, accelerationMaxShort float64) ([]float64, error) {
+	n, err := checkInputs("SAREXT", "high, low", high, low)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	if err := checkParams("SAREXT", startValue, offsetOnReverse,
+		accelerationInitLong, accelerationLong, accelerationMaxLong,
+		accelerationInitShort, accelerationShort, accelerationMaxShort); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taSAREXT(high, low, startValue, offsetOnReverse,
+		accelerationInitLong, accelerationLong, accelerationMaxLong,
+		accelerationInitShort, accelerationShort, accelerationMaxShort)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// SAREXTLookback 返回 SAREXT 的回看期，参数有效时恒为 1，参数含义见 SAREXT。
+//
+// @return int   - 回看期
+// @return error - 参数无效时返回错误
+func SAREXTLookback(startValue, offsetOnReverse,
+	accelerationInitLong, accelerationLong, accelerationMaxLong,
+	accelerationInitShort, accelerationShort, accelerationMaxShort float64) (int, error) {
+	_, err := sarExtParams(startValue, offsetOnReverse,
+		accelerationInitLong, accelerationLong, accelerationMaxLong,
+		accelerationInitShort, accelerationShort, accelerationMaxShort)
+	return lookbackResult("TA_SAREXT", taSAREXTLookback(startValue, offsetOnReverse,
+		accelerationInitLong, accelerationLong, accelerationMaxLong,
+		accelerationInitShort, accelerationShort, accelerationMaxShort), err)
+}
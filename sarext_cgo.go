@@ -0,0 +1,63 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taSAREXT 调用 TA_SAREXT。
+func taSAREXT(high, low []float64, startValue, offsetOnReverse,
+	accelerationInitLong, accelerationLong, accelerationMaxLong,
+	accelerationInitShort, accelerationShort, accelerationMaxShort float64) (int, []float64, error) {
+	defer readSettings()()
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	output := make([]C.double, len(high))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_SAREXT(
+		0,
+		C.int(len(high)-1),
+		cHigh,
+		cLow,
+		C.double(startValue),
+		C.double(offsetOnReverse),
+		C.double(accelerationInitLong),
+		C.double(accelerationLong),
+		C.double(accelerationMaxLong),
+		C.double(accelerationInitShort),
+		C.double(accelerationShort),
+		C.double(accelerationMaxShort),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, paramErr := sarExtParams(startValue, offsetOnReverse,
+			accelerationInitLong, accelerationLong, accelerationMaxLong,
+			accelerationInitShort, accelerationShort, accelerationMaxShort)
+		return 0, nil, taErr("TA_SAREXT", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taSAREXTLookback 调用 TA_SAREXT_Lookback，参数无效时返回 -1。
+func taSAREXTLookback(startValue, offsetOnReverse,
+	accelerationInitLong, accelerationLong, accelerationMaxLong,
+	accelerationInitShort, accelerationShort, accelerationMaxShort float64) int {
+	defer readSettings()()
+	return int(C.TA_SAREXT_Lookback(C.double(startValue), C.double(offsetOnReverse),
+		C.double(accelerationInitLong), C.double(accelerationLong), C.double(accelerationMaxLong),
+		C.double(accelerationInitShort), C.double(accelerationShort), C.double(accelerationMaxShort)))
+}
@@ -0,0 +1,45 @@
+package go4ta
+
+// nativeSAREXT 是 TA_SAREXT 的原生实现，计算见 intSAR。
+func nativeSAREXT(high, low []float64, startValue, offsetOnReverse,
+	accelerationInitLong, accelerationLong, accelerationMaxLong,
+	accelerationInitShort, accelerationShort, accelerationMaxShort float64) (int, []float64, error) {
+	cfg, err := sarExtParams(startValue, offsetOnReverse,
+		accelerationInitLong, accelerationLong, accelerationMaxLong,
+		accelerationInitShort, accelerationShort, accelerationMaxShort)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intSAR(high, low, cfg, -1)
+	return outBegIdx, output, nil
+}
+
+// nativeSAREXTLookback 对应 TA_SAREXT_Lookback，参数无效时返回 -1。
+func nativeSAREXTLookback(startValue, offsetOnReverse,
+	accelerationInitLong, accelerationLong, accelerationMaxLong,
+	accelerationInitShort, accelerationShort, accelerationMaxShort float64) int {
+	if _, err := sarExtParams(startValue, offsetOnReverse,
+		accelerationInitLong, accelerationLong, accelerationMaxLong,
+		accelerationInitShort, accelerationShort, accelerationMaxShort); err != nil {
+		return -1
+	}
+	return 1
+}
+
+// sarExtParams 按 TA_SAREXT 的规则处理参数。
+func sarExtParams(startValue, offsetOnReverse,
+	accelerationInitLong, accelerationLong, accelerationMaxLong,
+	accelerationInitShort, accelerationShort, accelerationMaxShort float64) (sarConfig, error) {
+	c := paramCheck{fn: "TA_SAREXT"}
+	cfg := sarConfig{
+		startValue:      c.real("startValue", startValue, 0, taRealMin, taRealMax),
+		offsetOnReverse: c.real("offsetOnReverse", offsetOnReverse, 0, 0, taRealMax),
+		initLong:        c.real("accelerationInitLong", accelerationInitLong, 0.02, 0, taRealMax),
+		accelLong:       c.real("accelerationLong", accelerationLong, 0.02, 0, taRealMax),
+		maxLong:         c.real("accelerationMaxLong", accelerationMaxLong, 0.2, 0, taRealMax),
+		initShort:       c.real("accelerationInitShort", accelerationInitShort, 0.02, 0, taRealMax),
+		accelShort:      c.real("accelerationShort", accelerationShort, 0.02, 0, taRealMax),
+		maxShort:        c.real("accelerationMaxShort", accelerationMaxShort, 0.2, 0, taRealMax),
+	}
+	return cfg, c.err
+}
@@ -0,0 +1,172 @@
+package go4ta
+
+import "sync"
+
+// FuncUnstID 对应 TA-Lib 的 TA_FuncUnstId，标识带有不稳定期的函数。
+// 这些函数的输出依赖全部历史（如 EMA、Wilder 平滑），设置不稳定期后，
+// 结果开头会多丢弃相应数量的值，使剩余的值更接近用更长历史计算出的结果。
+type FuncUnstID int
+
+const (
+	FuncUnstADX FuncUnstID = iota
+	FuncUnstADXR
+	FuncUnstATR
+	FuncUnstCMO
+	FuncUnstDX
+	FuncUnstEMA
+	FuncUnstHTDCPeriod
+	FuncUnstHTDCPhase
+	FuncUnstHTPhasor
+	FuncUnstHTSine
+	FuncUnstHTTrendline
+	FuncUnstHTTrendMode
+	FuncUnstKAMA
+	FuncUnstMAMA
+	FuncUnstMFI
+	FuncUnstMinusDI
+	FuncUnstMinusDM
+	FuncUnstNATR
+	FuncUnstPlusDI
+	FuncUnstPlusDM
+	FuncUnstRSI
+	FuncUnstSTOCHRSI
+	FuncUnstT3
+	// FuncUnstAll 用于 SetUnstablePeriod，一次设置所有函数。
+	FuncUnstAll
+)
+
+// Compatibility 对应 TA-Lib 的 TA_Compatibility。
+type Compatibility int
+
+const (
+	// CompatibilityDefault 是 TA-Lib 的默认算法：EMA 以前 timePeriod 个值的 SMA 作为种子。
+	CompatibilityDefault Compatibility = iota
+	// CompatibilityMetastock 与 MetaStock 一致：EMA 以第一个价格作为种子，
+	// RSI 在没有不稳定期时提前一根价格柱输出。
+	CompatibilityMetastock
+)
+
+// maxUnstablePeriod 限制不稳定期的上限，避免回看期溢出。
+const maxUnstablePeriod = 100000
+
+// settings 是 TA-Lib 全局设置（TA_Globals）在 Go 侧的副本，原生实现直接读取。
+// 指标计算期间持有读锁，修改设置时持有写锁，保证一次计算中看到的设置不变。
+var settings = struct {
+	mu       sync.RWMutex
+	unstable [FuncUnstAll]int
+	compat   Compatibility
+	candles  CandleSettings
+}{candles: defaultCandleSettings}
+
+// readSettings 获取全局设置的读锁，返回对应的解锁函数，用法为 defer readSettings()()。
+func readSettings() func() {
+	settings.mu.RLock()
+	return settings.mu.RUnlock
+}
+
+// unstablePeriod 返回函数 id 的不稳定期，调用方须持有读锁。
+func unstablePeriod(id FuncUnstID) int {
+	return settings.unstable[id]
+}
+
+// candleSettings 返回当前的K线形态设置，调用方须持有读锁。
+func candleSettings() CandleSettings {
+	return settings.candles
+}
+
+// metastock 表示当前是否为 MetaStock 兼容模式，调用方须持有读锁。
+func metastock() bool {
+	return settings.compat == CompatibilityMetastock
+}
+
+func init() {
+	taInitialize()
+}
+
+// Initialize 调用 TA_Initialize，并把不稳定期、兼容模式和K线形态设置恢复为默认值。
+// 包在加载时已经初始化过一次，只有在 Shutdown 之后或需要重置全局设置时才需要调用。
+//
+// @return error - TA-Lib 初始化失败时返回错误
+func Initialize() error {
+	settings.mu.Lock()
+	defer settings.mu.Unlock()
+	if code := taInitialize(); code != RetSuccess {
+		return &FuncError{Func: "TA_Initialize", Code: code}
+	}
+	settings.unstable = [FuncUnstAll]int{}
+	settings.compat = CompatibilityDefault
+	settings.candles = defaultCandleSettings
+	return nil
+}
+
+// Shutdown 调用 TA_Shutdown 释放 TA-Lib 的资源，之后须先调用 Initialize 再继续计算。
+//
+// @return error - TA-Lib 未初始化时返回错误
+func Shutdown() error {
+	settings.mu.Lock()
+	defer settings.mu.Unlock()
+	if code := taShutdown(); code != RetSuccess {
+		return &FuncError{Func: "TA_Shutdown", Code: code}
+	}
+	return nil
+}
+
+// SetUnstablePeriod 设置函数 id 的不稳定期，id 为 FuncUnstAll 时设置全部函数。
+// 设置对之后开始的所有计算生效，可与计算并发调用。流式指标不受影响，始终按默认设置计算。
+//
+// @param id     - 函数标识
+// @param period - 不稳定期，即结果开头额外丢弃的价格柱数
+// @return error - 参数无效时返回错误
+func SetUnstablePeriod(id FuncUnstID, period int) error {
+	if id < 0 || id > FuncUnstAll {
+		return badParam("TA_SetUnstablePeriod", "id", id)
+	}
+	if period < 0 || period > maxUnstablePeriod {
+		return badParam("TA_SetUnstablePeriod", "period", period)
+	}
+	settings.mu.Lock()
+	defer settings.mu.Unlock()
+	if code := taSetUnstablePeriod(id, period); code != RetSuccess {
+		return &FuncError{Func: "TA_SetUnstablePeriod", Code: code}
+	}
+	if id == FuncUnstAll {
+		for i := range settings.unstable {
+			settings.unstable[i] = period
+		}
+	} else {
+		settings.unstable[id] = period
+	}
+	return nil
+}
+
+// GetUnstablePeriod 返回函数 id 当前的不稳定期，id 无效或为 FuncUnstAll 时返回 0。
+func GetUnstablePeriod(id FuncUnstID) int {
+	if id < 0 || id >= FuncUnstAll {
+		return 0
+	}
+	defer readSettings()()
+	return settings.unstable[id]
+}
+
+// SetCompatibility 设置兼容模式，对之后开始的所有计算生效，可与计算并发调用。
+//
+// @param compat - 兼容模式
+// @return error - 参数无效时返回错误
+func SetCompatibility(compat Compatibility) error {
+	if compat != CompatibilityDefault && compat != CompatibilityMetastock {
+		return badParam("TA_SetCompatibility", "compat", compat)
+	}
+	settings.mu.Lock()
+	defer settings.mu.Unlock()
+	if code := taSetCompatibility(compat); code != RetSuccess {
+		return &FuncError{Func: "TA_SetCompatibility", Code: code}
+	}
+	settings.compat = compat
+	return nil
+}
+
+// GetCompatibility 返回当前的兼容模式。
+func GetCompatibility() Compatibility {
+	defer readSettings()()
+	return settings.compat
+}
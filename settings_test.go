@@ -0,0 +1,231 @@
+package go4ta
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// resetSettings 在测试结束时恢复默认的全局设置。
+func resetSettings(t *testing.T) {
+	t.Cleanup(func() {
+		if err := Initialize(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+// checkShifted 检查 got 在前 lookback 个位置为 0，之后与 want 完全相同。
+func checkShifted(t *testing.T, name string, got, want []float64, lookback int) {
+	t.Helper()
+	for i := range got {
+		if i < lookback {
+			if got[i] != 0 {
+				t.Fatalf("%s[%d] = %v, want 0 within lookback %d", name, i, got[i], lookback)
+			}
+		} else if got[i] != want[i] {
+			t.Fatalf("%s[%d] = %v, want %v", name, i, got[i], want[i])
+		}
+	}
+}
+
+func TestUnstablePeriod(t *testing.T) {
+	resetSettings(t)
+	s := randomWalk(rand.New(rand.NewSource(1)), "trending", 200, 100, 0.002, 0.01, 0)
+
+	rsi, _ := RSI(s.close, 14)
+	ema, _ := EMA(s.close, 10)
+	atr, _ := ATR(s.high, s.low, s.close, 14)
+	adx, _ := ADX(s.high, s.low, s.close, 14)
+	kama, _ := MA(s.close, 10, 6)
+	mama, _ := MA(s.close, 10, 7)
+	t3, _ := MA(s.close, 5, 8)
+
+	for _, id := range []FuncUnstID{FuncUnstRSI, FuncUnstEMA, FuncUnstATR, FuncUnstADX, FuncUnstKAMA, FuncUnstMAMA, FuncUnstT3} {
+		if err := SetUnstablePeriod(id, 10); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := GetUnstablePeriod(FuncUnstRSI); got != 10 {
+		t.Errorf("GetUnstablePeriod(RSI) = %d", got)
+	}
+
+	// 不稳定期只是多丢弃开头的值，之后的值不变
+	rsiU, _ := RSI(s.close, 14)
+	checkShifted(t, "RSI", rsiU, rsi, 24)
+	emaU, _ := EMA(s.close, 10)
+	checkShifted(t, "EMA", emaU, ema, 19)
+	atrU, _ := ATR(s.high, s.low, s.close, 14)
+	checkShifted(t, "ATR", atrU, atr, 24)
+	adxU, _ := ADX(s.high, s.low, s.close, 14)
+	checkShifted(t, "ADX", adxU, adx, 37)
+	kamaU, _ := MA(s.close, 10, 6)
+	checkShifted(t, "KAMA", kamaU, kama, 20)
+	mamaU, _ := MA(s.close, 10, 7)
+	checkShifted(t, "MAMA", mamaU, mama, 42)
+	t3U, _ := MA(s.close, 5, 8)
+	checkShifted(t, "T3", t3U, t3, 34)
+
+	for name, lookback := range map[string]func() (int, error){
+		"RSI": func() (int, error) { return RSILookback(14) },
+		"EMA": func() (int, error) { return EMALookback(10) },
+		"ATR": func() (int, error) { return ATRLookback(14) },
+		"ADX": func() (int, error) { return ADXLookback(14) },
+	} {
+		want := map[string]int{"RSI": 24, "EMA": 19, "ATR": 24, "ADX": 37}[name]
+		if got, err := lookback(); err != nil || got != want {
+			t.Errorf("%sLookback = %d, %v, want %d", name, got, err, want)
+		}
+	}
+
+	// DEMA 的第二条 EMA 以第一条 EMA 的输出为种子，不稳定期会改变数值
+	dema, _ := MA(s.close, 10, 3)
+	if lookback, _ := MALookback(10, 3); lookback != 38 || dema[37] != 0 || dema[38] == 0 {
+		t.Errorf("DEMA lookback %d, first values %v", lookback, dema[37:39])
+	}
+
+	// 周期为1的 ATR 即真实波幅，同样从不稳定期之后开始输出
+	tr, _ := ATR(s.high, s.low, s.close, 1)
+	if tr[10] != 0 || tr[11] != trueRange(s.high[11], s.low[11], s.close[10]) {
+		t.Errorf("ATR(1) with unstable period: %v", tr[10:12])
+	}
+
+	if err := SetUnstablePeriod(FuncUnstAll, 3); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetUnstablePeriod(FuncUnstT3); got != 3 {
+		t.Errorf("FuncUnstAll did not apply, T3 = %d", got)
+	}
+	if err := Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetUnstablePeriod(FuncUnstEMA); got != 0 {
+		t.Errorf("Initialize did not reset unstable period, EMA = %d", got)
+	}
+}
+
+func TestCompatibilityMetastock(t *testing.T) {
+	resetSettings(t)
+	s := randomWalk(rand.New(rand.NewSource(2)), "trending", 100, 100, 0.002, 0.01, 0)
+	const p = 14
+
+	rsi, _ := RSI(s.close, p)
+	if err := SetCompatibility(CompatibilityMetastock); err != nil {
+		t.Fatal(err)
+	}
+	if GetCompatibility() != CompatibilityMetastock {
+		t.Fatal("compatibility not set")
+	}
+
+	// RSI 复用第一根价格柱，提前一根输出，之后与 Wilder 的算法相同
+	rsiM, _ := RSI(s.close, p)
+	if lookback, _ := RSILookback(p); lookback != p-1 {
+		t.Errorf("RSILookback = %d, want %d", lookback, p-1)
+	}
+	gain, loss := 0.0, 0.0
+	for i := 1; i < p; i++ {
+		if d := s.close[i] - s.close[i-1]; d < 0 {
+			loss -= d
+		} else {
+			gain += d
+		}
+	}
+	gain /= p
+	loss /= p
+	if want := 100 * (gain / (gain + loss)); rsiM[p-1] != want {
+		t.Errorf("RSI[%d] = %v, want %v", p-1, rsiM[p-1], want)
+	}
+	checkShifted(t, "RSI", rsiM[p:], rsi[p:], 0)
+	if rsiM[p-2] != 0 {
+		t.Errorf("RSI[%d] = %v, want 0", p-2, rsiM[p-2])
+	}
+
+	// EMA 以第一个价格为种子，而不是前 timePeriod 个价格的 SMA
+	emaM, _ := EMA(s.close, p)
+	k := 2.0 / (p + 1)
+	prev := s.close[0]
+	for i := 1; i < len(s.close); i++ {
+		prev = ((s.close[i] - prev) * k) + prev
+		if i >= p-1 && emaM[i] != prev {
+			t.Fatalf("EMA[%d] = %v, want %v", i, emaM[i], prev)
+		}
+	}
+
+	// 有不稳定期时 RSI 不再多输出一个值，回看期比默认模式少 1
+	if err := SetUnstablePeriod(FuncUnstRSI, 5); err != nil {
+		t.Fatal(err)
+	}
+	rsiMU, _ := RSI(s.close, p)
+	checkShifted(t, "RSI", rsiMU, rsi, p+4)
+}
+
+func TestSettingsInvalid(t *testing.T) {
+	resetSettings(t)
+	for _, err := range []error{
+		SetUnstablePeriod(-1, 1),
+		SetUnstablePeriod(FuncUnstAll+1, 1),
+		SetUnstablePeriod(FuncUnstEMA, -1),
+		SetCompatibility(2),
+	} {
+		if !errors.Is(err, ErrBadParam) {
+			t.Errorf("got %v, want ErrBadParam", err)
+		}
+	}
+	if got := GetUnstablePeriod(FuncUnstAll); got != 0 {
+		t.Errorf("GetUnstablePeriod(FuncUnstAll) = %d", got)
+	}
+}
+
+// TestSettingsConcurrent 在计算的同时反复修改设置，每次计算的结果须完整对应其中一种设置。
+func TestSettingsConcurrent(t *testing.T) {
+	resetSettings(t)
+	s := randomWalk(rand.New(rand.NewSource(3)), "trending", 300, 100, 0.002, 0.01, 0)
+	stable, _ := RSI(s.close, 14)
+	SetUnstablePeriod(FuncUnstRSI, 20)
+	unstable, _ := RSI(s.close, 14)
+	SetUnstablePeriod(FuncUnstRSI, 0)
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				got, err := RSI(s.close, 14)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if !equalFloats(got, stable) && !equalFloats(got, unstable) {
+					t.Error("RSI computed with mixed settings")
+					return
+				}
+			}
+		}()
+	}
+	for i := range 200 {
+		SetUnstablePeriod(FuncUnstRSI, 20*(i%2))
+		SetCompatibility(CompatibilityDefault)
+	}
+	close(done)
+	wg.Wait()
+}
+
+func equalFloats(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
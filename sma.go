@@ -1,5 +1,10 @@
 package go4ta
 
 func SMA(close []float64, timePeriod int) ([]float64, error) {
-	return MA(close, timePeriod, 0)
+	return MA(close, timePeriod, MATypeSMA)
+}
+
+// SMALookback 返回 SMA 的回看期，等同于 MALookback(timePeriod, MATypeSMA)。
+func SMALookback(timePeriod int) (int, error) {
+	return MALookback(timePeriod, MATypeSMA)
 }
@@ -0,0 +1,225 @@
+package go4ta
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// windowStats 返回窗口 in[i-n+1:i+1] 的总体方差与 x、y 的相关系数及 y 对 x 的回归斜率。
+func windowStats(x, y []float64) (variance, correl, slope float64) {
+	n := float64(len(x))
+	var sx, sy, sxx, syy, sxy float64
+	for i := range x {
+		sx += x[i]
+		sy += y[i]
+		sxx += x[i] * x[i]
+		syy += y[i] * y[i]
+		sxy += x[i] * y[i]
+	}
+	covXY := sxy/n - sx/n*sy/n
+	varX := sxx/n - sx/n*sx/n
+	varY := syy/n - sy/n*sy/n
+	return varX, covXY / math.Sqrt(varX*varY), covXY / varX
+}
+
+func TestVAR(t *testing.T) {
+	close := testBars().Close
+
+	got, err := VAR(close, 20, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stddev, _ := STDDEV(close, 20, 1)
+	for i := 19; i < len(close); i++ {
+		want, _, _ := windowStats(close[i-19:i+1], close[i-19:i+1])
+		if math.Abs(got[i]-want) > 1e-6 || math.Abs(math.Sqrt(got[i])-stddev[i]) > 1e-9 {
+			t.Fatalf("VAR[%d] = %v, want %v (STDDEV %v)", i, got[i], want, stddev[i])
+		}
+	}
+	// nbDev 不影响结果
+	if scaled, err := VAR(close, 20, 2); err != nil || !equalFloats(scaled, got) {
+		t.Errorf("VAR(nbDev 2) differs from VAR(nbDev 1): %v", err)
+	}
+	if one, err := VAR(close, 1, 1); err != nil || one[0] != 0 || one[len(one)-1] != 0 {
+		t.Errorf("VAR(1) = %v, %v", one, err)
+	}
+	if l, err := VARLookback(20, 1); err != nil || l != 19 {
+		t.Errorf("VARLookback(20) = %d, %v", l, err)
+	}
+
+	var fe *FuncError
+	if _, err := VAR(close, 0, 1); !errors.As(err, &fe) || fe.Func != "TA_VAR" || fe.Param != "timePeriod" {
+		t.Errorf("VAR(0) = %v", err)
+	}
+	if _, err := VAR(close[:5], 6, 1); !errors.Is(err, ErrInputTooShort) {
+		t.Errorf("VAR(5 bars, 6) = %v", err)
+	}
+	if out, err := VAR(nil, 5, 1); err != nil || len(out) != 0 {
+		t.Errorf("VAR(empty) = %v, %v", out, err)
+	}
+}
+
+func TestCORRELAndBETA(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	market := randomWalk(rng, "market", 200, 100, 0.001, 0.01, 0).close
+	noise := randomWalk(rng, "noise", 200, 50, 0, 0.01, 0).close
+	// stock 的涨跌幅约为 market 的2倍，再叠加独立的波动
+	stock := make([]float64, len(market))
+	stock[0] = 50
+	for i := 1; i < len(stock); i++ {
+		r := 2*(market[i]/market[i-1]-1) + 0.2*(noise[i]/noise[i-1]-1)
+		stock[i] = stock[i-1] * (1 + r)
+	}
+
+	correl, err := CORREL(market, stock, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beta, err := BETA(market, stock, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l, err := CORRELLookback(30); err != nil || l != 29 {
+		t.Errorf("CORRELLookback(30) = %d, %v", l, err)
+	}
+	if l, err := BETALookback(30); err != nil || l != 30 {
+		t.Errorf("BETALookback(30) = %d, %v", l, err)
+	}
+
+	rx := make([]float64, len(market))
+	ry := make([]float64, len(stock))
+	for i := 1; i < len(market); i++ {
+		rx[i] = market[i]/market[i-1] - 1
+		ry[i] = stock[i]/stock[i-1] - 1
+	}
+	for i := range market {
+		if i < 29 {
+			if correl[i] != 0 {
+				t.Fatalf("CORREL[%d] = %v within lookback", i, correl[i])
+			}
+		} else if _, want, _ := windowStats(market[i-29:i+1], stock[i-29:i+1]); math.Abs(correl[i]-want) > 1e-6 {
+			t.Fatalf("CORREL[%d] = %v, want %v", i, correl[i], want)
+		}
+		if i < 30 {
+			if beta[i] != 0 {
+				t.Fatalf("BETA[%d] = %v within lookback", i, beta[i])
+			}
+		} else if _, _, want := windowStats(rx[i-29:i+1], ry[i-29:i+1]); math.Abs(beta[i]-want) > 1e-6 {
+			t.Fatalf("BETA[%d] = %v, want %v", i, beta[i], want)
+		}
+	}
+	if last := beta[len(beta)-1]; math.Abs(last-2) > 0.3 {
+		t.Errorf("BETA last = %v, want about 2", last)
+	}
+
+	// 完全同步的两条序列相关系数为1，窗口内不变的序列为0
+	if self, err := CORREL(market, market, 10); err != nil || math.Abs(self[50]-1) > 1e-9 {
+		t.Errorf("CORREL(market, market)[50] = %v, %v", self[50], err)
+	}
+	flat := flatSeries("flat", len(market), 10).close
+	if out, err := CORREL(market, flat, 10); err != nil || out[50] != 0 {
+		t.Errorf("CORREL(market, flat)[50] = %v, %v", out[50], err)
+	}
+
+	b := &Bars{Open: stock, High: stock, Low: stock, Close: stock, Volume: stock}
+	if byBars, err := b.BETA(market, 30); err != nil || !equalFloats(byBars, beta) {
+		t.Errorf("Bars.BETA differs from BETA: %v", err)
+	}
+	if byBars, err := b.CORREL(market, 30); err != nil || !equalFloats(byBars, correl) {
+		t.Errorf("Bars.CORREL differs from CORREL: %v", err)
+	}
+	res, err := Call("BETA", map[string][]float64{"real0": market, "real1": stock}, map[string]float64{"timePeriod": 30})
+	if err != nil || !equalFloats(res["Real"], beta) {
+		t.Errorf("Call(BETA) differs from BETA: %v", err)
+	}
+
+	var le *LengthMismatchError
+	if _, err := CORREL(market, stock[1:], 30); !errors.As(err, &le) || !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("CORREL(length mismatch) = %v", err)
+	}
+	if _, err := BETA(market[1:], stock, 30); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("BETA(length mismatch) = %v", err)
+	}
+	var fe *FuncError
+	if _, err := BETA(market, stock, 0); !errors.As(err, &fe) || fe.Func != "TA_BETA" || fe.Param != "timePeriod" {
+		t.Errorf("BETA(0) = %v", err)
+	}
+	if _, err := CORREL(market[:10], stock[:10], 30); !errors.Is(err, ErrInputTooShort) {
+		t.Errorf("CORREL(10 bars, 30) = %v", err)
+	}
+	if out, err := BETA([]float64{}, nil, 5); err != nil || len(out) != 0 {
+		t.Errorf("BETA(empty) = %v, %v", out, err)
+	}
+}
+
+func TestSUMAndMidpoints(t *testing.T) {
+	b := testBars()
+	n := len(b.Close)
+
+	sum, err := SUM(b.Volume, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	midPoint, err := MIDPOINT(b.Close, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	midPrice, err := MIDPRICE(b.High, b.Low, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range n {
+		if i < 9 {
+			if sum[i] != 0 {
+				t.Fatalf("SUM[%d] = %v within lookback", i, sum[i])
+			}
+		} else {
+			want := 0.0
+			for _, v := range b.Volume[i-9 : i+1] {
+				want += v
+			}
+			if math.Abs(sum[i]-want) > 1e-6*math.Abs(want) {
+				t.Fatalf("SUM[%d] = %v, want %v", i, sum[i], want)
+			}
+		}
+		if i < 13 {
+			continue
+		}
+		lo, hi := math.Inf(1), math.Inf(-1)
+		for _, v := range b.Close[i-13 : i+1] {
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+		if midPoint[i] != (lo+hi)/2 {
+			t.Fatalf("MIDPOINT[%d] = %v, want %v", i, midPoint[i], (lo+hi)/2)
+		}
+	}
+
+	// MIDPRICE 与唐奇安通道的中轨相同
+	_, middle, _, err := Donchian(b.High, b.Low, 14)
+	if err != nil || !equalFloats(midPrice, middle) {
+		t.Errorf("MIDPRICE differs from Donchian middle: %v", err)
+	}
+	if byBars, err := b.MIDPRICE(14); err != nil || !equalFloats(byBars, midPrice) {
+		t.Errorf("Bars.MIDPRICE differs from MIDPRICE: %v", err)
+	}
+	for name, want := range map[string]int{"SUM": 29, "MIDPOINT": 13, "MIDPRICE": 13} {
+		if got, err := Lookback(name); err != nil || got != want {
+			t.Errorf("Lookback(%s) = %d, %v, want %d", name, got, err, want)
+		}
+	}
+
+	if _, err := SUM(b.Close, 1); !errors.Is(err, ErrBadParam) {
+		t.Errorf("SUM(1) = %v", err)
+	}
+	if _, err := MIDPRICE(b.High, b.Low[1:], 14); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("MIDPRICE(length mismatch) = %v", err)
+	}
+	if _, err := MIDPOINTLookback(100001); !errors.Is(err, ErrBadParam) {
+		t.Errorf("MIDPOINTLookback(100001) = %v", err)
+	}
+	if out, err := MIDPRICE(nil, nil, 14); err != nil || len(out) != 0 {
+		t.Errorf("MIDPRICE(empty) = %v, %v", out, err)
+	}
+}
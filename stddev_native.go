@@ -0,0 +1,93 @@
+package go4ta
+
+import "math"
+
+// nativeSTDDEV 是 TA_STDDEV 的原生实现。
+func nativeSTDDEV(close []float64, timePeriod int, nbDev float64) (int, []float64, error) {
+	timePeriod, nbDev, err := stddevParams(timePeriod, nbDev)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intSTDDEV(close, 0, timePeriod, nbDev)
+	return outBegIdx, output, nil
+}
+
+// intSTDDEV 对应 TA_INT_STDDEV。
+func intSTDDEV(in []float64, startIdx, timePeriod int, nbDev float64) (int, []float64) {
+	outBegIdx, output := intVAR(in, startIdx, timePeriod)
+	for i, tempReal := range output {
+		if !isZeroOrNeg(tempReal) {
+			if nbDev != 1.0 {
+				output[i] = math.Sqrt(tempReal) * nbDev
+			} else {
+				output[i] = math.Sqrt(tempReal)
+			}
+		} else {
+			output[i] = 0.0
+		}
+	}
+	return outBegIdx, output
+}
+
+// intVAR 对应 TA_INT_VAR。
+func intVAR(in []float64, startIdx, timePeriod int) (int, []float64) {
+	nbInitialElementNeeded := timePeriod - 1
+	if startIdx < nbInitialElementNeeded {
+		startIdx = nbInitialElementNeeded
+	}
+	if startIdx > len(in)-1 {
+		return 0, nil
+	}
+
+	output := make([]float64, 0, len(in)-startIdx)
+	periodTotal1 := 0.0
+	periodTotal2 := 0.0
+	trailingIdx := startIdx - nbInitialElementNeeded
+	i := trailingIdx
+	if timePeriod > 1 {
+		for i < startIdx {
+			tempReal := in[i]
+			i++
+			periodTotal1 += tempReal
+			tempReal *= tempReal
+			periodTotal2 += tempReal
+		}
+	}
+
+	period := float64(timePeriod)
+	for i < len(in) {
+		tempReal := in[i]
+		i++
+		periodTotal1 += tempReal
+		tempReal *= tempReal
+		periodTotal2 += tempReal
+		meanValue1 := periodTotal1 / period
+		meanValue2 := periodTotal2 / period
+
+		tempReal = in[trailingIdx]
+		trailingIdx++
+		periodTotal1 -= tempReal
+		tempReal *= tempReal
+		periodTotal2 -= tempReal
+
+		output = append(output, meanValue2-(meanValue1*meanValue1))
+	}
+	return startIdx, output
+}
+
+// nativeSTDDEVLookback 对应 TA_STDDEV_Lookback，参数无效时返回 -1。
+func nativeSTDDEVLookback(timePeriod int, nbDev float64) int {
+	timePeriod, _, err := stddevParams(timePeriod, nbDev)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// stddevParams 按 TA_STDDEV 的规则处理参数。
+func stddevParams(timePeriod int, nbDev float64) (int, float64, error) {
+	c := paramCheck{fn: "TA_STDDEV"}
+	timePeriod = c.integer("timePeriod", timePeriod, 5, 2, 100000)
+	nbDev = c.real("nbDev", nbDev, 1.0, taRealMin, taRealMax)
+	return timePeriod, nbDev, c.err
+}
@@ -0,0 +1,57 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taSTOCH 调用 TA_STOCH。
+func taSTOCH(high, low, close []float64, fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD int) (int, []float64, []float64, error) {
+	defer readSettings()()
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	outSlowK := make([]C.double, len(high))
+	outSlowD := make([]C.double, len(high))
+	cOutSlowK := (*C.double)(unsafe.Pointer(&outSlowK[0]))
+	cOutSlowD := (*C.double)(unsafe.Pointer(&outSlowD[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_STOCH(
+		0,
+		C.int(len(high)-1),
+		cHigh,
+		cLow,
+		cClose,
+		C.int(fastKPeriod),
+		C.int(slowKPeriod),
+		C.TA_MAType(maTypeK),
+		C.int(slowDPeriod),
+		C.TA_MAType(maTypeD),
+		&outBegIdx,
+		&outNBElement,
+		cOutSlowK,
+		cOutSlowD,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, _, _, _, paramErr := stochParams(fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD)
+		return 0, nil, nil, taErr("TA_STOCH", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(outSlowK, outNBElement), fromC(outSlowD, outNBElement), nil
+}
+
+// taSTOCHLookback 调用 TA_STOCH_Lookback，参数无效时返回 -1。
+func taSTOCHLookback(fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD int) int {
+	defer readSettings()()
+	return int(C.TA_STOCH_Lookback(C.int(fastKPeriod), C.int(slowKPeriod), C.TA_MAType(maTypeK), C.int(slowDPeriod), C.TA_MAType(maTypeD)))
+}
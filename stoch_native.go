@@ -0,0 +1,135 @@
+package go4ta
+
+// nativeSTOCH 是 TA_STOCH 的原生实现。
+func nativeSTOCH(high, low, close []float64, fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD int) (int, []float64, []float64, error) {
+	fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD, err := stochParams(fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	lookbackK := fastKPeriod - 1
+	lookbackKSlow := maLookback(slowKPeriod, maTypeK)
+	lookbackDSlow := maLookback(slowDPeriod, maTypeD)
+	lookbackTotal := lookbackK + lookbackDSlow + lookbackKSlow
+	startIdx := lookbackTotal
+	if startIdx > len(high)-1 {
+		return 0, nil, nil, nil
+	}
+
+	// 先计算覆盖所需区间及慢线回看期的 Fast-K
+	fastK := intFastK(high, low, close, startIdx-lookbackTotal, fastKPeriod)
+
+	_, slowK := intMA(fastK, slowKPeriod, maTypeK)
+	if len(slowK) == 0 {
+		return 0, nil, nil, nil
+	}
+	_, slowD := intMA(slowK, slowDPeriod, maTypeD)
+	if len(slowD) == 0 {
+		return 0, nil, nil, nil
+	}
+
+	return startIdx, slowK[lookbackDSlow : lookbackDSlow+len(slowD)], slowD, nil
+}
+
+func stochLookback(fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD int) int {
+	return (fastKPeriod - 1) + maLookback(slowKPeriod, maTypeK) + maLookback(slowDPeriod, maTypeD)
+}
+
+// intFastK 从 trailingIdx 开始计算原始随机值 (Fast-K)，对应 TA_STOCH/TA_STOCHF 中共用的循环。
+func intFastK(high, low, close []float64, trailingIdx, fastKPeriod int) []float64 {
+	today := trailingIdx + fastKPeriod - 1
+	output := make([]float64, 0, len(high)-today)
+	lowestIdx, highestIdx := -1, -1
+	diff, highest, lowest := 0.0, 0.0, 0.0
+
+	for today < len(high) {
+		// 更新最低价
+		tmp := low[today]
+		if lowestIdx < trailingIdx {
+			lowestIdx = trailingIdx
+			lowest = low[lowestIdx]
+			for i := lowestIdx + 1; i <= today; i++ {
+				tmp = low[i]
+				if tmp < lowest {
+					lowestIdx = i
+					lowest = tmp
+				}
+			}
+			diff = (highest - lowest) / 100.0
+		} else if tmp <= lowest {
+			lowestIdx = today
+			lowest = tmp
+			diff = (highest - lowest) / 100.0
+		}
+
+		// 更新最高价
+		tmp = high[today]
+		if highestIdx < trailingIdx {
+			highestIdx = trailingIdx
+			highest = high[highestIdx]
+			for i := highestIdx + 1; i <= today; i++ {
+				tmp = high[i]
+				if tmp > highest {
+					highestIdx = i
+					highest = tmp
+				}
+			}
+			diff = (highest - lowest) / 100.0
+		} else if tmp >= highest {
+			highestIdx = today
+			highest = tmp
+			diff = (highest - lowest) / 100.0
+		}
+
+		if diff != 0.0 {
+			output = append(output, (close[today]-lowest)/diff)
+		} else {
+			output = append(output, 0.0)
+		}
+		trailingIdx++
+		today++
+	}
+	return output
+}
+
+// intSTOCHF 对应 TA_STOCHF，返回 Fast-K 与 Fast-D。
+func intSTOCHF(high, low, close []float64, fastKPeriod, fastDPeriod, maTypeD int) (int, []float64, []float64) {
+	lookbackK := fastKPeriod - 1
+	lookbackFastD := maLookback(fastDPeriod, maTypeD)
+	lookbackTotal := lookbackK + lookbackFastD
+	startIdx := lookbackTotal
+	if startIdx > len(high)-1 {
+		return 0, nil, nil
+	}
+
+	fastK := intFastK(high, low, close, startIdx-lookbackTotal, fastKPeriod)
+	_, fastD := intMA(fastK, fastDPeriod, maTypeD)
+	if len(fastD) == 0 {
+		return 0, nil, nil
+	}
+	return startIdx, fastK[lookbackFastD : lookbackFastD+len(fastD)], fastD
+}
+
+func stochfLookback(fastKPeriod, fastDPeriod, maTypeD int) int {
+	return (fastKPeriod - 1) + maLookback(fastDPeriod, maTypeD)
+}
+
+// nativeSTOCHLookback 对应 TA_STOCH_Lookback，参数无效时返回 -1。
+func nativeSTOCHLookback(fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD int) int {
+	fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD, err := stochParams(fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD)
+	if err != nil {
+		return -1
+	}
+	return stochLookback(fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD)
+}
+
+// stochParams 按 TA_STOCH 的规则处理参数。
+func stochParams(fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD int) (int, int, int, int, int, error) {
+	c := paramCheck{fn: "TA_STOCH"}
+	fastKPeriod = c.integer("fastKPeriod", fastKPeriod, 5, 1, 100000)
+	slowKPeriod = c.integer("slowKPeriod", slowKPeriod, 3, 1, 100000)
+	slowDPeriod = c.integer("slowDPeriod", slowDPeriod, 3, 1, 100000)
+	maTypeK = c.maType("maTypeK", maTypeK)
+	maTypeD = c.maType("maTypeD", maTypeD)
+	return fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD, c.err
+}
@@ -0,0 +1,143 @@
+package go4ta
+
+// STOCHStream 是 STOCH 的流式版本。
+type STOCHStream struct {
+	state        stochState
+	slowK, slowD float64
+	ready        bool
+}
+
+// NewSTOCHStream 创建随机指标流式指标。
+//
+// @param fastKPeriod    - Fast-K 周期
+// @param slowKPeriod    - Slow-K 周期
+// @param slowDPeriod    - Slow-D 周期
+// @param maTypeK        - Slow-K 均线类型
+// @param maTypeD        - Slow-D 均线类型
+// @return *STOCHStream  - 流式指标
+// @return error         - 参数无效时返回错误
+func NewSTOCHStream(fastKPeriod, slowKPeriod, slowDPeriod int, maTypeK, maTypeD MAType) (*STOCHStream, error) {
+	fastK, ok := optInteger(fastKPeriod, 5, 1, 100000)
+	if !ok {
+		return nil, badParam("NewSTOCHStream", "fastKPeriod", fastKPeriod)
+	}
+	slowK, ok := optInteger(slowKPeriod, 3, 1, 100000)
+	if !ok {
+		return nil, badParam("NewSTOCHStream", "slowKPeriod", slowKPeriod)
+	}
+	slowD, ok := optInteger(slowDPeriod, 3, 1, 100000)
+	if !ok {
+		return nil, badParam("NewSTOCHStream", "slowDPeriod", slowDPeriod)
+	}
+	typeK, ok := optMAType(int(maTypeK))
+	if !ok {
+		return nil, badParam("NewSTOCHStream", "maTypeK", int(maTypeK))
+	}
+	typeD, ok := optMAType(int(maTypeD))
+	if !ok {
+		return nil, badParam("NewSTOCHStream", "maTypeD", int(maTypeD))
+	}
+	return &STOCHStream{state: *newSTOCHState(fastK, slowK, slowD, typeK, typeD)}, nil
+}
+
+// Update 输入一根新的价格柱，返回最新的 slowK、slowD，未就绪时均为 0。
+func (s *STOCHStream) Update(high, low, close float64) (slowK, slowD float64) {
+	s.slowK, s.slowD, s.ready = s.state.update(high, low, close)
+	return s.slowK, s.slowD
+}
+
+// Value 返回最近一次 Update 的结果。
+func (s *STOCHStream) Value() (slowK, slowD float64) {
+	return s.slowK, s.slowD
+}
+
+// Ready 表示当前值是否已越过回看期。
+func (s *STOCHStream) Ready() bool {
+	return s.ready
+}
+
+func (s *STOCHStream) snapshot() *streamSnapshot {
+	return &streamSnapshot{kind: "STOCH", values: []*float64{&s.slowK, &s.slowD}, ready: &s.ready, state: &s.state}
+}
+
+// MarshalBinary 将完整的计算状态编码为二进制快照。
+func (s *STOCHStream) MarshalBinary() ([]byte, error) {
+	return s.snapshot().marshalBinary()
+}
+
+// UnmarshalBinary 从 MarshalBinary 生成的快照恢复状态。
+func (s *STOCHStream) UnmarshalBinary(data []byte) error {
+	return s.snapshot().unmarshalBinary(data)
+}
+
+// MarshalJSON 将完整的计算状态编码为 JSON 快照。
+func (s *STOCHStream) MarshalJSON() ([]byte, error) {
+	return s.snapshot().marshalJSON()
+}
+
+// UnmarshalJSON 从 MarshalJSON 生成的快照恢复状态。
+func (s *STOCHStream) UnmarshalJSON(data []byte) error {
+	return s.snapshot().unmarshalJSON(data)
+}
+
+// fastKState 维护 Fast-K 周期内的最高价与最低价，对应 intFastK。
+type fastKState struct {
+	Period  int
+	Count   int
+	Highest extremeWindow
+	Lowest  extremeWindow
+}
+
+func newFastKState(fastKPeriod int) fastKState {
+	return fastKState{
+		Period:  fastKPeriod,
+		Highest: extremeWindow{Size: fastKPeriod, Max: true},
+		Lowest:  extremeWindow{Size: fastKPeriod},
+	}
+}
+
+func (s *fastKState) update(high, low, close float64) (float64, bool) {
+	today := s.Count
+	s.Count++
+	lowest := s.Lowest.push(today, low)
+	highest := s.Highest.push(today, high)
+	if s.Count < s.Period {
+		return 0, false
+	}
+	diff := (highest - lowest) / 100.0
+	if diff != 0.0 {
+		return (close - lowest) / diff, true
+	}
+	return 0.0, true
+}
+
+// stochState 依次串联 Fast-K、Slow-K 均线与 Slow-D 均线。
+type stochState struct {
+	FastK fastKState
+	SlowK maState
+	SlowD maState
+}
+
+func newSTOCHState(fastKPeriod, slowKPeriod, slowDPeriod, maTypeK, maTypeD int) *stochState {
+	return &stochState{
+		FastK: newFastKState(fastKPeriod),
+		SlowK: newMAState(slowKPeriod, maTypeK),
+		SlowD: newMAState(slowDPeriod, maTypeD),
+	}
+}
+
+func (s *stochState) update(high, low, close float64) (slowK, slowD float64, ok bool) {
+	fastK, ok := s.FastK.update(high, low, close)
+	if !ok {
+		return 0, 0, false
+	}
+	slowK, ok = s.SlowK.update(fastK)
+	if !ok {
+		return 0, 0, false
+	}
+	slowD, ok = s.SlowD.update(slowK)
+	if !ok {
+		return 0, 0, false
+	}
+	return slowK, slowD, true
+}
@@ -1,18 +1,6 @@
 package go4ta
 
-/*
-#cgo LDFLAGS: -lta-lib -lm
-#include <ta-lib/ta_libc.h>
-#include <ta-lib/ta_func.h>
-#include <stdlib.h>
-*/
-import "C"
-import (
-	"fmt"
-	"unsafe"
-)
-
-// STOCHRSI 使用 CGO 直接调用 TA-Lib C 库来计算随机RSI（Stochastic RSI）。
+// STOCHRSI 计算随机RSI（Stochastic RSI），按位置返回各输出，结果含义见 CalcSTOCHRSI。
 //
 // @param close        - 收盘价序列
 // @param timePeriod   - RSI周期
@@ -21,44 +9,50 @@ import (
 // @param maType       - 均线类型
 // @return fastK, fastD - 两个与输入等长的结果序列
 // @return error       - 如果输入数据无效或 C 库调用失败，则返回错误。
-func STOCHRSI(close []float64, timePeriod, fastKPeriod, fastDPeriod, maType int) ([]float64, []float64, error) {
+func STOCHRSI(close []float64, timePeriod, fastKPeriod, fastDPeriod int, maType MAType) ([]float64, []float64, error) {
+	r, err := CalcSTOCHRSI(close, timePeriod, fastKPeriod, fastDPeriod, maType)
+	return r.FastK, r.FastD, err
+}
+
+// CalcSTOCHRSI 计算随机RSI（Stochastic RSI）。
+//
+// @param close        - 收盘价序列
+// @param timePeriod   - RSI周期
+// @param fastKPeriod  - K线周期
+// @param fastDPeriod  - D线周期
+// @param maType       - 均线类型
+// @return STOCHRSIResult - 快K与快D，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error       - 如果输入数据无效或 C 库调用失败，则返回错误。
+func CalcSTOCHRSI(close []float64, timePeriod, fastKPeriod, fastDPeriod int, maType MAType) (STOCHRSIResult, error) {
 	if len(close) == 0 {
-		return []float64{}, []float64{}, nil
+		return STOCHRSIResult{[]float64{}, []float64{}}, nil
 	}
-	cClose := (*C.double)(unsafe.Pointer(&close[0]))
-	outFastK := make([]C.double, len(close))
-	outFastD := make([]C.double, len(close))
-	cOutFastK := (*C.double)(unsafe.Pointer(&outFastK[0]))
-	cOutFastD := (*C.double)(unsafe.Pointer(&outFastD[0]))
-
-	outBegIdx := C.int(0)
-	outNBElement := C.int(0)
-
-	retCode := C.TA_STOCHRSI(
-		0,
-		C.int(len(close)-1),
-		cClose,
-		C.int(timePeriod),
-		C.int(fastKPeriod),
-		C.int(fastDPeriod),
-		C.TA_MAType(maType),
-		&outBegIdx,
-		&outNBElement,
-		cOutFastK,
-		cOutFastD,
-	)
 
-	if retCode != C.TA_SUCCESS {
-		return nil, nil, fmt.Errorf("TA-Lib C call failed with exit code: %d", retCode)
+	if err := checkParams("STOCHRSI", float64(timePeriod), float64(fastKPeriod), float64(fastDPeriod), float64(maType)); err != nil {
+		return STOCHRSIResult{}, err
 	}
 
-	fastK := make([]float64, len(close))
-	fastD := make([]float64, len(close))
-	for i := 0; i < int(outNBElement); i++ {
-		idx := int(outBegIdx) + i
-		fastK[idx] = float64(outFastK[i])
-		fastD[idx] = float64(outFastD[i])
+	outBegIdx, outFastK, outFastD, err := taSTOCHRSI(close, timePeriod, fastKPeriod, fastDPeriod, int(maType))
+	if err != nil {
+		return STOCHRSIResult{}, err
 	}
 
-	return fastK, fastD, nil
+	w := newWarmup(0)
+	return STOCHRSIResult{
+		FastK: w.spread(len(close), outBegIdx, outFastK),
+		FastD: w.spread(len(close), outBegIdx, outFastD),
+	}, nil
+}
+
+// STOCHRSILookback 返回 STOCHRSI 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod  - RSI 周期
+// @param fastKPeriod - Fast-K 周期
+// @param fastDPeriod - Fast-D 周期
+// @param maType      - Fast-D 均线类型
+// @return int        - 回看期
+// @return error      - 参数无效时返回错误
+func STOCHRSILookback(timePeriod, fastKPeriod, fastDPeriod int, maType MAType) (int, error) {
+	_, _, _, _, err := stochrsiParams(timePeriod, fastKPeriod, fastDPeriod, int(maType))
+	return lookbackResult("TA_STOCHRSI", taSTOCHRSILookback(timePeriod, fastKPeriod, fastDPeriod, int(maType)), err)
 }
@@ -0,0 +1,52 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taSTOCHRSI 调用 TA_STOCHRSI。
+func taSTOCHRSI(close []float64, timePeriod, fastKPeriod, fastDPeriod, maType int) (int, []float64, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	outFastK := make([]C.double, len(close))
+	outFastD := make([]C.double, len(close))
+	cOutFastK := (*C.double)(unsafe.Pointer(&outFastK[0]))
+	cOutFastD := (*C.double)(unsafe.Pointer(&outFastD[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_STOCHRSI(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		C.int(fastKPeriod),
+		C.int(fastDPeriod),
+		C.TA_MAType(maType),
+		&outBegIdx,
+		&outNBElement,
+		cOutFastK,
+		cOutFastD,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, _, _, paramErr := stochrsiParams(timePeriod, fastKPeriod, fastDPeriod, maType)
+		return 0, nil, nil, taErr("TA_STOCHRSI", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(outFastK, outNBElement), fromC(outFastD, outNBElement), nil
+}
+
+// taSTOCHRSILookback 调用 TA_STOCHRSI_Lookback，参数无效时返回 -1。
+func taSTOCHRSILookback(timePeriod, fastKPeriod, fastDPeriod, maType int) int {
+	defer readSettings()()
+	return int(C.TA_STOCHRSI_Lookback(C.int(timePeriod), C.int(fastKPeriod), C.int(fastDPeriod), C.TA_MAType(maType)))
+}
@@ -0,0 +1,49 @@
+package go4ta
+
+// nativeSTOCHRSI 是 TA_STOCHRSI 的原生实现。
+func nativeSTOCHRSI(close []float64, timePeriod, fastKPeriod, fastDPeriod, maType int) (int, []float64, []float64, error) {
+	timePeriod, fastKPeriod, fastDPeriod, maType, err := stochrsiParams(timePeriod, fastKPeriod, fastDPeriod, maType)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	lookbackSTOCHF := stochfLookback(fastKPeriod, fastDPeriod, maType)
+	lookbackTotal := rsiLookback(timePeriod) + lookbackSTOCHF
+	startIdx := lookbackTotal
+	if startIdx > len(close)-1 {
+		return 0, nil, nil, nil
+	}
+
+	_, rsi := intRSI(close, timePeriod)
+	if len(rsi) == 0 {
+		return 0, nil, nil, nil
+	}
+	_, fastK, fastD := intSTOCHF(rsi, rsi, rsi, fastKPeriod, fastDPeriod, maType)
+	if len(fastK) == 0 {
+		return 0, nil, nil, nil
+	}
+	return startIdx, fastK, fastD, nil
+}
+
+func stochRSILookback(timePeriod, fastKPeriod, fastDPeriod, maType int) int {
+	return rsiLookback(timePeriod) + stochfLookback(fastKPeriod, fastDPeriod, maType)
+}
+
+// nativeSTOCHRSILookback 对应 TA_STOCHRSI_Lookback，参数无效时返回 -1。
+func nativeSTOCHRSILookback(timePeriod, fastKPeriod, fastDPeriod, maType int) int {
+	timePeriod, fastKPeriod, fastDPeriod, maType, err := stochrsiParams(timePeriod, fastKPeriod, fastDPeriod, maType)
+	if err != nil {
+		return -1
+	}
+	return stochRSILookback(timePeriod, fastKPeriod, fastDPeriod, maType)
+}
+
+// stochrsiParams 按 TA_STOCHRSI 的规则处理参数。
+func stochrsiParams(timePeriod, fastKPeriod, fastDPeriod, maType int) (int, int, int, int, error) {
+	c := paramCheck{fn: "TA_STOCHRSI"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	fastKPeriod = c.integer("fastKPeriod", fastKPeriod, 5, 1, 100000)
+	fastDPeriod = c.integer("fastDPeriod", fastDPeriod, 3, 1, 100000)
+	maType = c.maType("maType", maType)
+	return timePeriod, fastKPeriod, fastDPeriod, maType, c.err
+}
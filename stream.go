@@ -0,0 +1,87 @@
+package go4ta
+
+// 流式指标：每个 XxxStream 保存计算所需的全部中间状态，每来一根新价格柱调用一次 Update，
+// 耗时与历史长度无关。各流式指标与对应批量函数使用相同的初始化方式和运算顺序，
+// 因此 Update 之后的 Value 与批量函数在同一段历史上计算出的最后一个值一致。
+// Ready 返回 false 时，批量函数在该位置输出的是填充值，Value 也返回相同的填充值。
+// 流式指标始终按 TA-Lib 的默认设置计算，不受 SetUnstablePeriod 与 SetCompatibility 影响。
+//
+// 状态结构体的字段采用导出命名，由 stream_state.go 按字段顺序整体序列化，
+// 因此调整字段会改变快照格式，需要同时递增 streamStateVersion。
+
+// ring 是定长环形缓冲区，保存最近写入的 len(Buf) 个值。
+type ring struct {
+	Buf  []float64
+	Head int // 下一个写入位置
+}
+
+func newRing(size int) ring {
+	return ring{Buf: make([]float64, size)}
+}
+
+// push 写入一个新值，覆盖最旧的值。
+func (r *ring) push(v float64) {
+	r.Buf[r.Head] = v
+	r.Head++
+	if r.Head == len(r.Buf) {
+		r.Head = 0
+	}
+}
+
+// back 返回倒数第 k+1 个写入的值，k=0 为最新值。k 须小于缓冲区长度。
+func (r *ring) back(k int) float64 {
+	i := r.Head - 1 - k
+	if i < 0 {
+		i += len(r.Buf)
+	}
+	return r.Buf[i]
+}
+
+func (r *ring) checkState() error {
+	if len(r.Buf) == 0 || r.Head < 0 || r.Head >= len(r.Buf) {
+		return stateErr("bad ring buffer")
+	}
+	return nil
+}
+
+// checkSize 检查缓冲区长度是否与周期对应。
+func (r *ring) checkSize(size int) error {
+	if len(r.Buf) != size {
+		return stateErr("ring buffer has %d values, want %d", len(r.Buf), size)
+	}
+	return nil
+}
+
+// extremeWindow 用单调队列维护滑动窗口内的最高或最低价，摊还 O(1)。
+type extremeWindow struct {
+	Size int
+	Max  bool      // true 维护最大值，false 维护最小值
+	Idx  []int     // 队列中各值所在的价格柱序号
+	Val  []float64 // 自队首到队尾单调
+}
+
+// push 加入序号为 idx 的新值，并移出窗口外的旧值，返回当前窗口的极值。
+func (w *extremeWindow) push(idx int, v float64) float64 {
+	for n := len(w.Val); n > 0; n-- {
+		last := w.Val[n-1]
+		if (w.Max && last > v) || (!w.Max && last < v) {
+			break
+		}
+		w.Idx = w.Idx[:n-1]
+		w.Val = w.Val[:n-1]
+	}
+	w.Idx = append(w.Idx, idx)
+	w.Val = append(w.Val, v)
+	if w.Idx[0] <= idx-w.Size {
+		w.Idx = w.Idx[1:]
+		w.Val = w.Val[1:]
+	}
+	return w.Val[0]
+}
+
+func (w *extremeWindow) checkState() error {
+	if w.Size < 1 || len(w.Idx) != len(w.Val) {
+		return stateErr("bad extreme window")
+	}
+	return nil
+}
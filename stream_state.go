@@ -0,0 +1,421 @@
+package go4ta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// 流式指标的状态快照：每个 XxxStream 都实现 encoding.BinaryMarshaler/BinaryUnmarshaler
+// 与 json.Marshaler/Unmarshaler，保存并恢复全部中间状态（包括当前输出值与是否就绪），
+// 恢复后继续 Update 的结果与从未中断时完全相同。恢复时可以使用零值对象，例如：
+//
+//	var s go4ta.SuperTrendStream
+//	err := s.UnmarshalBinary(data)
+//
+// 二进制格式：版本号(1字节)、指标名称、当前输出值、是否就绪、状态结构体。
+// 状态结构体按字段顺序编码：整数为 zigzag 变长整数，浮点数为8字节小端 IEEE 754，
+// 布尔值为1字节，切片先写长度，指针先写1字节表示是否为空。
+// JSON 格式中 NaN 与 ±Inf 分别写为字符串 "NaN"、"+Inf"、"-Inf"。
+
+// streamStateVersion 是当前的快照格式版本，格式变化时递增。
+const streamStateVersion = 1
+
+// ErrStreamState 表示快照数据无法恢复为流式指标：格式损坏、版本不支持或指标类型不符。
+var ErrStreamState = errors.New("invalid stream state")
+
+// streamSnapshot 指向一个流式指标中需要保存的全部字段。
+type streamSnapshot struct {
+	kind   string
+	values []*float64
+	ready  *bool
+	state  any // 指向状态结构体的指针
+}
+
+// stateChecker 由需要在恢复后检查一致性的状态类型实现，避免损坏的数据在 Update 时引发越界。
+type stateChecker interface {
+	checkState() error
+}
+
+func stateErr(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrStreamState, fmt.Sprintf(format, args...))
+}
+
+func (s *streamSnapshot) marshalBinary() ([]byte, error) {
+	buf := []byte{streamStateVersion}
+	buf = binary.AppendUvarint(buf, uint64(len(s.kind)))
+	buf = append(buf, s.kind...)
+	for _, v := range s.values {
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(*v))
+	}
+	buf = appendState(buf, reflect.ValueOf(s.ready).Elem())
+	buf = appendState(buf, reflect.ValueOf(s.state).Elem())
+	return buf, nil
+}
+
+func (s *streamSnapshot) unmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	version, err := r.ReadByte()
+	if err != nil {
+		return stateErr("empty data")
+	}
+	if version != streamStateVersion {
+		return stateErr("unsupported version %d", version)
+	}
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n > uint64(r.Len()) {
+		return stateErr("truncated data")
+	}
+	kind := make([]byte, n)
+	r.Read(kind)
+	if string(kind) != s.kind {
+		return stateErr("state of %s cannot be restored into %s", kind, s.kind)
+	}
+
+	values := make([]float64, len(s.values))
+	for i := range values {
+		var bits uint64
+		if err := binary.Read(r, binary.LittleEndian, &bits); err != nil {
+			return stateErr("truncated data")
+		}
+		values[i] = math.Float64frombits(bits)
+	}
+	var ready bool
+	if err := readState(r, reflect.ValueOf(&ready).Elem()); err != nil {
+		return err
+	}
+	// 先解码到新的状态对象，成功后再整体替换，失败时原对象保持不变
+	state := reflect.New(reflect.TypeOf(s.state).Elem())
+	if err := readState(r, state.Elem()); err != nil {
+		return err
+	}
+	if r.Len() != 0 {
+		return stateErr("%d trailing bytes", r.Len())
+	}
+	return s.restore(values, ready, state)
+}
+
+// snapshotJSON 是 JSON 快照的外层结构。
+type snapshotJSON struct {
+	Version int               `json:"version"`
+	Kind    string            `json:"kind"`
+	Value   []json.RawMessage `json:"value"`
+	Ready   bool              `json:"ready"`
+	State   json.RawMessage   `json:"state"`
+}
+
+func (s *streamSnapshot) marshalJSON() ([]byte, error) {
+	out := snapshotJSON{Version: streamStateVersion, Kind: s.kind, Ready: *s.ready}
+	for _, v := range s.values {
+		out.Value = append(out.Value, jsonFloat(*v))
+	}
+	var buf bytes.Buffer
+	appendJSONState(&buf, reflect.ValueOf(s.state).Elem())
+	out.State = buf.Bytes()
+	return json.Marshal(out)
+}
+
+func (s *streamSnapshot) unmarshalJSON(data []byte) error {
+	var in snapshotJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return stateErr("%v", err)
+	}
+	if in.Version != streamStateVersion {
+		return stateErr("unsupported version %d", in.Version)
+	}
+	if in.Kind != s.kind {
+		return stateErr("state of %s cannot be restored into %s", in.Kind, s.kind)
+	}
+	if len(in.Value) != len(s.values) {
+		return stateErr("expected %d values, got %d", len(s.values), len(in.Value))
+	}
+
+	values := make([]float64, len(s.values))
+	for i, raw := range in.Value {
+		if err := readJSONState(decodeJSON(raw), reflect.ValueOf(&values[i]).Elem()); err != nil {
+			return err
+		}
+	}
+	state := reflect.New(reflect.TypeOf(s.state).Elem())
+	if err := readJSONState(decodeJSON(in.State), state.Elem()); err != nil {
+		return err
+	}
+	return s.restore(values, in.Ready, state)
+}
+
+// restore 检查解码出的状态，并写回流式指标。
+func (s *streamSnapshot) restore(values []float64, ready bool, state reflect.Value) error {
+	if err := checkState(state.Elem()); err != nil {
+		return err
+	}
+	for i, v := range values {
+		*s.values[i] = v
+	}
+	*s.ready = ready
+	reflect.ValueOf(s.state).Elem().Set(state.Elem())
+	return nil
+}
+
+// checkState 递归调用各层状态的 checkState。
+func checkState(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		return checkState(v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if err := checkState(v.Field(i)); err != nil {
+				return err
+			}
+		}
+		if c, ok := v.Addr().Interface().(stateChecker); ok {
+			return c.checkState()
+		}
+	}
+	return nil
+}
+
+func appendState(buf []byte, v reflect.Value) []byte {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(buf, 1)
+		}
+		return append(buf, 0)
+	case reflect.Int:
+		return binary.AppendVarint(buf, v.Int())
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(v.Float()))
+	case reflect.Slice:
+		buf = binary.AppendUvarint(buf, uint64(v.Len()))
+		fallthrough
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			buf = appendState(buf, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			buf = appendState(buf, v.Field(i))
+		}
+	case reflect.Pointer:
+		if v.IsNil() {
+			return append(buf, 0)
+		}
+		buf = append(buf, 1)
+		return appendState(buf, v.Elem())
+	default:
+		panic("go4ta: unsupported state field type " + v.Type().String())
+	}
+	return buf
+}
+
+func readState(r *bytes.Reader, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := r.ReadByte()
+		if err != nil || b > 1 {
+			return stateErr("bad bool")
+		}
+		v.SetBool(b == 1)
+	case reflect.Int:
+		n, err := binary.ReadVarint(r)
+		if err != nil || v.OverflowInt(n) {
+			return stateErr("bad integer")
+		}
+		v.SetInt(n)
+	case reflect.Float64:
+		var bits uint64
+		if err := binary.Read(r, binary.LittleEndian, &bits); err != nil {
+			return stateErr("truncated data")
+		}
+		v.SetFloat(math.Float64frombits(bits))
+	case reflect.Slice:
+		n, err := binary.ReadUvarint(r)
+		// 每个元素至少占1字节，据此拒绝明显过大的长度
+		if err != nil || n > uint64(r.Len()) {
+			return stateErr("bad slice length")
+		}
+		v.Set(reflect.MakeSlice(v.Type(), int(n), int(n)))
+		fallthrough
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := readState(r, v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if err := readState(r, v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Pointer:
+		b, err := r.ReadByte()
+		if err != nil || b > 1 {
+			return stateErr("bad pointer flag")
+		}
+		if b == 0 {
+			v.SetZero()
+			return nil
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		return readState(r, v.Elem())
+	default:
+		panic("go4ta: unsupported state field type " + v.Type().String())
+	}
+	return nil
+}
+
+// jsonFloat 将浮点数编码为 JSON，非有限值写为字符串。
+func jsonFloat(f float64) json.RawMessage {
+	switch {
+	case math.IsNaN(f):
+		return json.RawMessage(`"NaN"`)
+	case math.IsInf(f, 1):
+		return json.RawMessage(`"+Inf"`)
+	case math.IsInf(f, -1):
+		return json.RawMessage(`"-Inf"`)
+	}
+	return strconv.AppendFloat(nil, f, 'g', -1, 64)
+}
+
+func appendJSONState(buf *bytes.Buffer, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		buf.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int:
+		buf.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Float64:
+		buf.Write(jsonFloat(v.Float()))
+	case reflect.Slice, reflect.Array:
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			appendJSONState(buf, v.Index(i))
+		}
+		buf.WriteByte(']')
+	case reflect.Struct:
+		buf.WriteByte('{')
+		first := true
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).Kind() == reflect.Pointer && v.Field(i).IsNil() {
+				continue
+			}
+			if !first {
+				buf.WriteByte(',')
+			}
+			first = false
+			buf.WriteString(strconv.Quote(v.Type().Field(i).Name))
+			buf.WriteByte(':')
+			appendJSONState(buf, v.Field(i))
+		}
+		buf.WriteByte('}')
+	case reflect.Pointer:
+		appendJSONState(buf, v.Elem())
+	default:
+		panic("go4ta: unsupported state field type " + v.Type().String())
+	}
+}
+
+// decodeJSON 把 JSON 解码为通用的 map/slice/json.Number 结构，解码失败时返回 nil。
+func decodeJSON(data []byte) any {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var out any
+	if d.Decode(&out) != nil {
+		return nil
+	}
+	return out
+}
+
+func readJSONState(in any, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		b, ok := in.(bool)
+		if !ok {
+			return stateErr("expected bool, got %v", in)
+		}
+		v.SetBool(b)
+	case reflect.Int:
+		num, ok := in.(json.Number)
+		if !ok {
+			return stateErr("expected integer, got %v", in)
+		}
+		n, err := strconv.ParseInt(string(num), 10, 64)
+		if err != nil || v.OverflowInt(n) {
+			return stateErr("bad integer %s", num)
+		}
+		v.SetInt(n)
+	case reflect.Float64:
+		var f float64
+		switch x := in.(type) {
+		case json.Number:
+			var err error
+			if f, err = strconv.ParseFloat(string(x), 64); err != nil {
+				return stateErr("bad number %s", x)
+			}
+		case string:
+			switch x {
+			case "NaN":
+				f = math.NaN()
+			case "+Inf":
+				f = math.Inf(1)
+			case "-Inf":
+				f = math.Inf(-1)
+			default:
+				return stateErr("bad number %q", x)
+			}
+		default:
+			return stateErr("expected number, got %v", in)
+		}
+		v.SetFloat(f)
+	case reflect.Slice, reflect.Array:
+		items, ok := in.([]any)
+		if !ok {
+			return stateErr("expected array, got %v", in)
+		}
+		if v.Kind() == reflect.Slice {
+			v.Set(reflect.MakeSlice(v.Type(), len(items), len(items)))
+		} else if len(items) != v.Len() {
+			return stateErr("expected %d items, got %d", v.Len(), len(items))
+		}
+		for i, item := range items {
+			if err := readJSONState(item, v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		fields, ok := in.(map[string]any)
+		if !ok {
+			return stateErr("expected object, got %v", in)
+		}
+		for i := 0; i < v.NumField(); i++ {
+			name := v.Type().Field(i).Name
+			item, ok := fields[name]
+			if !ok {
+				if v.Field(i).Kind() == reflect.Pointer {
+					continue
+				}
+				return stateErr("missing field %s", name)
+			}
+			if err := readJSONState(item, v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		return readJSONState(in, v.Elem())
+	default:
+		panic("go4ta: unsupported state field type " + v.Type().String())
+	}
+	return nil
+}
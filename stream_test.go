@@ -0,0 +1,340 @@
+package go4ta
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// streamCase 描述一个流式指标及其对应的批量计算。
+type streamCase struct {
+	name     string
+	lookback int
+	batch    func(s *conformanceSeries) ([][]float64, error)
+	// newStream 创建一个新的流式指标
+	newStream func() (streamUnderTest, error)
+	// update 向流式指标输入第 i 根价格柱，返回 Update 的结果、随后 Value 的结果以及 Ready
+	update func(st streamUnderTest, s *conformanceSeries, i int) (update, value []float64, ready bool)
+}
+
+// streamUnderTest 是所有流式指标共有的方法。
+type streamUnderTest interface {
+	Ready() bool
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	json.Marshaler
+	json.Unmarshaler
+}
+
+func streamCases() []streamCase {
+	var cases []streamCase
+	add := func(name string, lookback int, batch func(s *conformanceSeries) ([][]float64, error),
+		newStream func() (streamUnderTest, error),
+		update func(st streamUnderTest, s *conformanceSeries, i int) ([]float64, []float64, bool)) {
+		cases = append(cases, streamCase{name, lookback, batch, newStream, update})
+	}
+	vals := func(v ...float64) []float64 { return v }
+
+	for _, p := range []int{1, 2, 5, 30} {
+		for maType := 0; maType <= 8; maType++ {
+			add(fmt.Sprint("MA(", p, ",", maType, ")"), maLookback(p, maType),
+				func(s *conformanceSeries) ([][]float64, error) {
+					out, err := MA(s.close, p, MAType(maType))
+					return [][]float64{out}, err
+				},
+				func() (streamUnderTest, error) { return NewMAStream(p, MAType(maType)) },
+				func(st streamUnderTest, s *conformanceSeries, i int) ([]float64, []float64, bool) {
+					x := st.(*MAStream)
+					return vals(x.Update(s.close[i])), vals(x.Value()), x.Ready()
+				})
+		}
+	}
+	for _, p := range []int{2, 14} {
+		add(fmt.Sprint("RSI(", p, ")"), p,
+			func(s *conformanceSeries) ([][]float64, error) {
+				out, err := RSI(s.close, p)
+				return [][]float64{out}, err
+			},
+			func() (streamUnderTest, error) { return NewRSIStream(p) },
+			func(st streamUnderTest, s *conformanceSeries, i int) ([]float64, []float64, bool) {
+				x := st.(*RSIStream)
+				return vals(x.Update(s.close[i])), vals(x.Value()), x.Ready()
+			})
+		add(fmt.Sprint("ADX(", p, ")"), adxLookback(p),
+			func(s *conformanceSeries) ([][]float64, error) {
+				out, err := ADX(s.high, s.low, s.close, p)
+				return [][]float64{out}, err
+			},
+			func() (streamUnderTest, error) { return NewADXStream(p) },
+			func(st streamUnderTest, s *conformanceSeries, i int) ([]float64, []float64, bool) {
+				x := st.(*ADXStream)
+				return vals(x.Update(s.high[i], s.low[i], s.close[i])), vals(x.Value()), x.Ready()
+			})
+	}
+	for _, p := range []int{1, 2, 14} {
+		add(fmt.Sprint("ATR(", p, ")"), atrLookback(p),
+			func(s *conformanceSeries) ([][]float64, error) {
+				out, err := ATR(s.high, s.low, s.close, p)
+				return [][]float64{out}, err
+			},
+			func() (streamUnderTest, error) { return NewATRStream(p) },
+			func(st streamUnderTest, s *conformanceSeries, i int) ([]float64, []float64, bool) {
+				x := st.(*ATRStream)
+				return vals(x.Update(s.high[i], s.low[i], s.close[i])), vals(x.Value()), x.Ready()
+			})
+	}
+	for _, p := range [][3]int{{12, 26, 9}, {26, 12, 9}, {2, 3, 1}, {5, 35, 5}} {
+		add(fmt.Sprint("MACD", p), macdLookback(p[0], p[1], p[2]),
+			func(s *conformanceSeries) ([][]float64, error) {
+				macd, signal, hist, err := MACD(s.close, p[0], p[1], p[2])
+				return [][]float64{macd, signal, hist}, err
+			},
+			func() (streamUnderTest, error) { return NewMACDStream(p[0], p[1], p[2]) },
+			func(st streamUnderTest, s *conformanceSeries, i int) ([]float64, []float64, bool) {
+				x := st.(*MACDStream)
+				return vals(x.Update(s.close[i])), vals(x.Value()), x.Ready()
+			})
+	}
+	for _, p := range []int{5, 20, 40} {
+		for maType := 0; maType <= 8; maType++ {
+			add(fmt.Sprint("BBANDS(", p, ",2,1.5,", maType, ")"), max(maLookback(p, maType), p-1),
+				func(s *conformanceSeries) ([][]float64, error) {
+					upper, middle, lower, err := BBands(s.close, p, 2, 1.5, MAType(maType))
+					return [][]float64{upper, middle, lower}, err
+				},
+				func() (streamUnderTest, error) { return NewBBandsStream(p, 2, 1.5, MAType(maType)) },
+				func(st streamUnderTest, s *conformanceSeries, i int) ([]float64, []float64, bool) {
+					x := st.(*BBandsStream)
+					return vals(x.Update(s.close[i])), vals(x.Value()), x.Ready()
+				})
+		}
+	}
+	for _, p := range [][3]int{{5, 3, 3}, {14, 1, 5}, {1, 2, 2}} {
+		for maType := 0; maType <= 8; maType++ {
+			add(fmt.Sprint("STOCH", p, maType), stochLookback(p[0], p[1], p[2], maType, maType),
+				func(s *conformanceSeries) ([][]float64, error) {
+					slowK, slowD, err := STOCH(s.high, s.low, s.close, p[0], p[1], p[2], MAType(maType), MAType(maType))
+					return [][]float64{slowK, slowD}, err
+				},
+				func() (streamUnderTest, error) {
+					return NewSTOCHStream(p[0], p[1], p[2], MAType(maType), MAType(maType))
+				},
+				func(st streamUnderTest, s *conformanceSeries, i int) ([]float64, []float64, bool) {
+					x := st.(*STOCHStream)
+					return vals(x.Update(s.high[i], s.low[i], s.close[i])), vals(x.Value()), x.Ready()
+				})
+		}
+	}
+	add("OBV", 0,
+		func(s *conformanceSeries) ([][]float64, error) {
+			out, err := OBV(s.close, s.volume)
+			return [][]float64{out}, err
+		},
+		func() (streamUnderTest, error) { return NewOBVStream(), nil },
+		func(st streamUnderTest, s *conformanceSeries, i int) ([]float64, []float64, bool) {
+			x := st.(*OBVStream)
+			return vals(x.Update(s.close[i], s.volume[i])), vals(x.Value()), x.Ready()
+		})
+	add("AD", 0,
+		func(s *conformanceSeries) ([][]float64, error) {
+			out, err := AD(s.high, s.low, s.close, s.volume)
+			return [][]float64{out}, err
+		},
+		func() (streamUnderTest, error) { return NewADStream(), nil },
+		func(st streamUnderTest, s *conformanceSeries, i int) ([]float64, []float64, bool) {
+			x := st.(*ADStream)
+			return vals(x.Update(s.high[i], s.low[i], s.close[i], s.volume[i])), vals(x.Value()), x.Ready()
+		})
+	for _, p := range []int{1, 7} {
+		add(fmt.Sprint("SuperTrend(", p, ",3)"), p,
+			func(s *conformanceSeries) ([][]float64, error) {
+				st, dir, lower, upper, err := SuperTrend(s.high, s.low, s.close, p, 3)
+				return [][]float64{st, dir, lower, upper}, err
+			},
+			func() (streamUnderTest, error) { return NewSuperTrendStream(p, 3) },
+			func(st streamUnderTest, s *conformanceSeries, i int) ([]float64, []float64, bool) {
+				x := st.(*SuperTrendStream)
+				return vals(x.Update(s.high[i], s.low[i], s.close[i])), vals(x.Value()), x.Ready()
+			})
+	}
+	return cases
+}
+
+// TestStreamMatchesBatch 逐根输入价格柱，检查每次 Update 后的值与批量函数一致。
+// 批量函数的计算只依赖之前的价格柱，因此与整段序列上的结果逐点比较；
+// 较短的序列上另外直接与截至当前价格柱的批量计算结果比较。
+func TestStreamMatchesBatch(t *testing.T) {
+	equal := func(got, want float64) bool {
+		return compareSeries([]float64{got}, []float64{want}, conformanceTolerance).firstIdx < 0
+	}
+	for _, c := range streamCases() {
+		for _, s := range conformanceSeriesSet(1) {
+			want, err := c.batch(s)
+			st, serr := c.newStream()
+			if serr != nil {
+				t.Fatal(serr)
+			}
+			for i := range s.close {
+				got, value, ready := c.update(st, s, i)
+				if ready != (i >= c.lookback) {
+					t.Fatalf("%s on %s: index %d ready=%v, lookback %d", c.name, s.name, i, ready, c.lookback)
+				}
+				for k := range got {
+					if !equal(value[k], got[k]) {
+						t.Fatalf("%s on %s: index %d output #%d Value()=%v, Update()=%v", c.name, s.name, i, k, value[k], got[k])
+					}
+				}
+				if err != nil {
+					if ready {
+						t.Fatalf("%s on %s: batch failed (%v) but stream is ready at index %d", c.name, s.name, err, i)
+					}
+					continue
+				}
+				for k := range got {
+					if !equal(got[k], want[k][i]) {
+						t.Fatalf("%s on %s: index %d output #%d want %.17g, got %.17g", c.name, s.name, i, k, want[k][i], got[k])
+					}
+				}
+				if len(s.close) > 40 {
+					continue
+				}
+				prefix := &conformanceSeries{s.name, s.open[:i+1], s.high[:i+1], s.low[:i+1], s.close[:i+1], s.volume[:i+1]}
+				last, err := c.batch(prefix)
+				if err != nil {
+					if ready {
+						t.Fatalf("%s on %s: batch on first %d bars failed (%v) but stream is ready", c.name, s.name, i+1, err)
+					}
+					continue
+				}
+				for k := range got {
+					if !equal(got[k], last[k][i]) {
+						t.Fatalf("%s on %s: after %d bars output #%d want %.17g, got %.17g", c.name, s.name, i+1, k, last[k][i], got[k])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestStreamInvalidParam(t *testing.T) {
+	if _, err := NewMAStream(0, 0); err == nil {
+		t.Error("NewMAStream(0, 0) 期望返回错误")
+	}
+	if _, err := NewMAStream(5, 9); err == nil {
+		t.Error("NewMAStream(5, 9) 期望返回错误")
+	}
+	if _, err := NewRSIStream(1); err == nil {
+		t.Error("NewRSIStream(1) 期望返回错误")
+	}
+	if _, err := NewMACDStream(12, 26, 0); err == nil {
+		t.Error("NewMACDStream(12, 26, 0) 期望返回错误")
+	}
+	if _, err := NewBBandsStream(20, math.Inf(1), 2, 0); err == nil {
+		t.Error("NewBBandsStream 的 nbDevUp 为 +Inf 时期望返回错误")
+	}
+	if _, err := NewSTOCHStream(5, 3, 3, 0, -1); err == nil {
+		t.Error("NewSTOCHStream 的 maTypeD 为 -1 时期望返回错误")
+	}
+	if _, err := NewSuperTrendStream(0, 3); err == nil {
+		t.Error("NewSuperTrendStream(0, 3) 期望返回错误")
+	}
+}
+
+// TestStreamSnapshot 在序列中途保存快照并恢复到零值对象，检查之后的输出与未中断的对象完全相同。
+func TestStreamSnapshot(t *testing.T) {
+	for _, c := range streamCases() {
+		for _, s := range conformanceSeriesSet(2) {
+			for _, format := range []string{"binary", "json"} {
+				orig, err := c.newStream()
+				if err != nil {
+					t.Fatal(err)
+				}
+				cut := len(s.close) / 2
+				for i := 0; i < cut; i++ {
+					c.update(orig, s, i)
+				}
+
+				var data []byte
+				restored := reflect.New(reflect.TypeOf(orig).Elem()).Interface().(streamUnderTest)
+				if format == "binary" {
+					data, err = orig.MarshalBinary()
+					if err == nil {
+						err = restored.UnmarshalBinary(data)
+					}
+				} else {
+					data, err = orig.MarshalJSON()
+					if err == nil {
+						err = restored.UnmarshalJSON(data)
+					}
+				}
+				if err != nil {
+					t.Fatalf("%s on %s (%s): %v", c.name, s.name, format, err)
+				}
+				if restored.Ready() != orig.Ready() {
+					t.Fatalf("%s on %s (%s): Ready() changed after restore", c.name, s.name, format)
+				}
+
+				for i := cut; i < len(s.close); i++ {
+					want, _, _ := c.update(orig, s, i)
+					got, _, _ := c.update(restored, s, i)
+					for k := range want {
+						if math.Float64bits(got[k]) != math.Float64bits(want[k]) {
+							t.Fatalf("%s on %s (%s): index %d output #%d want %v, got %v", c.name, s.name, format, i, k, want[k], got[k])
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestStreamSnapshotErrors(t *testing.T) {
+	st, _ := NewSuperTrendStream(7, 3)
+	s := conformanceSeriesSet(1)[len(conformanceSeriesSet(1))-1]
+	for i := 0; i < 3; i++ {
+		st.Update(s.high[i], s.low[i], s.close[i])
+	}
+	data, err := st.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	js, err := st.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 未就绪时各输出为 NaN，JSON 中写为字符串
+	if !strings.Contains(string(js), `"NaN"`) {
+		t.Errorf("JSON 快照中期望出现 \"NaN\": %s", js)
+	}
+
+	var atr ATRStream
+	if err := atr.UnmarshalBinary(data); !errors.Is(err, ErrStreamState) {
+		t.Errorf("指标类型不符时期望返回 ErrStreamState, 实际 %v", err)
+	}
+	if err := atr.UnmarshalJSON(js); !errors.Is(err, ErrStreamState) {
+		t.Errorf("指标类型不符时期望返回 ErrStreamState, 实际 %v", err)
+	}
+
+	var restored SuperTrendStream
+	for _, bad := range [][]byte{nil, data[:len(data)-1], append(append([]byte(nil), data...), 0), append([]byte{2}, data[1:]...)} {
+		if err := restored.UnmarshalBinary(bad); !errors.Is(err, ErrStreamState) {
+			t.Errorf("损坏的数据 %v 期望返回 ErrStreamState, 实际 %v", bad, err)
+		}
+	}
+	if err := restored.UnmarshalJSON([]byte(`{"version":1,"kind":"SuperTrend"}`)); !errors.Is(err, ErrStreamState) {
+		t.Errorf("缺少字段时期望返回 ErrStreamState, 实际 %v", err)
+	}
+
+	// 环形缓冲区长度与周期不符的状态不能被恢复
+	ma, _ := NewMAStream(5, 0)
+	ma.state.SMA.Window = newRing(3)
+	data, _ = ma.MarshalBinary()
+	if err := new(MAStream).UnmarshalBinary(data); !errors.Is(err, ErrStreamState) {
+		t.Errorf("缓冲区长度不符时期望返回 ErrStreamState, 实际 %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+package go4ta
+
+// SUM 计算最近 timePeriod 根（含当根）的累加和（Summation）。
+//
+// @param close      - 收盘价序列，也可以是成交量等任意序列
+// @param timePeriod - 计算周期（如30）
+// @return []float64 - 累加和序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func SUM(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("SUM", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("SUM", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taSUM(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// SUMLookback 返回 SUM 在给定参数下的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func SUMLookback(timePeriod int) (int, error) {
+	_, err := sumParams(timePeriod)
+	return lookbackResult("TA_SUM", taSUMLookback(timePeriod), err)
+}
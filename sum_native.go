@@ -0,0 +1,50 @@
+package go4ta
+
+// nativeSUM 是 TA_SUM 的原生实现。
+func nativeSUM(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := sumParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intSUM(close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeSUMLookback 对应 TA_SUM_Lookback，参数无效时返回 -1。
+func nativeSUMLookback(timePeriod int) int {
+	timePeriod, err := sumParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// sumParams 按 TA_SUM 的规则处理参数。
+func sumParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_SUM"}
+	timePeriod = c.integer("timePeriod", timePeriod, 30, 2, 100000)
+	return timePeriod, c.err
+}
+
+// intSUM 对应 TA_SUM：滑动窗口内的累加和，先加入新值输出后再减去移出窗口的值。
+func intSUM(in []float64, timePeriod int) (int, []float64) {
+	startIdx := timePeriod - 1
+	if startIdx > len(in)-1 {
+		return 0, nil
+	}
+
+	output := make([]float64, 0, len(in)-startIdx)
+	periodTotal := 0.0
+	trailingIdx := 0
+	i := 0
+	for ; i < startIdx; i++ {
+		periodTotal += in[i]
+	}
+	for ; i < len(in); i++ {
+		periodTotal += in[i]
+		output = append(output, periodTotal)
+		periodTotal -= in[trailingIdx]
+		trailingIdx++
+	}
+	return startIdx, output
+}
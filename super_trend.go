@@ -17,10 +17,10 @@ import (
 // @return err             - 错误信息
 
 // 计算基础上下轨
-func calcSupTrdBasicBands(high, low, atr []float64, multiplier float64, i int) (float64, float64) {
-	hl2 := (high[i] + low[i]) / 2
-	basicUpper := hl2 + multiplier*atr[i]
-	basicLower := hl2 - multiplier*atr[i]
+func calcSupTrdBasicBands(high, low, atr, multiplier float64) (float64, float64) {
+	hl2 := (high + low) / 2
+	basicUpper := hl2 + multiplier*atr
+	basicLower := hl2 - multiplier*atr
 	return basicUpper, basicLower
 }
 
@@ -42,84 +42,126 @@ func calcSupTrdFinalBands(prevFinal, basic float64, isUpper bool) float64 {
 	}
 }
 
-// SuperTrend 主函数
+// SuperTrend 主函数，按位置返回各输出，结果含义见 CalcSuperTrend。
 func SuperTrend(high, low, close []float64, period int, multiplier float64) (superTrend, direction, lowerBand, upperBand []float64, err error) {
-	n := len(close)
-	if n == 0 {
-		return nil, nil, nil, nil, nil
+	r, err := CalcSuperTrend(high, low, close, period, multiplier)
+	return r.Line, r.Direction, r.Lower, r.Upper, err
+}
+
+// CalcSuperTrend 计算 SuperTrend。
+//
+// @param high, low, close  - 价格序列
+// @param period            - ATR周期
+// @param multiplier        - ATR倍数
+// @return SuperTrendResult - 主线、方向与上下轨，与输入等长，回看期（见 SuperTrendLookback）内默认为 NaN（方向为0）
+// @return error            - 错误信息
+func CalcSuperTrend(high, low, close []float64, period int, multiplier float64) (SuperTrendResult, error) {
+	n, err := checkInputs("SuperTrend", "high, low, close", high, low, close)
+	if err != nil || n == 0 {
+		return SuperTrendResult{}, err
 	}
 
-	atr, atrErr := ATR(high, low, close, period)
+	if err := checkParams("SUPERTREND", float64(period), multiplier); err != nil {
+		return SuperTrendResult{}, err
+	}
+
+	outBegIdx, output, atrErr := calcATR(high, low, close, period)
 	if atrErr != nil {
-		return nil, nil, nil, nil, fmt.Errorf("ATR calculation failed: %w", atrErr)
+		return SuperTrendResult{}, fmt.Errorf("ATR calculation failed: %w", atrErr)
 	}
 
+	superTrend, direction, lowerBand, upperBand, begIdx := superTrendFromATR(high, low, close, outBegIdx, output, period, multiplier)
+
+	// superTrendFromATR 按原有方式填充前 begIdx 根，再按当前的填充方式处理
+	w := newWarmup(math.NaN())
+	return SuperTrendResult{
+		Line:      w.refill(superTrend, begIdx),
+		Direction: w.refill(direction, begIdx),
+		Lower:     w.refill(lowerBand, begIdx),
+		Upper:     w.refill(upperBand, begIdx),
+	}, nil
+}
+
+// SuperTrendLookback 返回 SuperTrend 的回看期，与 ATR 相同，默认情况下结果序列开头的这些位置为 NaN。
+// SuperTrend 没有默认周期，period 须为正数。
+func SuperTrendLookback(period int) (int, error) {
+	if period < 1 {
+		return 0, badParam("SuperTrend", "period", period)
+	}
+	return ATRLookback(period)
+}
+
+// superTrendFromATR 根据 ATR 的计算结果（从 atrBegIdx 开始紧凑排列）计算 SuperTrend 各输出。
+// 第一个有效值位于 begIdx，即 period 与 ATR 起始下标中较晚的一个，设置了 ATR 的不稳定期时为后者；
+// ATR 没有有效值时 begIdx 为输入长度。
+func superTrendFromATR(high, low, close []float64, atrBegIdx int, atr []float64, period int, multiplier float64) (superTrend, direction, lowerBand, upperBand []float64, begIdx int) {
+	n := len(close)
+	begIdx = n
+	if len(atr) > 0 {
+		begIdx = min(max(period, atrBegIdx), n)
+	}
 	superTrend = make([]float64, n)
 	direction = make([]float64, n)
-	finalLowerBand := make([]float64, n)
-	finalUpperBand := make([]float64, n)
 	lowerBand = make([]float64, n)
 	upperBand = make([]float64, n)
 
 	for i := 0; i < n; i++ {
 		superTrend[i] = math.NaN()
 		direction[i] = 0
-		finalLowerBand[i] = math.NaN()
-		finalUpperBand[i] = math.NaN()
 		lowerBand[i] = math.NaN()
 		upperBand[i] = math.NaN()
 	}
 
-	for i := 0; i < n; i++ {
-		if i < period || math.IsNaN(atr[i]) {
-			// ATR未满周期，全部为NaN/0
+	bands := superTrendBands{Multiplier: multiplier}
+	for i := begIdx; i < n; i++ {
+		v := atr[i-atrBegIdx]
+		if math.IsNaN(v) {
 			continue
 		}
+		superTrend[i], lowerBand[i], upperBand[i] = bands.next(high[i], low[i], close[i], v, i == begIdx)
+		direction[i] = bands.Direction
+	}
 
-		basicUpper, basicLower := calcSupTrdBasicBands(high, low, atr, multiplier, i)
-		if i == period {
-			finalLowerBand[i] = basicLower
-			finalUpperBand[i] = basicUpper
-		} else {
-			finalLowerBand[i] = calcSupTrdFinalBands(finalLowerBand[i-1], basicLower, false)
-			finalUpperBand[i] = calcSupTrdFinalBands(finalUpperBand[i-1], basicUpper, true)
-		}
-
-		prevDir := 0
-		if i > 0 {
-			prevDir = int(direction[i-1])
-		}
+	return superTrend, direction, lowerBand, upperBand, begIdx
+}
 
-		// 默认方向为0，只有趋势成立后才赋值
-		direction[i] = float64(prevDir)
+// superTrendBands 保存逐根递推所需的最终上下轨与当前方向。
+type superTrendBands struct {
+	Multiplier float64
+	FinalLower float64
+	FinalUpper float64
+	Direction  float64
+}
 
-		if prevDir == 0 {
-			// 第一个有效点，方向初始化为1
-			direction[i] = 1
-		} else if prevDir == 1 && close[i] < finalLowerBand[i] {
-			direction[i] = -1
-		} else if prevDir == -1 && close[i] > finalUpperBand[i] {
-			direction[i] = 1
-		}
+// next 用当前价格柱和 ATR 推进一根，first 表示这是 ATR 的第一个有效值。
+func (b *superTrendBands) next(high, low, close, atr float64, first bool) (superTrend, lowerBand, upperBand float64) {
+	basicUpper, basicLower := calcSupTrdBasicBands(high, low, atr, b.Multiplier)
+	if first {
+		b.FinalLower = basicLower
+		b.FinalUpper = basicUpper
+	} else {
+		b.FinalLower = calcSupTrdFinalBands(b.FinalLower, basicLower, false)
+		b.FinalUpper = calcSupTrdFinalBands(b.FinalUpper, basicUpper, true)
+	}
 
-		// 只有方向为1或-1时才赋值主线和上下轨，否则为NaN
-		switch direction[i] {
-		case 1:
-			superTrend[i] = finalLowerBand[i]
-			lowerBand[i] = finalLowerBand[i]
-			upperBand[i] = math.NaN()
-			finalUpperBand[i] = math.NaN()
-		case -1:
-			superTrend[i] = finalUpperBand[i]
-			upperBand[i] = finalUpperBand[i]
-			lowerBand[i] = math.NaN()
-			finalLowerBand[i] = math.NaN()
-		default:
-			superTrend[i] = math.NaN()
-			lowerBand[i] = math.NaN()
-			upperBand[i] = math.NaN()
-		}
+	prevDir := int(b.Direction)
+	if prevDir == 0 {
+		// 第一个有效点，方向初始化为1
+		b.Direction = 1
+	} else if prevDir == 1 && close < b.FinalLower {
+		b.Direction = -1
+	} else if prevDir == -1 && close > b.FinalUpper {
+		b.Direction = 1
 	}
 
-	return superTrend, direction, lowerBand, upperBand, nil
+	// 只有方向为1或-1时才赋值主线和上下轨，否则为NaN
+	switch b.Direction {
+	case 1:
+		b.FinalUpper = math.NaN()
+		return b.FinalLower, b.FinalLower, math.NaN()
+	case -1:
+		b.FinalLower = math.NaN()
+		return b.FinalUpper, math.NaN(), b.FinalUpper
+	}
+	return math.NaN(), math.NaN(), math.NaN()
 }
@@ -0,0 +1,89 @@
+package go4ta
+
+import "math"
+
+// SuperTrendStream 是 SuperTrend 的流式版本。
+type SuperTrendStream struct {
+	state                               superTrendState
+	superTrend, direction, lower, upper float64
+	ready                               bool
+}
+
+// NewSuperTrendStream 创建 SuperTrend 流式指标。
+//
+// @param period             - ATR周期
+// @param multiplier         - ATR倍数
+// @return *SuperTrendStream - 流式指标
+// @return error             - 参数无效时返回错误
+func NewSuperTrendStream(period int, multiplier float64) (*SuperTrendStream, error) {
+	if period < 1 || period > 100000 {
+		return nil, badParam("NewSuperTrendStream", "period", period)
+	}
+	s := &SuperTrendStream{state: superTrendState{
+		Period: period,
+		ATR:    atrState{Period: period},
+		Bands:  superTrendBands{Multiplier: multiplier},
+	}}
+	s.superTrend, s.lower, s.upper = math.NaN(), math.NaN(), math.NaN()
+	return s, nil
+}
+
+// Update 输入一根新的价格柱，返回最新的主线、方向、下轨、上轨。
+// 未就绪时与批量函数一样，主线和上下轨为 NaN，方向为 0。
+func (s *SuperTrendStream) Update(high, low, close float64) (superTrend, direction, lowerBand, upperBand float64) {
+	s.superTrend, s.direction, s.lower, s.upper, s.ready = s.state.update(high, low, close)
+	return s.Value()
+}
+
+// Value 返回最近一次 Update 的结果。
+func (s *SuperTrendStream) Value() (superTrend, direction, lowerBand, upperBand float64) {
+	return s.superTrend, s.direction, s.lower, s.upper
+}
+
+// Ready 表示当前值是否已越过 ATR 的回看期。
+func (s *SuperTrendStream) Ready() bool {
+	return s.ready
+}
+
+func (s *SuperTrendStream) snapshot() *streamSnapshot {
+	return &streamSnapshot{kind: "SuperTrend", values: []*float64{&s.superTrend, &s.direction, &s.lower, &s.upper}, ready: &s.ready, state: &s.state}
+}
+
+// MarshalBinary 将完整的计算状态编码为二进制快照。
+func (s *SuperTrendStream) MarshalBinary() ([]byte, error) {
+	return s.snapshot().marshalBinary()
+}
+
+// UnmarshalBinary 从 MarshalBinary 生成的快照恢复状态。
+func (s *SuperTrendStream) UnmarshalBinary(data []byte) error {
+	return s.snapshot().unmarshalBinary(data)
+}
+
+// MarshalJSON 将完整的计算状态编码为 JSON 快照。
+func (s *SuperTrendStream) MarshalJSON() ([]byte, error) {
+	return s.snapshot().marshalJSON()
+}
+
+// UnmarshalJSON 从 MarshalJSON 生成的快照恢复状态。
+func (s *SuperTrendStream) UnmarshalJSON(data []byte) error {
+	return s.snapshot().unmarshalJSON(data)
+}
+
+// superTrendState 在 ATR 的增量状态之上推进 superTrendBands。
+type superTrendState struct {
+	Period int
+	Count  int
+	ATR    atrState
+	Bands  superTrendBands
+}
+
+func (s *superTrendState) update(high, low, close float64) (superTrend, direction, lowerBand, upperBand float64, ok bool) {
+	today := s.Count
+	s.Count++
+	atr, ok := s.ATR.update(high, low, close)
+	if today < s.Period || !ok {
+		return math.NaN(), 0, math.NaN(), math.NaN(), false
+	}
+	superTrend, lowerBand, upperBand = s.Bands.next(high, low, close, atr, today == s.Period)
+	return superTrend, s.Bands.Direction, lowerBand, upperBand, true
+}
@@ -100,4 +100,52 @@ func TestSuperTrend(t *testing.T) {
 			t.Errorf("UpperBand[%d]: 期望 %.2f, 实际 %.2f", i, expUpper[i], upper[i])
 		}
 	}
-}
\ No newline at end of file
+}
+// TestSuperTrendUnstablePeriod 检查 ATR 设置了不稳定期时 SuperTrend 从 ATR 的第一个有效值开始，
+// 结果的起始下标与 SuperTrendLookback 一致。
+func TestSuperTrendUnstablePeriod(t *testing.T) {
+	resetSettings(t)
+	b := testBars()
+	n := b.Len()
+
+	for _, c := range []struct {
+		id   FuncUnstID
+		want int
+	}{{FuncUnstATR, 12}, {FuncUnstAll, 14}} {
+		if err := Initialize(); err != nil {
+			t.Fatal(err)
+		}
+		if err := SetUnstablePeriod(c.id, c.want-7); err != nil {
+			t.Fatal(err)
+		}
+		lookback, err := SuperTrendLookback(7)
+		if err != nil || lookback != c.want {
+			t.Fatalf("SuperTrendLookback(7) = %d, %v, want %d", lookback, err, c.want)
+		}
+		r, err := CalcSuperTrend(b.High, b.Low, b.Close, 7, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range lookback {
+			if !math.IsNaN(r.Line[i]) || r.Direction[i] != 0 {
+				t.Fatalf("SuperTrend[%d] = %v, direction %v within lookback %d", i, r.Line[i], r.Direction[i], lookback)
+			}
+		}
+		// 第一个有效值以 ATR 的第一个有效值初始化下轨，方向为1
+		atr, _ := ATR(b.High, b.Low, b.Close, 7)
+		hl2 := (b.High[lookback] + b.Low[lookback]) / 2
+		if r.Direction[lookback] != 1 || math.Abs(r.Lower[lookback]-(hl2-3*atr[lookback])) > 1e-9 {
+			t.Errorf("SuperTrend at %d = %+v, want direction 1 and lower %v", lookback, r.At(lookback), hl2-3*atr[lookback])
+		}
+
+		withFillPolicy(t, FillTrim, func() {
+			trimmed, err := CalcSuperTrend(b.High, b.Low, b.Close, 7, 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if begIdx := BeginIndex(n, trimmed.Line); begIdx != lookback || trimmed.Direction[0] != 1 || len(trimmed.Upper) != n-lookback {
+				t.Errorf("SuperTrend with FillTrim: begin index %d, direction %v, want %d and 1", begIdx, trimmed.Direction[0], lookback)
+			}
+		})
+	}
+}
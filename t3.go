@@ -0,0 +1,39 @@
+package go4ta
+
+// T3 计算 Tillson 三倍平滑移动平均线（T3）。vFactor 为 0.7 时与 MA(close, timePeriod, MATypeT3) 相同。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如5），不小于2
+// @param vFactor    - 成交量因子，取值 0..1，越大越平滑、滞后越多（如0.7）
+// @return []float64 - T3结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func T3(close []float64, timePeriod int, vFactor float64) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("T3", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("T3", float64(timePeriod), vFactor); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taT3(close, timePeriod, vFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// T3Lookback 返回 T3 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @param vFactor    - 成交量因子
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func T3Lookback(timePeriod int, vFactor float64) (int, error) {
+	_, _, err := t3Params(timePeriod, vFactor)
+	return lookbackResult("TA_T3", taT3Lookback(timePeriod, vFactor), err)
+}
@@ -0,0 +1,47 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taT3 调用 TA_T3。
+func taT3(close []float64, timePeriod int, vFactor float64) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_T3(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		C.double(vFactor),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, paramErr := t3Params(timePeriod, vFactor)
+		return 0, nil, taErr("TA_T3", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taT3Lookback 调用 TA_T3_Lookback，参数无效时返回 -1。
+func taT3Lookback(timePeriod int, vFactor float64) int {
+	defer readSettings()()
+	return int(C.TA_T3_Lookback(C.int(timePeriod), C.double(vFactor)))
+}
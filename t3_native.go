@@ -0,0 +1,28 @@
+package go4ta
+
+// nativeT3 是 TA_T3 的原生实现，计算见 ma_native.go 中的 intT3。
+func nativeT3(close []float64, timePeriod int, vFactor float64) (int, []float64, error) {
+	timePeriod, vFactor, err := t3Params(timePeriod, vFactor)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intT3(close, timePeriod, vFactor)
+	return outBegIdx, output, nil
+}
+
+// nativeT3Lookback 对应 TA_T3_Lookback，参数无效时返回 -1。
+func nativeT3Lookback(timePeriod int, vFactor float64) int {
+	timePeriod, _, err := t3Params(timePeriod, vFactor)
+	if err != nil {
+		return -1
+	}
+	return t3Lookback(timePeriod)
+}
+
+// t3Params 按 TA_T3 的规则处理参数。
+func t3Params(timePeriod int, vFactor float64) (int, float64, error) {
+	c := paramCheck{fn: "TA_T3"}
+	timePeriod = c.integer("timePeriod", timePeriod, 5, 2, 100000)
+	vFactor = c.real("vFactor", vFactor, 0.7, 0, 1)
+	return timePeriod, vFactor, c.err
+}
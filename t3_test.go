@@ -0,0 +1,53 @@
+package go4ta
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestT3(t *testing.T) {
+	close := testBars().Close
+
+	// vFactor 为 0.7 时与 MA 的 T3 类型一致
+	want, err := MA(close, 5, MATypeT3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := T3(close, 5, 0.7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalFloats(got, want) {
+		t.Errorf("T3(5, 0.7) differs from MA(5, MATypeT3)")
+	}
+
+	// vFactor 为 0 时 T3 即第三层 EMA，结果应不同且仍在价格范围内
+	zero, err := T3(close, 5, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lookback, err := T3Lookback(5, 0)
+	if err != nil || lookback != 24 {
+		t.Fatalf("T3Lookback(5, 0) = %d, %v, want 24", lookback, err)
+	}
+	if equalFloats(zero, got) {
+		t.Error("vFactor has no effect")
+	}
+	lo, hi := close[0], close[0]
+	for _, v := range close {
+		lo, hi = min(lo, v), max(hi, v)
+	}
+	for i := lookback; i < len(zero); i++ {
+		if zero[i] < lo || zero[i] > hi {
+			t.Errorf("T3[%d] = %f outside [%f, %f]", i, zero[i], lo, hi)
+		}
+	}
+
+	var fe *FuncError
+	if _, err := T3(close, 5, 1.5); !errors.As(err, &fe) || fe.Func != "TA_T3" || fe.Param != "vFactor" {
+		t.Errorf("T3(vFactor 1.5) = %v", err)
+	}
+	if _, err := T3Lookback(1, 0.7); !errors.Is(err, ErrBadParam) {
+		t.Errorf("T3Lookback(1) = %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+package go4ta
+
+// TEMA 计算三指数移动平均线（TEMA），与 MA(close, timePeriod, MATypeTEMA) 相同，但周期须不小于2。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如30）
+// @return []float64 - TEMA结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func TEMA(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("TEMA", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("TEMA", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taTEMA(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// TEMALookback 返回 TEMA 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func TEMALookback(timePeriod int) (int, error) {
+	_, err := temaParams(timePeriod)
+	return lookbackResult("TA_TEMA", taTEMALookback(timePeriod), err)
+}
@@ -0,0 +1,27 @@
+package go4ta
+
+// nativeTEMA 是 TA_TEMA 的原生实现，计算见 ma_native.go 中的 intTEMA。
+func nativeTEMA(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := temaParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intTEMA(close, 0, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeTEMALookback 对应 TA_TEMA_Lookback，参数无效时返回 -1。
+func nativeTEMALookback(timePeriod int) int {
+	timePeriod, err := temaParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return emaLookback(timePeriod) * 3
+}
+
+// temaParams 按 TA_TEMA 的规则处理参数。
+func temaParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_TEMA"}
+	timePeriod = c.integer("timePeriod", timePeriod, 30, 2, 100000)
+	return timePeriod, c.err
+}
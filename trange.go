@@ -0,0 +1,31 @@
+package go4ta
+
+// TRANGE 计算真实波幅（True Range）：当根最高价与最低价之差、最高价与前收盘价之差、最低价与前收盘价之差
+// 三者绝对值的最大值。第一根没有前收盘价，不输出。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @return []float64 - 真实波幅序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func TRANGE(high, low, close []float64) ([]float64, error) {
+	n, err := checkInputs("TRANGE", "high, low, close", high, low, close)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	outBegIdx, output, err := taTRANGE(high, low, close)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// TRANGELookback 返回 TRANGE 的回看期。第一根价格柱没有前收盘价，因此恒为 1。
+func TRANGELookback() int {
+	return taTRANGELookback()
+}
@@ -0,0 +1,12 @@
+package go4ta
+
+// nativeTRANGE 是 TA_TRANGE 的原生实现，计算见 atr_native.go 中的 intTRANGE。
+func nativeTRANGE(high, low, close []float64) (int, []float64, error) {
+	outBegIdx, output := intTRANGE(high, low, close)
+	return outBegIdx, output, nil
+}
+
+// nativeTRANGELookback 对应 TA_TRANGE_Lookback。
+func nativeTRANGELookback() int {
+	return 1
+}
@@ -0,0 +1,68 @@
+package go4ta
+
+import (
+	"errors"
+	"testing"
+)
+
+// 以下参考数据由逐根按定义直接计算得到：Aroon 在最近 timePeriod+1 根中取最近一次出现的极值，
+// Vortex 的真实波幅以前一根收盘价计算。
+
+func TestAroon(t *testing.T) {
+	g := readGolden(t, "aroon.csv")
+	r, err := CalcAroon(g["High"], g["Low"], 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "AroonDown", r.Down, g["AroonDown_14"])
+	checkGolden(t, "AroonUp", r.Up, g["AroonUp_14"])
+	down, up, err := Aroon(g["High"], g["Low"], 25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "AroonDown_25", down, g["AroonDown_25"])
+	checkGolden(t, "AroonUp_25", up, g["AroonUp_25"])
+
+	osc, err := AroonOsc(g["High"], g["Low"], 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "AroonOsc", osc, g["AroonOsc_14"])
+
+	if lookback, err := AroonLookback(14); err != nil || lookback != 14 {
+		t.Errorf("AroonLookback(14) = %d, %v", lookback, err)
+	}
+	if lookback, err := AroonOscLookback(14); err != nil || lookback != 14 {
+		t.Errorf("AroonOscLookback(14) = %d, %v", lookback, err)
+	}
+	var fe *FuncError
+	if _, err := AroonOsc(g["High"], g["Low"], 1); !errors.As(err, &fe) || fe.Param != "timePeriod" {
+		t.Errorf("AroonOsc(1) = %v", err)
+	}
+	if r, err := CalcAroon(nil, nil, 14); err != nil || r.Len() != 0 {
+		t.Errorf("CalcAroon(empty) = %v, %v", r, err)
+	}
+}
+
+func TestVortex(t *testing.T) {
+	g := readGolden(t, "vortex.csv")
+	r, err := CalcVortex(g["High"], g["Low"], g["Close"], 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "VI+", r.Plus, g["VI+_14"])
+	checkGolden(t, "VI-", r.Minus, g["VI-_14"])
+
+	if lookback, err := VortexLookback(14); err != nil || lookback != 14 {
+		t.Errorf("VortexLookback(14) = %d, %v", lookback, err)
+	}
+
+	// 价格不变时真实波幅之和为0，结果为0
+	flat := []float64{10, 10, 10, 10}
+	if plus, minus, err := Vortex(flat, flat, flat, 2); err != nil || plus[3] != 0 || minus[3] != 0 {
+		t.Errorf("Vortex(flat) = %v, %v, %v", plus, minus, err)
+	}
+	if _, err := CalcVortex(g["High"], g["Low"], g["Close"][:10], 14); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("CalcVortex with short close = %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+package go4ta
+
+// TRIMA 计算三角移动平均线（TRIMA），与 MA(close, timePeriod, MATypeTRIMA) 相同，但周期须不小于2。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如30）
+// @return []float64 - TRIMA结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func TRIMA(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("TRIMA", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("TRIMA", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taTRIMA(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// TRIMALookback 返回 TRIMA 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func TRIMALookback(timePeriod int) (int, error) {
+	_, err := trimaParams(timePeriod)
+	return lookbackResult("TA_TRIMA", taTRIMALookback(timePeriod), err)
+}
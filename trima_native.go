@@ -0,0 +1,27 @@
+package go4ta
+
+// nativeTRIMA 是 TA_TRIMA 的原生实现，计算见 ma_native.go 中的 intTRIMA。
+func nativeTRIMA(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := trimaParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intTRIMA(close, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeTRIMALookback 对应 TA_TRIMA_Lookback，参数无效时返回 -1。
+func nativeTRIMALookback(timePeriod int) int {
+	timePeriod, err := trimaParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// trimaParams 按 TA_TRIMA 的规则处理参数。
+func trimaParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_TRIMA"}
+	timePeriod = c.integer("timePeriod", timePeriod, 30, 2, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,44 @@
+package go4ta
+
+// nativeTRIX 是 TA_TRIX 的原生实现：三重 EMA 的一期变化率。
+func nativeTRIX(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := trixParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	k := perToK(timePeriod)
+	firstBegIdx, firstEMA := intEMA(close, emaLookback(timePeriod), timePeriod, k)
+	if len(firstEMA) == 0 {
+		return 0, nil, nil
+	}
+	secondBegIdx, secondEMA := intEMA(firstEMA, 0, timePeriod, k)
+	if len(secondEMA) == 0 {
+		return 0, nil, nil
+	}
+	thirdBegIdx, thirdEMA := intEMA(secondEMA, 0, timePeriod, k)
+	if len(thirdEMA) == 0 {
+		return 0, nil, nil
+	}
+	rocBegIdx, output := intROC(thirdEMA, 1, rocValue)
+	if len(output) == 0 {
+		return 0, nil, nil
+	}
+	return firstBegIdx + secondBegIdx + thirdBegIdx + rocBegIdx, output, nil
+}
+
+// nativeTRIXLookback 对应 TA_TRIX_Lookback，参数无效时返回 -1。
+func nativeTRIXLookback(timePeriod int) int {
+	timePeriod, err := trixParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return emaLookback(timePeriod)*3 + 1
+}
+
+// trixParams 按 TA_TRIX 的规则处理参数。
+func trixParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_TRIX"}
+	timePeriod = c.integer("timePeriod", timePeriod, 30, 1, 100000)
+	return timePeriod, c.err
+}
@@ -0,0 +1,38 @@
+package go4ta
+
+// TSF 计算时间序列预测（Time Series Forecast），即把回归直线延伸到下一根价格柱的取值，
+// 等于 LinearReg 加上 LinearRegSlope。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - 预测值序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func TSF(close []float64, timePeriod int) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("TSF", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("TSF", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taTSF(close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// TSFLookback 返回 TSF 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func TSFLookback(timePeriod int) (int, error) {
+	_, err := tsfParams(timePeriod)
+	return lookbackResult("TA_TSF", taTSFLookback(timePeriod), err)
+}
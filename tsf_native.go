@@ -0,0 +1,29 @@
+package go4ta
+
+// nativeTSF 是 TA_TSF 的原生实现。
+func nativeTSF(close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := tsfParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+	begIdx, output := intLinearReg(close, timePeriod, func(m, b float64) float64 {
+		return b + m*float64(timePeriod)
+	})
+	return begIdx, output, nil
+}
+
+// nativeTSFLookback 对应 TA_TSF_Lookback，参数无效时返回 -1。
+func nativeTSFLookback(timePeriod int) int {
+	timePeriod, err := tsfParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// tsfParams 按 TA_TSF 的规则处理参数。
+func tsfParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_TSF"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
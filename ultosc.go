@@ -0,0 +1,60 @@
+package go4ta
+
+// ULTOSC 计算终极振荡指标（Ultimate Oscillator），取值在 0 到 100 之间。
+// 三个周期的顺序不影响结果：最短周期的权重为4，其次为2，最长为1。
+//
+// @param high        - 最高价序列
+// @param low         - 最低价序列
+// @param close       - 收盘价序列
+// @param timePeriod1 - 第一个周期（如7）
+// @param timePeriod2 - 第二个周期（如14）
+// @param timePeriod3 - 第三个周期（如28）
+// @return []float64  - ULTOSC 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error      - 如果输入数据无效或 C 库调用失败，则返回错误。
+func ULTOSC(high, low, close []float64, timePeriod1, timePeriod2, timePeriod3 int) ([]float64, error) {
+	n, err := checkInputs("ULTOSC", "high, low, close", high, low, close)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if longest, param := ultoscLongest(timePeriod1, timePeriod2, timePeriod3); n < longest {
+		return nil, tooShort("ULTOSC", n, param, longest)
+	}
+
+	if err := checkParams("ULTOSC", float64(timePeriod1), float64(timePeriod2), float64(timePeriod3)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taULTOSC(high, low, close, timePeriod1, timePeriod2, timePeriod3)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// ULTOSCLookback 返回 ULTOSC 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod1 - 第一个周期
+// @param timePeriod2 - 第二个周期
+// @param timePeriod3 - 第三个周期
+// @return int        - 回看期
+// @return error      - 参数无效时返回错误
+func ULTOSCLookback(timePeriod1, timePeriod2, timePeriod3 int) (int, error) {
+	_, _, _, err := ultoscParams(timePeriod1, timePeriod2, timePeriod3)
+	return lookbackResult("TA_ULTOSC", taULTOSCLookback(timePeriod1, timePeriod2, timePeriod3), err)
+}
+
+// ultoscLongest 返回三个周期中最长的一个及其参数名，输入至少要有这么多根价格柱。
+func ultoscLongest(timePeriod1, timePeriod2, timePeriod3 int) (int, string) {
+	longest, param := timePeriod1, "timePeriod1"
+	if timePeriod2 > longest {
+		longest, param = timePeriod2, "timePeriod2"
+	}
+	if timePeriod3 > longest {
+		longest, param = timePeriod3, "timePeriod3"
+	}
+	return longest, param
+}
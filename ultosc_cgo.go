@@ -0,0 +1,52 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taULTOSC 调用 TA_ULTOSC。
+func taULTOSC(high, low, close []float64, timePeriod1, timePeriod2, timePeriod3 int) (int, []float64, error) {
+	defer readSettings()()
+	cHigh := (*C.double)(unsafe.Pointer(&high[0]))
+	cLow := (*C.double)(unsafe.Pointer(&low[0]))
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(high))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_ULTOSC(
+		0,
+		C.int(len(high)-1),
+		cHigh,
+		cLow,
+		cClose,
+		C.int(timePeriod1),
+		C.int(timePeriod2),
+		C.int(timePeriod3),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, _, paramErr := ultoscParams(timePeriod1, timePeriod2, timePeriod3)
+		return 0, nil, taErr("TA_ULTOSC", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taULTOSCLookback 调用 TA_ULTOSC_Lookback，参数无效时返回 -1。
+func taULTOSCLookback(timePeriod1, timePeriod2, timePeriod3 int) int {
+	defer readSettings()()
+	return int(C.TA_ULTOSC_Lookback(C.int(timePeriod1), C.int(timePeriod2), C.int(timePeriod3)))
+}
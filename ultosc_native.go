@@ -0,0 +1,110 @@
+package go4ta
+
+import (
+	"math"
+	"slices"
+)
+
+// nativeULTOSC 是 TA_ULTOSC 的原生实现。与 TA-Lib 相同，三个周期先按从短到长排序，
+// 最短周期的权重为4，其次为2，最长为1。
+func nativeULTOSC(high, low, close []float64, timePeriod1, timePeriod2, timePeriod3 int) (int, []float64, error) {
+	timePeriod1, timePeriod2, timePeriod3, err := ultoscParams(timePeriod1, timePeriod2, timePeriod3)
+	if err != nil {
+		return 0, nil, err
+	}
+	periods := []int{timePeriod1, timePeriod2, timePeriod3}
+	slices.Sort(periods)
+	timePeriod1, timePeriod2, timePeriod3 = periods[0], periods[1], periods[2]
+
+	startIdx := timePeriod3
+	if startIdx > len(high)-1 {
+		return 0, nil, nil
+	}
+
+	// terms 返回第 day 根的收盘价与真实低点之差，以及真实波幅
+	terms := func(day int) (float64, float64) {
+		tempLT := low[day]
+		tempHT := high[day]
+		tempCY := close[day-1]
+		trueLow := min(tempLT, tempCY)
+		closeMinusTrueLow := close[day] - trueLow
+		trueRange := tempHT - tempLT
+		if tempDouble := math.Abs(tempCY - tempHT); tempDouble > trueRange {
+			trueRange = tempDouble
+		}
+		if tempDouble := math.Abs(tempCY - tempLT); tempDouble > trueRange {
+			trueRange = tempDouble
+		}
+		return closeMinusTrueLow, trueRange
+	}
+	prime := func(period int) (aTotal, bTotal float64) {
+		for i := startIdx - period + 1; i < startIdx; i++ {
+			a, b := terms(i)
+			aTotal += a
+			bTotal += b
+		}
+		return aTotal, bTotal
+	}
+	a1Total, b1Total := prime(timePeriod1)
+	a2Total, b2Total := prime(timePeriod2)
+	a3Total, b3Total := prime(timePeriod3)
+
+	output := make([]float64, 0, len(high)-startIdx)
+	trailingIdx1 := startIdx - timePeriod1 + 1
+	trailingIdx2 := startIdx - timePeriod2 + 1
+	trailingIdx3 := startIdx - timePeriod3 + 1
+	for today := startIdx; today < len(high); today++ {
+		a, b := terms(today)
+		a1Total += a
+		a2Total += a
+		a3Total += a
+		b1Total += b
+		b2Total += b
+		b3Total += b
+
+		value := 0.0
+		if !isZero(b1Total) {
+			value += 4.0 * (a1Total / b1Total)
+		}
+		if !isZero(b2Total) {
+			value += 2.0 * (a2Total / b2Total)
+		}
+		if !isZero(b3Total) {
+			value += a3Total / b3Total
+		}
+
+		a, b = terms(trailingIdx1)
+		a1Total -= a
+		b1Total -= b
+		a, b = terms(trailingIdx2)
+		a2Total -= a
+		b2Total -= b
+		a, b = terms(trailingIdx3)
+		a3Total -= a
+		b3Total -= b
+		trailingIdx1++
+		trailingIdx2++
+		trailingIdx3++
+
+		output = append(output, 100.0*(value/7.0))
+	}
+	return startIdx, output, nil
+}
+
+// nativeULTOSCLookback 对应 TA_ULTOSC_Lookback，参数无效时返回 -1。
+func nativeULTOSCLookback(timePeriod1, timePeriod2, timePeriod3 int) int {
+	timePeriod1, timePeriod2, timePeriod3, err := ultoscParams(timePeriod1, timePeriod2, timePeriod3)
+	if err != nil {
+		return -1
+	}
+	return max(timePeriod1, timePeriod2, timePeriod3)
+}
+
+// ultoscParams 按 TA_ULTOSC 的规则处理参数。
+func ultoscParams(timePeriod1, timePeriod2, timePeriod3 int) (int, int, int, error) {
+	c := paramCheck{fn: "TA_ULTOSC"}
+	timePeriod1 = c.integer("timePeriod1", timePeriod1, 7, 1, 100000)
+	timePeriod2 = c.integer("timePeriod2", timePeriod2, 14, 1, 100000)
+	timePeriod3 = c.integer("timePeriod3", timePeriod3, 28, 1, 100000)
+	return timePeriod1, timePeriod2, timePeriod3, c.err
+}
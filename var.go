@@ -0,0 +1,40 @@
+package go4ta
+
+// VAR 计算方差（Variance），即 STDDEV 的平方，按总体方差计算（除以 timePeriod）。
+// 与 TA-Lib 相同，nbDev 只做检查，不参与计算。
+//
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如5），可以为1，此时结果为0
+// @param nbDev      - 倍数，保留 TA-Lib 的参数，通常为1.0
+// @return []float64 - 方差结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func VAR(close []float64, timePeriod int, nbDev float64) ([]float64, error) {
+	if len(close) == 0 {
+		return []float64{}, nil
+	}
+	if len(close) < timePeriod {
+		return nil, tooShort("VAR", len(close), "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("VAR", float64(timePeriod), nbDev); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taVAR(close, timePeriod, nbDev)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(len(close), outBegIdx, output), nil
+}
+
+// VARLookback 返回 VAR 在给定参数下的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @param nbDev      - 倍数
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func VARLookback(timePeriod int, nbDev float64) (int, error) {
+	_, _, err := varParams(timePeriod, nbDev)
+	return lookbackResult("TA_VAR", taVARLookback(timePeriod, nbDev), err)
+}
@@ -0,0 +1,47 @@
+//go:build cgo && !purego
+
+package go4ta
+
+/*
+#cgo LDFLAGS: -lta-lib -lm
+#include <ta-lib/ta_libc.h>
+#include <ta-lib/ta_func.h>
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// taVAR 调用 TA_VAR。
+func taVAR(close []float64, timePeriod int, nbDev float64) (int, []float64, error) {
+	defer readSettings()()
+	cClose := (*C.double)(unsafe.Pointer(&close[0]))
+	output := make([]C.double, len(close))
+	cOutput := (*C.double)(unsafe.Pointer(&output[0]))
+
+	outBegIdx := C.int(0)
+	outNBElement := C.int(0)
+
+	retCode := C.TA_VAR(
+		0,
+		C.int(len(close)-1),
+		cClose,
+		C.int(timePeriod),
+		C.double(nbDev),
+		&outBegIdx,
+		&outNBElement,
+		cOutput,
+	)
+
+	if retCode != C.TA_SUCCESS {
+		_, _, paramErr := varParams(timePeriod, nbDev)
+		return 0, nil, taErr("TA_VAR", RetCode(retCode), paramErr)
+	}
+
+	return int(outBegIdx), fromC(output, outNBElement), nil
+}
+
+// taVARLookback 调用 TA_VAR_Lookback，参数无效时返回 -1。
+func taVARLookback(timePeriod int, nbDev float64) int {
+	defer readSettings()()
+	return int(C.TA_VAR_Lookback(C.int(timePeriod), C.double(nbDev)))
+}
@@ -0,0 +1,28 @@
+package go4ta
+
+// nativeVAR 是 TA_VAR 的原生实现。与 TA-Lib 相同，nbDev 只做检查，不参与计算。
+func nativeVAR(close []float64, timePeriod int, nbDev float64) (int, []float64, error) {
+	timePeriod, _, err := varParams(timePeriod, nbDev)
+	if err != nil {
+		return 0, nil, err
+	}
+	outBegIdx, output := intVAR(close, 0, timePeriod)
+	return outBegIdx, output, nil
+}
+
+// nativeVARLookback 对应 TA_VAR_Lookback，参数无效时返回 -1。
+func nativeVARLookback(timePeriod int, nbDev float64) int {
+	timePeriod, _, err := varParams(timePeriod, nbDev)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// varParams 按 TA_VAR 的规则处理参数。与 STDDEV 不同，timePeriod 可以为1。
+func varParams(timePeriod int, nbDev float64) (int, float64, error) {
+	c := paramCheck{fn: "TA_VAR"}
+	timePeriod = c.integer("timePeriod", timePeriod, 5, 1, 100000)
+	nbDev = c.real("nbDev", nbDev, 1.0, taRealMin, taRealMax)
+	return timePeriod, nbDev, c.err
+}
@@ -0,0 +1,93 @@
+package go4ta
+
+import (
+	"errors"
+	"testing"
+)
+
+// 以下参考数据由逐根按定义直接计算得到：ATR 以前 timePeriod 个真实波幅的平均为种子按 Wilder 方式平滑，
+// EMA 以前 timePeriod 个值的平均为种子。
+
+func TestNATR(t *testing.T) {
+	g := readGolden(t, "natr.csv")
+	tr, err := TRANGE(g["High"], g["Low"], g["Close"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "TRANGE", tr, g["TRANGE"])
+	natr, err := NATR(g["High"], g["Low"], g["Close"], 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "NATR", natr, g["NATR_14"])
+
+	if lookback, err := NATRLookback(14); err != nil || lookback != 14 {
+		t.Errorf("NATRLookback(14) = %d, %v", lookback, err)
+	}
+	if TRANGELookback() != 1 {
+		t.Errorf("TRANGELookback() = %d", TRANGELookback())
+	}
+}
+
+func TestKeltner(t *testing.T) {
+	g := readGolden(t, "keltner.csv")
+	r, err := CalcKeltner(g["High"], g["Low"], g["Close"], 20, 10, 2, MATypeEMA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "Upper", r.Upper, g["Upper"])
+	checkGolden(t, "Middle", r.Middle, g["Middle"])
+	checkGolden(t, "Lower", r.Lower, g["Lower"])
+
+	// ATR 的回看期较长时从 ATR 有效处开始
+	if lookback, err := KeltnerLookback(5, 30, MATypeSMA); err != nil || lookback != 30 {
+		t.Errorf("KeltnerLookback(5, 30, SMA) = %d, %v", lookback, err)
+	}
+	short, err := CalcKeltner(g["High"], g["Low"], g["Close"], 5, 30, 2, MATypeSMA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if short.Middle[29] != 0 || short.Middle[30] == 0 {
+		t.Errorf("Keltner(5, 30) middle starts at wrong index: %v", short.Middle[28:32])
+	}
+
+	var fe *FuncError
+	if _, err := CalcKeltner(g["High"], g["Low"], g["Close"], 20, 0, 2, MATypeEMA); !errors.As(err, &fe) || fe.Func != "Keltner" || fe.Param != "atrPeriod" {
+		t.Errorf("CalcKeltner(atrPeriod 0) = %v", err)
+	}
+}
+
+func TestDonchian(t *testing.T) {
+	g := readGolden(t, "donchian.csv")
+	r, err := CalcDonchian(g["High"], g["Low"], 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "Upper", r.Upper, g["Upper"])
+	checkGolden(t, "Middle", r.Middle, g["Middle"])
+	checkGolden(t, "Lower", r.Lower, g["Lower"])
+
+	if lookback, err := DonchianLookback(20); err != nil || lookback != 19 {
+		t.Errorf("DonchianLookback(20) = %d, %v", lookback, err)
+	}
+	if _, err := CalcDonchian(g["High"], g["Low"][:10], 5); err == nil {
+		t.Error("CalcDonchian accepted inputs of different lengths")
+	}
+}
+
+func TestChandelierExit(t *testing.T) {
+	g := readGolden(t, "chandelier.csv")
+	r, err := CalcChandelierExit(g["High"], g["Low"], g["Close"], 22, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "Long", r.Long, g["Long"])
+	checkGolden(t, "Short", r.Short, g["Short"])
+
+	if lookback, err := ChandelierExitLookback(22); err != nil || lookback != 22 {
+		t.Errorf("ChandelierExitLookback(22) = %d, %v", lookback, err)
+	}
+	if _, err := ChandelierExitLookback(0); !errors.Is(err, ErrBadParam) {
+		t.Errorf("ChandelierExitLookback(0) = %v", err)
+	}
+}
@@ -0,0 +1,238 @@
+package go4ta
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// 以下参考数据由逐根按定义直接计算得到：ADOSC 的两条 EMA 以第一根的 AD 值为种子，
+// ForceIndex 的 EMA 以前 timePeriod 个值的平均为种子，VWAP 的价格取典型价。
+
+func TestADOSC(t *testing.T) {
+	g := readGolden(t, "adosc.csv")
+	for _, c := range []struct {
+		name       string
+		fast, slow int
+	}{
+		{"ADOSC_3_10", 3, 10},
+		{"ADOSC_10_3", 10, 3},
+	} {
+		got, err := ADOSC(g["High"], g["Low"], g["Close"], g["Volume"], c.fast, c.slow)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, c.name, got, g[c.name])
+	}
+
+	if lookback, err := ADOSCLookback(3, 10); err != nil || lookback != 9 {
+		t.Errorf("ADOSCLookback(3, 10) = %d, %v", lookback, err)
+	}
+	var fe *FuncError
+	if _, err := ADOSC(g["High"], g["Low"], g["Close"], g["Volume"], 1, 10); !errors.As(err, &fe) || fe.Param != "fastPeriod" {
+		t.Errorf("ADOSC(1, 10) = %v", err)
+	}
+}
+
+// goldenTimes 将参考数据中以秒为单位的 Unix 时间转换为 UTC 时间。
+func goldenTimes(secs []float64) []time.Time {
+	times := make([]time.Time, len(secs))
+	for i, s := range secs {
+		times[i] = time.Unix(int64(s), 0).UTC()
+	}
+	return times
+}
+
+func TestVWAP(t *testing.T) {
+	g := readGolden(t, "vwap.csv")
+	times := goldenTimes(g["Time"])
+	for _, c := range []struct {
+		name    string
+		session Session
+	}{
+		{"VWAP_DAY", Session{}},
+		{"VWAP_0930", Session{Start: 9*time.Hour + 30*time.Minute}},
+	} {
+		got, err := VWAP(times, g["High"], g["Low"], g["Close"], g["Volume"], c.session)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, c.name, got, g[c.name])
+	}
+
+	// 东九区半的自然日从 UTC 14:30 开始
+	zoned, err := VWAP(times, g["High"], g["Low"], g["Close"], g["Volume"], Session{Location: time.FixedZone("", 9*3600+1800)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	shifted, err := VWAP(times, g["High"], g["Low"], g["Close"], g["Volume"], Session{Start: 14*time.Hour + 30*time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalFloats(zoned, shifted) {
+		t.Error("VWAP with Location differs from the equivalent Start in UTC")
+	}
+
+	// 时段内成交量为0时取典型价的平均
+	volume := make([]float64, len(times))
+	flat, err := VWAP(times[:2], g["High"][:2], g["Low"][:2], g["Close"][:2], volume[:2], Session{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	typical := func(i int) float64 { return (g["High"][i] + g["Low"][i] + g["Close"][i]) / 3 }
+	if want := (typical(0) + typical(1)) / 2; math.Abs(flat[1]-want) > 1e-9 {
+		t.Errorf("VWAP with zero volume = %f, want %f", flat[1], want)
+	}
+
+	var fe *FuncError
+	if _, err := VWAP(times, g["High"], g["Low"], g["Close"], g["Volume"], Session{Start: 24 * time.Hour}); !errors.As(err, &fe) || fe.Param != "session.Start" {
+		t.Errorf("VWAP(Start 24h) = %v", err)
+	}
+	if _, err := VWAP(times[:10], g["High"], g["Low"], g["Close"], g["Volume"], Session{}); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("VWAP with short times = %v", err)
+	}
+	b := &Bars{High: g["High"], Low: g["Low"], Close: g["Close"], Volume: g["Volume"]}
+	if _, err := b.VWAP(Session{}); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("Bars.VWAP without Time = %v", err)
+	}
+	if VWAPLookback() != 0 {
+		t.Errorf("VWAPLookback() = %d", VWAPLookback())
+	}
+}
+
+func TestAnchoredVWAP(t *testing.T) {
+	g := readGolden(t, "vwap.csv")
+	got, err := AnchoredVWAP(g["High"], g["Low"], g["Close"], g["Volume"], 20, 100, 180)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "AVWAP_20_100_180", got, g["AVWAP_20_100_180"])
+
+	// 锚点处的值即该根的典型价
+	for _, i := range []int{20, 100, 180} {
+		if want := (g["High"][i] + g["Low"][i] + g["Close"][i]) / 3; math.Abs(got[i]-want) > 1e-9 {
+			t.Errorf("AnchoredVWAP[%d] = %f, want %f", i, got[i], want)
+		}
+	}
+
+	if lookback, err := AnchoredVWAPLookback(20, 100); err != nil || lookback != 20 {
+		t.Errorf("AnchoredVWAPLookback(20, 100) = %d, %v", lookback, err)
+	}
+	for _, anchors := range [][]int{nil, {-1}, {100, 20}, {20, 20}, {20, 240}} {
+		if _, err := AnchoredVWAP(g["High"], g["Low"], g["Close"], g["Volume"], anchors...); !errors.Is(err, ErrBadParam) {
+			t.Errorf("AnchoredVWAP(%v) = %v", anchors, err)
+		}
+	}
+}
+
+func TestVWMA(t *testing.T) {
+	g := readGolden(t, "vwma.csv")
+	got, err := VWMA(g["Close"], g["Volume"], 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "VWMA", got, g["VWMA_20"])
+
+	// 成交量都为0时退化为简单平均
+	sma, err := SMA(g["Close"], 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	flat, err := VWMA(g["Close"], make([]float64, len(g["Close"])), 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range sma {
+		if math.Abs(flat[i]-sma[i]) > 1e-9 {
+			t.Errorf("VWMA with zero volume [%d] = %f, SMA %f", i, flat[i], sma[i])
+		}
+	}
+
+	// 小数成交量加减后留下舍入误差，成交量全为0的窗口仍应退化为简单平均
+	closes := []float64{10.1, 10.7, 11.3, 12, 12, 12, 12}
+	volumes := []float64{0.1, 0.2, 0.3, 0, 0, 0, 0}
+	residual, err := VWMA(closes, volumes, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := residual[len(residual)-1]; math.Abs(got-12) > 1e-9 {
+		t.Errorf("VWMA after zero-volume window = %f, want 12", got)
+	}
+
+	if lookback, err := VWMALookback(20); err != nil || lookback != 19 {
+		t.Errorf("VWMALookback(20) = %d, %v", lookback, err)
+	}
+}
+
+func TestCMF(t *testing.T) {
+	g := readGolden(t, "cmf.csv")
+	got, err := CMF(g["High"], g["Low"], g["Close"], g["Volume"], 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "CMF", got, g["CMF_20"])
+	for i, v := range got {
+		if v < -1 || v > 1 {
+			t.Errorf("CMF[%d] = %f out of range", i, v)
+		}
+	}
+
+	// 成交量全为0的窗口结果为0，不除以舍入误差
+	highs := []float64{10.5, 11, 11.5, 12.5, 12.5, 12.5, 12.5}
+	lows := []float64{9.5, 10, 10.5, 11.5, 11.5, 11.5, 11.5}
+	residual, err := CMF(highs, lows, []float64{10.1, 10.7, 11.3, 12, 12, 12, 12}, []float64{0.1, 0.2, 0.3, 0, 0, 0, 0}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := residual[len(residual)-1]; got != 0 {
+		t.Errorf("CMF after zero-volume window = %f, want 0", got)
+	}
+
+	if lookback, err := CMFLookback(20); err != nil || lookback != 19 {
+		t.Errorf("CMFLookback(20) = %d, %v", lookback, err)
+	}
+	if _, err := CMF(g["High"], g["Low"], g["Close"], g["Volume"], 0); !errors.Is(err, ErrBadParam) {
+		t.Errorf("CMF(0) = %v", err)
+	}
+}
+
+func TestForceIndex(t *testing.T) {
+	g := readGolden(t, "force_index.csv")
+	for _, c := range []struct {
+		name   string
+		period int
+	}{
+		{"FI_13", 13},
+		{"FI_1", 1},
+	} {
+		got, err := ForceIndex(g["Close"], g["Volume"], c.period)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, c.name, got, g[c.name])
+	}
+
+	if lookback, err := ForceIndexLookback(13); err != nil || lookback != 13 {
+		t.Errorf("ForceIndexLookback(13) = %d, %v", lookback, err)
+	}
+	if got, err := ForceIndex([]float64{1}, []float64{100}, 1); err != nil || len(got) != 1 || got[0] != 0 {
+		t.Errorf("ForceIndex(single bar) = %v, %v", got, err)
+	}
+}
+
+func TestEMV(t *testing.T) {
+	g := readGolden(t, "emv.csv")
+	got, err := EMV(g["High"], g["Low"], g["Volume"], 14, 10000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "EMV", got, g["EMV_14"])
+
+	if lookback, err := EMVLookback(14); err != nil || lookback != 14 {
+		t.Errorf("EMVLookback(14) = %d, %v", lookback, err)
+	}
+	if _, err := EMV(g["High"], g["Low"], g["Volume"][:10], 14, 10000); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("EMV with short volume = %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package go4ta
+
+import "math"
+
+// Vortex 计算涡旋指标，按位置返回各输出，结果含义见 CalcVortex。
+//
+// @param high, low, close - 价格序列
+// @param timePeriod       - 计算周期（如14）
+// @return plus, minus     - VI+ 与 VI-，两个与输入等长的结果序列
+// @return error           - 如果输入数据无效或参数超出范围，则返回错误。
+func Vortex(high, low, close []float64, timePeriod int) (plus, minus []float64, err error) {
+	r, err := CalcVortex(high, low, close, timePeriod)
+	return r.Plus, r.Minus, err
+}
+
+// CalcVortex 计算涡旋指标（Vortex Indicator）：VI+ 为最近 timePeriod 根 |最高价-前一根最低价| 之和除以真实波幅之和，
+// VI- 为 |最低价-前一根最高价| 之和除以真实波幅之和。VI+ 上穿 VI- 通常视为上升趋势的开始。
+//
+// @param high, low, close - 价格序列
+// @param timePeriod       - 计算周期（如14）
+// @return VortexResult    - VI+ 与 VI-，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0；真实波幅之和为0时为0。
+// @return error           - 如果输入数据无效或参数超出范围，则返回错误。
+func CalcVortex(high, low, close []float64, timePeriod int) (VortexResult, error) {
+	n, err := checkInputs("Vortex", "high, low, close", high, low, close)
+	if err != nil {
+		return VortexResult{}, err
+	}
+	if n == 0 {
+		return VortexResult{[]float64{}, []float64{}}, nil
+	}
+	if n < timePeriod {
+		return VortexResult{}, tooShort("Vortex", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("VORTEX", float64(timePeriod)); err != nil {
+		return VortexResult{}, err
+	}
+
+	// 第 i 根的变动与真实波幅都需要前一根，窗口移动时从合计中减去移出的一根
+	begIdx := timePeriod
+	var outPlus, outMinus []float64
+	if begIdx < n {
+		outPlus = make([]float64, n-begIdx)
+		outMinus = make([]float64, n-begIdx)
+	}
+	var sumPlus, sumMinus, sumTR float64
+	for i := 1; i < n; i++ {
+		sumPlus += math.Abs(high[i] - low[i-1])
+		sumMinus += math.Abs(low[i] - high[i-1])
+		sumTR += trueRange(high[i], low[i], close[i-1])
+		if i < begIdx {
+			continue
+		}
+		if sumTR > 0 {
+			outPlus[i-begIdx] = sumPlus / sumTR
+			outMinus[i-begIdx] = sumMinus / sumTR
+		}
+		trailing := i - timePeriod + 1
+		sumPlus -= math.Abs(high[trailing] - low[trailing-1])
+		sumMinus -= math.Abs(low[trailing] - high[trailing-1])
+		sumTR -= trueRange(high[trailing], low[trailing], close[trailing-1])
+	}
+
+	w := newWarmup(0)
+	return VortexResult{
+		Plus:  w.spread(n, begIdx, outPlus),
+		Minus: w.spread(n, begIdx, outMinus),
+	}, nil
+}
+
+// VortexLookback 返回 Vortex 的回看期，即 timePeriod。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func VortexLookback(timePeriod int) (int, error) {
+	if timePeriod < 1 || timePeriod > 100000 {
+		return 0, badParam("Vortex", "timePeriod", timePeriod)
+	}
+	return timePeriod, nil
+}
@@ -0,0 +1,142 @@
+package go4ta
+
+import "time"
+
+// Session 描述 VWAP 的交易时段划分：每个时段从某天的 Start 时刻开始，到次日同一时刻之前结束，
+// 每个时段的第一根价格柱处重新开始累计。
+type Session struct {
+	Location *time.Location // 判断日期与时刻所用的时区，nil 表示使用各时间戳自身的时区
+	Start    time.Duration  // 时段在一天中开始的时刻，取值为 [0, 24h)，0 表示按自然日划分；如夜盘 21:00 开始时为 21*time.Hour
+}
+
+// day 返回 t 所在时段开始的日期，同一时段内的时间戳得到相同的结果。
+func (s Session) day(t time.Time) time.Time {
+	if s.Location != nil {
+		t = t.In(s.Location)
+	}
+	y, m, d := t.Date()
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+	if clock < s.Start {
+		d--
+	}
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// VWAP 计算按交易时段累计的成交量加权平均价（Volume Weighted Average Price），
+// 价格取典型价 (high+low+close)/3，每个时段开始时重新累计。
+//
+// @param times      - 各价格柱的时间，须按先后排列
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param volume     - 成交量序列
+// @param session    - 交易时段的划分方式，零值表示按时间戳所在时区的自然日划分
+// @return []float64 - VWAP 结果序列，与输入等长；时段内成交量合计为0时取典型价的简单平均。
+// @return error     - 如果输入数据无效或参数超出范围，则返回错误。
+func VWAP(times []time.Time, high, low, close, volume []float64, session Session) ([]float64, error) {
+	n, err := checkInputs("VWAP", "high, low, close, volume", high, low, close, volume)
+	if err != nil {
+		return nil, err
+	}
+	if len(times) != n {
+		return nil, lengthMismatch("VWAP", "time, high, low, close, volume", len(times), len(high), len(low), len(close), len(volume))
+	}
+	if session.Start < 0 || session.Start >= 24*time.Hour {
+		return nil, badParam("VWAP", "session.Start", session.Start)
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	prevDay := session.day(times[0])
+	return cumulativeVWAP(high, low, close, volume, 0, func(i int) bool {
+		day := session.day(times[i])
+		newSession := !day.Equal(prevDay)
+		prevDay = day
+		return newSession
+	}), nil
+}
+
+// VWAPLookback 返回 VWAP 的回看期。VWAP 从第一根价格柱起即有输出，因此恒为 0。
+func VWAPLookback() int {
+	return 0
+}
+
+// AnchoredVWAP 计算锚定 VWAP：从每个锚点处重新累计成交量加权的典型价，直到下一个锚点。
+// 只给出一个锚点时即为常见的从某一根（如财报日、前高）开始的锚定 VWAP；累计的成交量为0时取典型价的简单平均。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param volume     - 成交量序列
+// @param anchors    - 锚点的下标，至少一个，须严格递增且小于输入长度
+// @return []float64 - 结果序列，与输入等长，第一个锚点之前按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或锚点无效，则返回错误。
+func AnchoredVWAP(high, low, close, volume []float64, anchors ...int) ([]float64, error) {
+	n, err := checkInputs("AnchoredVWAP", "high, low, close, volume", high, low, close, volume)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	begIdx, err := AnchoredVWAPLookback(anchors...)
+	if err != nil {
+		return nil, err
+	}
+	if last := anchors[len(anchors)-1]; last >= n {
+		return nil, badParam("AnchoredVWAP", "anchors", last)
+	}
+
+	next := 1
+	output := cumulativeVWAP(high, low, close, volume, begIdx, func(i int) bool {
+		if next < len(anchors) && anchors[next] == i {
+			next++
+			return true
+		}
+		return false
+	})
+	return newWarmup(0).spread(n, begIdx, output), nil
+}
+
+// AnchoredVWAPLookback 返回 AnchoredVWAP 的回看期，即第一个锚点的下标。
+//
+// @param anchors - 锚点的下标
+// @return int    - 回看期
+// @return error  - 没有锚点、锚点为负或不是严格递增时返回错误
+func AnchoredVWAPLookback(anchors ...int) (int, error) {
+	if len(anchors) == 0 {
+		return 0, badParam("AnchoredVWAP", "anchors", anchors)
+	}
+	for i, a := range anchors {
+		if a < 0 || (i > 0 && a <= anchors[i-1]) {
+			return 0, badParam("AnchoredVWAP", "anchors", a)
+		}
+	}
+	return anchors[0], nil
+}
+
+// cumulativeVWAP 从 begIdx 起累计成交量加权的典型价，reset(i) 为真时从第 i 根重新累计，
+// 结果从 begIdx 开始紧凑排列。
+func cumulativeVWAP(high, low, close, volume []float64, begIdx int, reset func(i int) bool) []float64 {
+	output := make([]float64, len(close)-begIdx)
+	var sumPV, sumV, sumP float64
+	count := 0
+	for i := begIdx; i < len(close); i++ {
+		if i > begIdx && reset(i) {
+			sumPV, sumV, sumP, count = 0, 0, 0, 0
+		}
+		typical := (high[i] + low[i] + close[i]) / 3.0
+		sumPV += typical * volume[i]
+		sumV += volume[i]
+		sumP += typical
+		count++
+		if sumV > 0 {
+			output[i-begIdx] = sumPV / sumV
+		} else {
+			output[i-begIdx] = sumP / float64(count)
+		}
+	}
+	return output
+}
@@ -0,0 +1,69 @@
+package go4ta
+
+// VWMA 计算成交量加权移动平均线（Volume Weighted Moving Average），即窗口内以成交量为权重的收盘价平均。
+//
+// @param close      - 收盘价序列
+// @param volume     - 成交量序列
+// @param timePeriod - 计算周期（如20）
+// @return []float64 - VWMA 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0；窗口内成交量合计为0时取收盘价的简单平均。
+// @return error     - 如果输入数据无效或参数超出范围，则返回错误。
+func VWMA(close, volume []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("VWMA", "close, volume", close, volume)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("VWMA", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("VWMA", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	// 窗口移动时从合计中减去移出的一根。加减后的合计带有舍入误差，
+	// 成交量全为0的窗口合计未必恰好为0，因此另外统计窗口内有成交量的根数
+	begIdx := timePeriod - 1
+	output := make([]float64, n-begIdx)
+	var sumPV, sumV, sumP float64
+	traded := 0
+	for i := range close {
+		sumPV += close[i] * volume[i]
+		sumV += volume[i]
+		sumP += close[i]
+		if volume[i] != 0 {
+			traded++
+		}
+		if i < begIdx {
+			continue
+		}
+		if traded > 0 && sumV > 0 {
+			output[i-begIdx] = sumPV / sumV
+		} else {
+			output[i-begIdx] = sumP / float64(timePeriod)
+		}
+		trailing := i - begIdx
+		sumPV -= close[trailing] * volume[trailing]
+		sumV -= volume[trailing]
+		sumP -= close[trailing]
+		if volume[trailing] != 0 {
+			traded--
+		}
+	}
+
+	return newWarmup(0).spread(n, begIdx, output), nil
+}
+
+// VWMALookback 返回 VWMA 的回看期，即 timePeriod-1。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func VWMALookback(timePeriod int) (int, error) {
+	if timePeriod < 1 || timePeriod > 100000 {
+		return 0, badParam("VWMA", "timePeriod", timePeriod)
+	}
+	return timePeriod - 1, nil
+}
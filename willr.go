@@ -0,0 +1,43 @@
+package go4ta
+
+// WILLR 计算威廉指标（Williams' %R），取值在 -100 到 0 之间，收盘价位于区间最高价时为0。
+//
+// @param high       - 最高价序列
+// @param low        - 最低价序列
+// @param close      - 收盘价序列
+// @param timePeriod - 计算周期（如14）
+// @return []float64 - %R 结果序列，与输入等长，未计算部分按 SetFillPolicy 的设置填充，默认为0。
+// @return error     - 如果输入数据无效或 C 库调用失败，则返回错误。
+func WILLR(high, low, close []float64, timePeriod int) ([]float64, error) {
+	n, err := checkInputs("WILLR", "high, low, close", high, low, close)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < timePeriod {
+		return nil, tooShort("WILLR", n, "timePeriod", timePeriod)
+	}
+
+	if err := checkParams("WILLR", float64(timePeriod)); err != nil {
+		return nil, err
+	}
+
+	outBegIdx, output, err := taWILLR(high, low, close, timePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(n, outBegIdx, output), nil
+}
+
+// WILLRLookback 返回 WILLR 在给定参数下的回看期，即结果序列开头填充值的个数。
+//
+// @param timePeriod - 计算周期
+// @return int       - 回看期
+// @return error     - 参数无效时返回错误
+func WILLRLookback(timePeriod int) (int, error) {
+	_, err := willrParams(timePeriod)
+	return lookbackResult("TA_WILLR", taWILLRLookback(timePeriod), err)
+}
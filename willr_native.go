@@ -0,0 +1,82 @@
+package go4ta
+
+// nativeWILLR 是 TA_WILLR 的原生实现。
+func nativeWILLR(high, low, close []float64, timePeriod int) (int, []float64, error) {
+	timePeriod, err := willrParams(timePeriod)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	startIdx := timePeriod - 1
+	if startIdx > len(high)-1 {
+		return 0, nil, nil
+	}
+
+	output := make([]float64, 0, len(high)-startIdx)
+	trailingIdx := 0
+	lowestIdx, highestIdx := -1, -1
+	diff, highest, lowest := 0.0, 0.0, 0.0
+
+	// 与 intFastK 相同地跟踪区间最高价与最低价，diff 预先除以 -100
+	for today := startIdx; today < len(high); today++ {
+		tmp := low[today]
+		if lowestIdx < trailingIdx {
+			lowestIdx = trailingIdx
+			lowest = low[lowestIdx]
+			for i := lowestIdx + 1; i <= today; i++ {
+				tmp = low[i]
+				if tmp < lowest {
+					lowestIdx = i
+					lowest = tmp
+				}
+			}
+			diff = (highest - lowest) / (-100.0)
+		} else if tmp <= lowest {
+			lowestIdx = today
+			lowest = tmp
+			diff = (highest - lowest) / (-100.0)
+		}
+
+		tmp = high[today]
+		if highestIdx < trailingIdx {
+			highestIdx = trailingIdx
+			highest = high[highestIdx]
+			for i := highestIdx + 1; i <= today; i++ {
+				tmp = high[i]
+				if tmp > highest {
+					highestIdx = i
+					highest = tmp
+				}
+			}
+			diff = (highest - lowest) / (-100.0)
+		} else if tmp >= highest {
+			highestIdx = today
+			highest = tmp
+			diff = (highest - lowest) / (-100.0)
+		}
+
+		if diff != 0.0 {
+			output = append(output, (highest-close[today])/diff)
+		} else {
+			output = append(output, 0.0)
+		}
+		trailingIdx++
+	}
+	return startIdx, output, nil
+}
+
+// nativeWILLRLookback 对应 TA_WILLR_Lookback，参数无效时返回 -1。
+func nativeWILLRLookback(timePeriod int) int {
+	timePeriod, err := willrParams(timePeriod)
+	if err != nil {
+		return -1
+	}
+	return timePeriod - 1
+}
+
+// willrParams 按 TA_WILLR 的规则处理参数。
+func willrParams(timePeriod int) (int, error) {
+	c := paramCheck{fn: "TA_WILLR"}
+	timePeriod = c.integer("timePeriod", timePeriod, 14, 2, 100000)
+	return timePeriod, c.err
+}
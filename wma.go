@@ -1,5 +1,10 @@
 package go4ta
 
 func WMA(close []float64, timePeriod int) ([]float64, error) {
-	return MA(close, timePeriod, 2)
+	return MA(close, timePeriod, MATypeWMA)
+}
+
+// WMALookback 返回 WMA 的回看期，等同于 MALookback(timePeriod, MATypeWMA)。
+func WMALookback(timePeriod int) (int, error) {
+	return MALookback(timePeriod, MATypeWMA)
 }